@@ -28,6 +28,20 @@ func (bs *bridgeSignal[T]) Get() T {
 	return bs.signal.Get()
 }
 
+// Peek returns the current value without registering the current running
+// effect as a dependent.
+func (bs *bridgeSignal[T]) Peek() T {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if bs.disposed {
+		var zero T
+		return zero
+	}
+
+	return bs.signal.Peek()
+}
+
 // Set updates the value. If the value hasn't changed (DeepEqual), it's a no-op.
 // Otherwise all dependent effects are re-executed.
 func (bs *bridgeSignal[T]) Set(value T) {
@@ -41,6 +55,12 @@ func (bs *bridgeSignal[T]) Set(value T) {
 	bs.signal.Set(value)
 }
 
+// OnChange calls fn with the previous and the new value after every change
+// of the underlying signal.
+func (bs *bridgeSignal[T]) OnChange(fn func(old, new T)) func() {
+	return bs.signal.OnChange(fn)
+}
+
 // Dispose disposes the bridge signal and its underlying subscription.
 func (bs *bridgeSignal[T]) Dispose() {
 	bs.mu.Lock()
@@ -1,6 +1,7 @@
 package action
 
 import (
+	"context"
 	"encoding/json"
 	"reflect"
 	"sync"
@@ -44,6 +45,9 @@ type Bus interface {
 
 	// OnError registers an enhanced error handler for the bus.
 	OnError(handler func(ctx Context, err error, recovered any), opts ...SubOption) Subscription
+
+	// Shutdown stops the bus and waits for in-flight handlers to finish.
+	Shutdown(ctx context.Context) error
 }
 
 // busImpl is the real implementation of the Bus interface.
@@ -52,10 +56,21 @@ type busImpl struct {
 	scopePath            string
 	subscribers          map[string][]*subscriptionEntry
 	anyHandlers          []*subscriptionEntry
+	exactPatterns        map[string][]*subscriptionEntry // OnActionPattern subscribers without a wildcard
+	prefixPatterns       map[string][]*subscriptionEntry // OnActionPattern subscribers keyed by prefix before "*"
 	queryHandlers        map[string]*queryHandlerEntry
 	errorHandler         func(error)
 	enhancedErrorHandler func(ctx Context, err error, recovered any)
 	parent               *busImpl
+
+	// Shutdown state, guarded by closeMu rather than mu because dispatches
+	// hold mu while handlers run; see shutdown.go
+	closeMu     sync.RWMutex
+	closed      bool
+	inflight    sync.WaitGroup
+	onShutdown  map[uint64]func()
+	shutdownSeq uint64
+	closeOnce   sync.Once
 }
 
 // queryHandlerEntry represents a query handler entry in the bus.
@@ -121,6 +136,10 @@ var (
 
 // Dispatch sends an action to all registered subscribers.
 func (b *busImpl) Dispatch(action any, opts ...DispatchOption) error {
+	if !b.acquire() {
+		return ErrBusClosed
+	}
+	defer b.done()
 	// Apply dispatch options
 	dispatchOpts := &dispatchOptions{
 		context: Context{
@@ -243,9 +262,19 @@ func (b *busImpl) Dispatch(action any, opts ...DispatchOption) error {
 		actionType = "unknown"
 	}
 
+	// Reject invalid payloads before any subscriber sees them
+	if err := b.rejectInvalid(actionToDispatch, actionType, dispatchOpts); err != nil {
+		return err
+	}
+
 	// Handle async dispatch
 	if dispatchOpts.async {
-		go b.dispatchAsync(actionToDispatch, actionType, dispatchOpts.context)
+		// The goroutine stays in flight after Dispatch returns
+		b.hold()
+		go func() {
+			defer b.done()
+			b.dispatchAsync(actionToDispatch, actionType, dispatchOpts.context)
+		}()
 		return nil
 	}
 
@@ -262,13 +291,19 @@ func (b *busImpl) dispatchSync(action any, actionType string, ctx Context) error
 		handlers = b.getOrderedSubscribers(actionType)
 	}
 
+	// Get ordered pattern subscribers
+	var patternHandlers []*subscriptionEntry
+	if actionType != "unknown" {
+		patternHandlers = b.getOrderedPatternSubscribers(actionType)
+	}
+
 	// Get ordered any handlers
 	anyHandlers := b.getOrderedAnyHandlers()
 	subscriberCount := len(handlers) + len(anyHandlers)
 	b.mu.RUnlock()
 
 	// Instrument dispatch with observability features
-	return instrumentDispatch(b, actionType, action, ctx, subscriberCount, func() error {
+	return instrumentDispatch(b, actionType, action, ctx, subscriberCount, len(patternHandlers), func() error {
 		b.mu.RLock()
 		defer b.mu.RUnlock()
 
@@ -279,6 +314,13 @@ func (b *busImpl) dispatchSync(action any, actionType string, ctx Context) error
 			}
 		}
 
+		// Dispatch to pattern subscribers
+		for _, entry := range patternHandlers {
+			if entry.active {
+				b.dispatchToHandler(entry, action, ctx)
+			}
+		}
+
 		// Dispatch to any handlers
 		for _, entry := range anyHandlers {
 			if entry.active {
@@ -290,19 +332,19 @@ func (b *busImpl) dispatchSync(action any, actionType string, ctx Context) error
 	})
 }
 
-// dispatchAsync performs asynchronous dispatch
+// dispatchAsync performs asynchronous dispatch on the caller's goroutine.
+// A dispatch that has not started by the time the bus shuts down is dropped.
 func (b *busImpl) dispatchAsync(action any, actionType string, ctx Context) {
-	// Simple goroutine-based async dispatch for now
-	// In a more sophisticated implementation, this could use a microtask scheduler
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				// Handle panic in async dispatch using enhanced error handling
-				handleEnhancedError(b, ctx, &panicError{value: r}, r)
-			}
-		}()
-		b.dispatchSync(action, actionType, ctx)
+	if b.isClosed() {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			// Handle panic in async dispatch using enhanced error handling
+			handleEnhancedError(b, ctx, &panicError{value: r}, r)
+		}
 	}()
+	b.dispatchSync(action, actionType, ctx)
 }
 
 // dispatchToHandler dispatches to a single handler with panic recovery
@@ -328,6 +370,9 @@ func (b *busImpl) dispatchToHandler(entry *subscriptionEntry, action any, ctx Co
 		}
 	case func(any) error:
 		handlerErr = handler(action)
+	case patternHandler:
+		actionType, payload := patternPayload(action)
+		handler(ctx, actionType, payload)
 	}
 
 	// Handle errors from handler execution
@@ -429,6 +474,22 @@ func (b *busImpl) findSubscriptionForEntry(entry *subscriptionEntry) Subscriptio
 		}
 	}
 
+	// Check pattern subscribers
+	for key, handlers := range b.exactPatterns {
+		for _, handler := range handlers {
+			if handler == entry {
+				return &patternSubscription{bus: b, entry: entry, key: key}
+			}
+		}
+	}
+	for key, handlers := range b.prefixPatterns {
+		for _, handler := range handlers {
+			if handler == entry {
+				return &patternSubscription{bus: b, entry: entry, key: key, prefix: true}
+			}
+		}
+	}
+
 	// Check any handlers
 	for _, handler := range b.anyHandlers {
 		if handler == entry {
@@ -827,6 +888,9 @@ func (b *busImpl) HandleQuery(queryType string, handler func(Action[string]) (an
 
 // Ask sends a query and waits for a response.
 func (b *busImpl) Ask(queryType string, query Action[string], opts ...AskOption) (any, error) {
+	if b.isClosed() {
+		return nil, ErrBusClosed
+	}
 	// Apply ask options
 	askOpts := &askOptions{}
 	for _, opt := range opts {
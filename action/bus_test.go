@@ -1,6 +1,7 @@
 package action
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -125,6 +126,10 @@ func (tb *testBus) AskTyped(qt interface{}, req interface{}, opts ...AskOption)
 	return nil
 }
 
+func (tb *testBus) Shutdown(ctx context.Context) error {
+	return nil
+}
+
 // TestGlobalBusSingleton verifies that Global() returns the same instance
 func TestGlobalBusSingleton(t *testing.T) {
 	// Get the global bus twice
@@ -14,6 +14,9 @@ var (
 
 	// ErrDisposed is returned when trying to use a disposed subscription or resource
 	ErrDisposed = errors.New("resource has been disposed")
+
+	// ErrBusClosed is returned when dispatching to a bus that has been shut down
+	ErrBusClosed = errors.New("bus has been shut down")
 )
 
 // dispatchError represents an error that occurred during dispatch
@@ -13,9 +13,12 @@ type DevLogEntry struct {
 	TraceID         string
 	Source          string
 	SubscriberCount int
-	Duration        time.Duration
-	Error           error
-	Timestamp       time.Time
+	// PatternSubscriberCount is the number of OnActionPattern subscribers
+	// that matched; they are not included in SubscriberCount.
+	PatternSubscriberCount int
+	Duration               time.Duration
+	Error                  error
+	Timestamp              time.Time
 }
 
 // DebugRingBufferEntry represents an entry in the debug ring buffer
@@ -83,7 +86,9 @@ type observabilityManager struct {
 	devLogger       *DevLogger
 	debugBuffer     *DebugRingBuffer
 	enhancedOnError ErrorHandler
-	mu              sync.RWMutex
+	// rejected counts the actions rejected by payload validators
+	rejected int
+	mu       sync.RWMutex
 }
 
 // Global observability managers per bus instance
@@ -285,19 +290,20 @@ func (o *analyticsFilterOption) applyAnalytics(opts *analyticsOptions) {
 }
 
 // logDevEntry logs a development entry if dev logger is enabled
-func (obs *observabilityManager) logDevEntry(actionType string, ctx Context, subscriberCount int, duration time.Duration, err error) {
+func (obs *observabilityManager) logDevEntry(actionType string, ctx Context, subscriberCount, patternCount int, duration time.Duration, err error) {
 	obs.devLogger.mu.RLock()
 	defer obs.devLogger.mu.RUnlock()
 
 	if obs.devLogger.enabled && obs.devLogger.handler != nil {
 		entry := DevLogEntry{
-			ActionType:      actionType,
-			TraceID:         ctx.TraceID,
-			Source:          ctx.Source,
-			SubscriberCount: subscriberCount,
-			Duration:        duration,
-			Error:           err,
-			Timestamp:       time.Now(),
+			ActionType:             actionType,
+			TraceID:                ctx.TraceID,
+			Source:                 ctx.Source,
+			SubscriberCount:        subscriberCount,
+			PatternSubscriberCount: patternCount,
+			Duration:               duration,
+			Error:                  err,
+			Timestamp:              time.Now(),
 		}
 		obs.devLogger.handler(entry)
 	}
@@ -373,8 +379,15 @@ func (obs *observabilityManager) setEnhancedErrorHandler(handler ErrorHandler) {
 	obs.enhancedOnError = handler
 }
 
+// countRejected counts an action rejected by its payload validator
+func (obs *observabilityManager) countRejected() {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	obs.rejected++
+}
+
 // instrumentDispatch instruments a dispatch with observability features
-func instrumentDispatch(bus *busImpl, actionType string, action any, ctx Context, subscriberCount int, dispatchFunc func() error) error {
+func instrumentDispatch(bus *busImpl, actionType string, action any, ctx Context, subscriberCount, patternCount int, dispatchFunc func() error) error {
 	obs := getObservabilityManager(bus)
 
 	// Record in debug buffer
@@ -386,7 +399,7 @@ func instrumentDispatch(bus *busImpl, actionType string, action any, ctx Context
 	duration := time.Since(start)
 
 	// Log development entry
-	obs.logDevEntry(actionType, ctx, subscriberCount, duration, err)
+	obs.logDevEntry(actionType, ctx, subscriberCount, patternCount, duration, err)
 
 	return err
 }
@@ -441,6 +454,7 @@ func GetObservabilityStats(bus Bus) ObservabilityStats {
 
 		obs.mu.RLock()
 		stats.EnhancedErrorHandlerSet = obs.enhancedOnError != nil
+		stats.RejectedActions = obs.rejected
 		obs.mu.RUnlock()
 	}
 
@@ -453,6 +467,9 @@ type ObservabilityStats struct {
 	DebugBufferSize         int
 	DebugBufferActionTypes  int
 	EnhancedErrorHandlerSet bool
+	// RejectedActions counts the dispatches rejected by the payload
+	// validators of DefineActionWithValidation
+	RejectedActions int
 }
 
 // LogObservabilityEvent logs a general observability event
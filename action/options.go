@@ -16,6 +16,8 @@ type dispatchOptions struct {
 	priority   int
 	persistent bool
 	async      bool
+	// skipValidation skips the payload validator of the action type
+	skipValidation bool
 }
 
 // WithTimeout sets a timeout for the dispatch operation.
@@ -99,6 +101,19 @@ func (o asyncOption) applyDispatch(opts *dispatchOptions) {
 	opts.async = true
 }
 
+// WithoutValidation skips the payload validator set with
+// DefineActionWithValidation, for trusted internal dispatches of payloads
+// known to be valid.
+func WithoutValidation() DispatchOption {
+	return withoutValidationOption{}
+}
+
+type withoutValidationOption struct{}
+
+func (o withoutValidationOption) applyDispatch(opts *dispatchOptions) {
+	opts.skipValidation = true
+}
+
 // SubOption configures how a subscription is created.
 type SubOption interface {
 	applySub(*subOptions)
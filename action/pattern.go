@@ -0,0 +1,156 @@
+package action
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// patternHandler is the handler signature stored for pattern subscriptions.
+type patternHandler = func(ctx Context, actionType string, payload any)
+
+// OnActionPattern registers fn for every action whose type matches pattern.
+// A pattern ending in "*" matches any type with the preceding prefix (so
+// "user.*" matches "user.login" and "user.profile.saved", and "*" matches
+// every typed action); any other pattern must match the type exactly.
+//
+// Matching is a map lookup per prefix of the dispatched type, so the cost
+// does not grow with the number of pattern subscriptions. Pattern
+// subscribers are reported separately from typed subscribers in
+// DevLogEntry.PatternSubscriberCount.
+//
+// When called inside a reactive cleanup scope the subscription is disposed
+// together with the scope.
+func OnActionPattern(bus Bus, pattern string, fn func(ctx Context, actionType string, payload any), opts ...SubOption) Subscription {
+	b, ok := bus.(*busImpl)
+	if !ok || fn == nil {
+		return NewNoOpSubscription()
+	}
+	sub := b.subscribePattern(pattern, fn, opts...)
+	reactivity.RegisterCleanup(func() {
+		sub.Dispose()
+	})
+	return sub
+}
+
+// subscribePattern adds a pattern subscription entry to the bus.
+func (b *busImpl) subscribePattern(pattern string, fn patternHandler, opts ...SubOption) *patternSubscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subOpts := &subOptions{}
+	for _, opt := range opts {
+		opt.applySub(subOpts)
+	}
+
+	entry := &subscriptionEntry{
+		id:                   generateID(),
+		handler:              fn,
+		active:               true,
+		priority:             subOpts.priority,
+		createdAt:            time.Now(),
+		once:                 subOpts.once,
+		filter:               subOpts.filter,
+		whenSignal:           subOpts.whenSignal,
+		distinctUntilChanged: subOpts.distinctUntilChanged,
+		distinctEqualityFunc: subOpts.distinctEqualityFunc,
+	}
+
+	sub := &patternSubscription{bus: b, entry: entry}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		if b.prefixPatterns == nil {
+			b.prefixPatterns = make(map[string][]*subscriptionEntry)
+		}
+		b.prefixPatterns[prefix] = append(b.prefixPatterns[prefix], entry)
+		sub.key, sub.prefix = prefix, true
+	} else {
+		if b.exactPatterns == nil {
+			b.exactPatterns = make(map[string][]*subscriptionEntry)
+		}
+		b.exactPatterns[pattern] = append(b.exactPatterns[pattern], entry)
+		sub.key = pattern
+	}
+	return sub
+}
+
+// getOrderedPatternSubscribers returns the pattern subscribers matching
+// actionType, ordered by priority (desc) then FIFO. The caller must hold b.mu.
+func (b *busImpl) getOrderedPatternSubscribers(actionType string) []*subscriptionEntry {
+	if len(b.exactPatterns) == 0 && len(b.prefixPatterns) == 0 {
+		return nil
+	}
+
+	var result []*subscriptionEntry
+	result = append(result, b.exactPatterns[actionType]...)
+	if len(b.prefixPatterns) > 0 {
+		for i := 0; i <= len(actionType); i++ {
+			result = append(result, b.prefixPatterns[actionType[:i]]...)
+		}
+	}
+
+	// Sort by priority (descending) then by creation time (ascending)
+	for i := 0; i < len(result)-1; i++ {
+		for j := i + 1; j < len(result); j++ {
+			if result[i].priority < result[j].priority ||
+				(result[i].priority == result[j].priority && result[i].createdAt.After(result[j].createdAt)) {
+				result[i], result[j] = result[j], result[i]
+			}
+		}
+	}
+
+	return result
+}
+
+// patternSubscription is the Subscription returned by OnActionPattern.
+type patternSubscription struct {
+	bus    *busImpl
+	entry  *subscriptionEntry
+	key    string
+	prefix bool
+}
+
+// Dispose stops the subscription.
+func (s *patternSubscription) Dispose() error {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.entry.active = false
+
+	index := s.bus.exactPatterns
+	if s.prefix {
+		index = s.bus.prefixPatterns
+	}
+	handlers := index[s.key]
+	for i, entry := range handlers {
+		if entry == s.entry {
+			handlers = append(handlers[:i], handlers[i+1:]...)
+			break
+		}
+	}
+	if len(handlers) == 0 {
+		delete(index, s.key)
+	} else {
+		index[s.key] = handlers
+	}
+
+	return nil
+}
+
+// IsActive returns true if the subscription is active.
+func (s *patternSubscription) IsActive() bool {
+	return s.entry.active
+}
+
+// patternPayload extracts the action type and payload delivered to pattern
+// handlers.
+func patternPayload(action any) (string, any) {
+	switch act := action.(type) {
+	case Action[string]:
+		return act.Type, act.Payload
+	case Action[any]:
+		return act.Type, act.Payload
+	default:
+		return "", action
+	}
+}
@@ -0,0 +1,86 @@
+package action
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOnActionPattern_PrefixAndExactMatches(t *testing.T) {
+	bus := New()
+
+	var prefixed, exact, all []string
+	OnActionPattern(bus, "user.*", func(ctx Context, actionType string, payload any) {
+		prefixed = append(prefixed, actionType+"="+payload.(string))
+	})
+	OnActionPattern(bus, "user.login", func(ctx Context, actionType string, payload any) {
+		exact = append(exact, actionType)
+	})
+	OnActionPattern(bus, "*", func(ctx Context, actionType string, payload any) {
+		all = append(all, actionType)
+	})
+
+	bus.Dispatch(Action[string]{Type: "user.login", Payload: "alice"})
+	bus.Dispatch(Action[string]{Type: "user.profile.saved", Payload: "bob"})
+	bus.Dispatch(Action[string]{Type: "cart.add", Payload: "apple"})
+	bus.Dispatch(Action[string]{Type: "user", Payload: "no-dot"})
+
+	if want := []string{"user.login=alice", "user.profile.saved=bob"}; !reflect.DeepEqual(prefixed, want) {
+		t.Errorf("prefix subscriber got %v, want %v", prefixed, want)
+	}
+	if want := []string{"user.login"}; !reflect.DeepEqual(exact, want) {
+		t.Errorf("exact subscriber got %v, want %v", exact, want)
+	}
+	if want := []string{"user.login", "user.profile.saved", "cart.add", "user"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("wildcard subscriber got %v, want %v", all, want)
+	}
+}
+
+func TestOnActionPattern_Dispose(t *testing.T) {
+	bus := New()
+
+	calls := 0
+	sub := OnActionPattern(bus, "todo.*", func(ctx Context, actionType string, payload any) {
+		calls++
+	})
+	if !sub.IsActive() {
+		t.Fatal("expected new subscription to be active")
+	}
+
+	bus.Dispatch(Action[string]{Type: "todo.add", Payload: "x"})
+	sub.Dispose()
+	bus.Dispatch(Action[string]{Type: "todo.add", Payload: "y"})
+
+	if calls != 1 {
+		t.Errorf("expected 1 call before disposal, got %d", calls)
+	}
+	if sub.IsActive() {
+		t.Error("expected subscription to be inactive after Dispose")
+	}
+	if impl := bus.(*busImpl); len(impl.prefixPatterns) != 0 {
+		t.Errorf("expected prefix index to be empty after Dispose, got %v", impl.prefixPatterns)
+	}
+}
+
+func TestOnActionPattern_CountedSeparatelyInDevLog(t *testing.T) {
+	bus := New()
+
+	var entries []DevLogEntry
+	EnableDevLogger(bus, func(entry DevLogEntry) {
+		entries = append(entries, entry)
+	})
+	defer DisableDevLogger(bus)
+
+	bus.Subscribe("order.placed", func(action Action[string]) error { return nil })
+	OnActionPattern(bus, "order.*", func(ctx Context, actionType string, payload any) {})
+	OnActionPattern(bus, "*", func(ctx Context, actionType string, payload any) {})
+
+	bus.Dispatch(Action[string]{Type: "order.placed", Payload: "1"})
+
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 dev log entry, got %d", len(entries))
+	}
+	if entries[0].SubscriberCount != 1 || entries[0].PatternSubscriberCount != 2 {
+		t.Errorf("expected 1 typed and 2 pattern subscribers, got %d and %d",
+			entries[0].SubscriberCount, entries[0].PatternSubscriberCount)
+	}
+}
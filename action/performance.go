@@ -668,6 +668,10 @@ func OptimizedDispatch(bus Bus, action any, opts ...DispatchOption) error {
 		actionType = "unknown"
 	}
 
+	if err := busImpl.rejectInvalid(actionToDispatch, actionType, dispatchOpts); err != nil {
+		return err
+	}
+
 	// Handle async dispatch with microtask scheduler
 	if dispatchOpts.async {
 		return optimizedDispatchAsync(busImpl, pm, actionToDispatch, actionType, dispatchOpts.context)
@@ -752,6 +756,12 @@ func optimizedDispatchSync(bus *busImpl, pm *performanceManager, action any, act
 		}
 	}
 
+	// Get ordered pattern subscribers
+	var patternHandlers []*subscriptionEntry
+	if actionType != "unknown" {
+		patternHandlers = bus.getOrderedPatternSubscribers(actionType)
+	}
+
 	// Get ordered any handlers
 	var anyHandlers []*subscriptionEntry
 	if pm.subscriberPool != nil && pm.config.EnableObjectPooling {
@@ -766,7 +776,7 @@ func optimizedDispatchSync(bus *busImpl, pm *performanceManager, action any, act
 	bus.mu.RUnlock()
 
 	// Instrument dispatch with observability features
-	err := instrumentDispatch(bus, actionType, action, ctx, subscriberCount, func() error {
+	err := instrumentDispatch(bus, actionType, action, ctx, subscriberCount, len(patternHandlers), func() error {
 		bus.mu.RLock()
 		defer bus.mu.RUnlock()
 
@@ -777,6 +787,13 @@ func optimizedDispatchSync(bus *busImpl, pm *performanceManager, action any, act
 			}
 		}
 
+		// Dispatch to pattern subscribers
+		for _, entry := range patternHandlers {
+			if entry.active {
+				bus.dispatchToHandler(entry, action, ctx)
+			}
+		}
+
 		// Dispatch to any handlers
 		for _, entry := range anyHandlers {
 			if entry.active {
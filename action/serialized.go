@@ -0,0 +1,311 @@
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// defaultSerialQueueDepth is the per-key queue bound used when
+// SerialQueueDepth is not given.
+const defaultSerialQueueDepth = 64
+
+// SerialOption configures OnActionSerialized.
+type SerialOption interface {
+	applySerial(*serialOptions)
+}
+
+// serialOptions holds the internal configuration for serialized handlers.
+type serialOptions struct {
+	depth    int
+	overflow DropPolicy
+	subOpts  []SubOption
+}
+
+// SerialQueueDepth bounds how many actions may wait per key while a handler
+// for that key is running. Values below 1 keep the default of 64.
+func SerialQueueDepth(depth int) SerialOption {
+	return serialDepthOption{depth: depth}
+}
+
+type serialDepthOption struct {
+	depth int
+}
+
+func (o serialDepthOption) applySerial(opts *serialOptions) {
+	if o.depth > 0 {
+		opts.depth = o.depth
+	}
+}
+
+// SerialOverflow sets what happens when an action arrives for a key whose
+// queue is full: DropNewest (the default) discards the new action,
+// DropOldest discards the longest-waiting one and DropAll discards every
+// waiting action so only the new one remains.
+func SerialOverflow(policy DropPolicy) SerialOption {
+	return serialOverflowOption{policy: policy}
+}
+
+type serialOverflowOption struct {
+	policy DropPolicy
+}
+
+func (o serialOverflowOption) applySerial(opts *serialOptions) {
+	opts.overflow = o.policy
+}
+
+// SerialSubOptions passes subscription options (filters, priority, ...)
+// through to the underlying bus subscription.
+func SerialSubOptions(opts ...SubOption) SerialOption {
+	return serialSubOption{opts: opts}
+}
+
+type serialSubOption struct {
+	opts []SubOption
+}
+
+func (o serialSubOption) applySerial(opts *serialOptions) {
+	opts.subOpts = append(opts.subOpts, o.opts...)
+}
+
+// SerialMetrics is a snapshot of a serialized handler's queues.
+type SerialMetrics struct {
+	// Queued is the number of actions waiting across all keys, not
+	// counting the ones being handled.
+	Queued int
+	// ActiveKeys is the number of keys with a handler currently running.
+	ActiveKeys int
+	// MaxQueued is the deepest any single key's queue has been.
+	MaxQueued int
+	// Processed counts handler runs that returned without error.
+	Processed uint64
+	// Failed counts handler runs that returned an error or panicked.
+	Failed uint64
+	// Dropped counts actions discarded by the overflow policy.
+	Dropped uint64
+}
+
+// SerializedSubscription is the Subscription returned by OnActionSerialized.
+type SerializedSubscription interface {
+	Subscription
+
+	// Metrics returns a snapshot of the queue counters.
+	Metrics() SerialMetrics
+}
+
+// queuedAction is one action waiting in a key's queue.
+type queuedAction[T any] struct {
+	ctx     Context
+	payload T
+}
+
+// serialQueue runs handler for each action type it receives, one at a time
+// per key.
+type serialQueue[T any] struct {
+	bus     *busImpl
+	keyFn   func(T) string
+	handler func(Context, T) error
+	opts    serialOptions
+
+	mu       sync.Mutex
+	queues   map[string][]queuedAction[T]
+	sub      Subscription
+	disposed bool
+	metrics  SerialMetrics
+
+	// unregister removes Dispose from the bus's shutdown work
+	unregister func()
+}
+
+// OnActionSerialized registers handler for actionType so that actions with
+// the same key, as returned by keyFn, are handled one at a time in dispatch
+// order while actions with different keys are handled in parallel. Each
+// key has its own bounded FIFO queue (see SerialQueueDepth and
+// SerialOverflow); handlers run on their own goroutine, so Dispatch returns
+// as soon as the action is queued.
+//
+// Errors returned by handler, and panics, are reported to the bus error
+// handlers. Disposing the subscription discards waiting actions but lets
+// running handlers finish. When called inside a reactive cleanup scope the
+// subscription is disposed together with the scope.
+func OnActionSerialized[T any](bus Bus, actionType ActionType[T], keyFn func(payload T) string, handler func(ctx Context, payload T) error, opts ...SerialOption) SerializedSubscription {
+	q := &serialQueue[T]{
+		keyFn:   keyFn,
+		handler: handler,
+		opts:    serialOptions{depth: defaultSerialQueueDepth, overflow: DropNewest},
+		queues:  make(map[string][]queuedAction[T]),
+	}
+	for _, opt := range opts {
+		opt.applySerial(&q.opts)
+	}
+	if keyFn == nil || handler == nil {
+		q.disposed = true
+		q.sub = NewNoOpSubscription()
+		return q
+	}
+	q.bus, _ = bus.(*busImpl)
+
+	q.sub = bus.Subscribe(actionType.Name, func(action Action[string]) error {
+		var payload T
+		switch any(payload).(type) {
+		case string:
+			// Directly use the raw string payload when T is string (payload is not JSON)
+			payload = any(action.Payload).(T)
+		default:
+			if err := json.Unmarshal([]byte(action.Payload), &payload); err != nil {
+				return fmt.Errorf("failed to unmarshal payload for action %s: %w", actionType.Name, err)
+			}
+		}
+		q.enqueue(Context{
+			Meta:    action.Meta,
+			Time:    action.Time,
+			TraceID: action.TraceID,
+			Source:  action.Source,
+		}, payload)
+		return nil
+	}, q.opts.subOpts...)
+
+	reactivity.RegisterCleanup(func() {
+		q.Dispose()
+	})
+	if q.bus != nil {
+		q.unregister = q.bus.registerShutdown(func() { q.Dispose() })
+	}
+	return q
+}
+
+// enqueue adds an action to its key's queue, applying the overflow policy,
+// and starts a worker for the key if none is running.
+func (q *serialQueue[T]) enqueue(ctx Context, payload T) {
+	key := q.keyFn(payload)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.disposed {
+		return
+	}
+
+	pending, running := q.queues[key]
+	item := queuedAction[T]{ctx: ctx, payload: payload}
+	if !running {
+		// The key is idle: run the action right away
+		q.queues[key] = nil
+		q.metrics.ActiveKeys++
+		if q.bus != nil {
+			// enqueue runs inside a dispatch, so the bus is not idle here
+			q.bus.hold()
+		}
+		go q.work(key, item)
+		return
+	}
+
+	if len(pending) >= q.opts.depth {
+		switch q.opts.overflow {
+		case DropOldest:
+			pending = pending[1:]
+			q.metrics.Dropped++
+			q.metrics.Queued--
+		case DropAll:
+			q.metrics.Dropped += uint64(len(pending))
+			q.metrics.Queued -= len(pending)
+			pending = nil
+		default:
+			q.metrics.Dropped++
+			return
+		}
+	}
+	pending = append(pending, item)
+	q.queues[key] = pending
+	q.metrics.Queued++
+	if len(pending) > q.metrics.MaxQueued {
+		q.metrics.MaxQueued = len(pending)
+	}
+}
+
+// work runs item and then every action queued for key behind it. The key
+// stays in q.queues while its worker runs, which is what marks it busy.
+func (q *serialQueue[T]) work(key string, item queuedAction[T]) {
+	for {
+		err := q.run(item)
+
+		q.mu.Lock()
+		if err != nil {
+			q.metrics.Failed++
+		} else {
+			q.metrics.Processed++
+		}
+		pending := q.queues[key]
+		if len(pending) == 0 || q.disposed {
+			delete(q.queues, key)
+			q.metrics.ActiveKeys--
+			q.mu.Unlock()
+			if q.bus != nil {
+				q.bus.done()
+			}
+			return
+		}
+		item = pending[0]
+		q.queues[key] = pending[1:]
+		q.metrics.Queued--
+		q.mu.Unlock()
+	}
+}
+
+// run calls the handler, reporting errors and panics to the bus.
+func (q *serialQueue[T]) run(item queuedAction[T]) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &panicError{value: r}
+			q.report(item.ctx, err, r)
+		}
+	}()
+	if err = q.handler(item.ctx, item.payload); err != nil {
+		q.report(item.ctx, err, nil)
+	}
+	return err
+}
+
+func (q *serialQueue[T]) report(ctx Context, err error, recovered any) {
+	if q.bus != nil {
+		handleEnhancedError(q.bus, ctx, err, recovered)
+		return
+	}
+	logutil.Logf("serialized action handler failed: %v", err)
+}
+
+// Metrics returns a snapshot of the queue counters.
+func (q *serialQueue[T]) Metrics() SerialMetrics {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.metrics
+}
+
+// Dispose stops the subscription and discards waiting actions.
+func (q *serialQueue[T]) Dispose() error {
+	q.mu.Lock()
+	if q.disposed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.disposed = true
+	for key := range q.queues {
+		q.queues[key] = nil
+	}
+	q.metrics.Queued = 0
+	q.mu.Unlock()
+
+	if q.unregister != nil {
+		q.unregister()
+	}
+	return q.sub.Dispose()
+}
+
+// IsActive returns true if the subscription is active.
+func (q *serialQueue[T]) IsActive() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return !q.disposed
+}
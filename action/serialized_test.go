@@ -0,0 +1,179 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type toggleTodo struct {
+	ID  string `json:"id"`
+	Seq int    `json:"seq"`
+}
+
+var toggleTodoAction = DefineAction[toggleTodo]("todo.toggle")
+
+func dispatchToggle(t *testing.T, bus Bus, id string, seq int) {
+	t.Helper()
+	payload, _ := json.Marshal(toggleTodo{ID: id, Seq: seq})
+	if err := bus.Dispatch(Action[string]{Type: toggleTodoAction.Name, Payload: string(payload)}); err != nil {
+		t.Fatalf("Dispatch failed: %v", err)
+	}
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestOnActionSerialized_OrdersPerKey(t *testing.T) {
+	bus := New()
+
+	var mu sync.Mutex
+	order := map[string][]int{}
+	running := map[string]int{}
+	overlap := false
+	releaseA := make(chan struct{})
+
+	sub := OnActionSerialized(bus, toggleTodoAction, func(p toggleTodo) string { return p.ID },
+		func(ctx Context, p toggleTodo) error {
+			mu.Lock()
+			running[p.ID]++
+			if running[p.ID] > 1 {
+				overlap = true
+			}
+			mu.Unlock()
+
+			if p.ID == "a" && p.Seq == 1 {
+				<-releaseA
+			}
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			running[p.ID]--
+			order[p.ID] = append(order[p.ID], p.Seq)
+			mu.Unlock()
+			return nil
+		})
+	defer sub.Dispose()
+
+	for seq := 1; seq <= 4; seq++ {
+		dispatchToggle(t, bus, "a", seq)
+		dispatchToggle(t, bus, "b", seq)
+	}
+
+	// "b" is not held up by the blocked "a" handler
+	waitFor(t, "key b to drain", func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(order["b"]) == 4
+	})
+	mu.Lock()
+	if len(order["a"]) != 0 {
+		t.Errorf("key a handled %v while its first handler was blocked", order["a"])
+	}
+	mu.Unlock()
+	if m := sub.Metrics(); m.Queued != 3 || m.ActiveKeys != 1 {
+		t.Errorf("metrics while a is blocked = %+v, want Queued 3 and ActiveKeys 1", m)
+	}
+
+	close(releaseA)
+	waitFor(t, "all actions to be handled", func() bool { return sub.Metrics().Processed == 8 })
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(order["a"], want) || !reflect.DeepEqual(order["b"], want) {
+		t.Errorf("per-key order = %v, want %v for both keys", order, want)
+	}
+	if overlap {
+		t.Error("two handlers ran at the same time for one key")
+	}
+	if m := sub.Metrics(); m.Queued != 0 || m.ActiveKeys != 0 || m.MaxQueued != 3 {
+		t.Errorf("final metrics = %+v", m)
+	}
+}
+
+func TestOnActionSerialized_OverflowPolicies(t *testing.T) {
+	cases := []struct {
+		policy  DropPolicy
+		want    []int
+		dropped uint64
+	}{
+		{DropNewest, []int{1, 2, 3}, 3},
+		{DropOldest, []int{1, 5, 6}, 3},
+		{DropAll, []int{1, 6}, 4},
+	}
+	for _, tc := range cases {
+		bus := New()
+		release := make(chan struct{})
+		var mu sync.Mutex
+		var got []int
+
+		sub := OnActionSerialized(bus, toggleTodoAction, func(p toggleTodo) string { return p.ID },
+			func(ctx Context, p toggleTodo) error {
+				if p.Seq == 1 {
+					<-release
+				}
+				mu.Lock()
+				got = append(got, p.Seq)
+				mu.Unlock()
+				return nil
+			}, SerialQueueDepth(2), SerialOverflow(tc.policy))
+
+		for seq := 1; seq <= 6; seq++ {
+			dispatchToggle(t, bus, "a", seq)
+		}
+		close(release)
+		waitFor(t, "queue to drain", func() bool { return sub.Metrics().ActiveKeys == 0 })
+
+		mu.Lock()
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("policy %d: handled %v, want %v", tc.policy, got, tc.want)
+		}
+		mu.Unlock()
+		if m := sub.Metrics(); m.Dropped != tc.dropped {
+			t.Errorf("policy %d: Dropped = %d, want %d", tc.policy, m.Dropped, tc.dropped)
+		}
+		sub.Dispose()
+	}
+}
+
+func TestOnActionSerialized_ReportsErrors(t *testing.T) {
+	bus := New()
+
+	errs := make(chan error, 2)
+	bus.OnError(func(ctx Context, err error, recovered any) {
+		errs <- err
+	})
+
+	sub := OnActionSerialized(bus, toggleTodoAction, func(p toggleTodo) string { return p.ID },
+		func(ctx Context, p toggleTodo) error {
+			if p.Seq == 1 {
+				return errors.New("toggle failed")
+			}
+			panic("toggle exploded")
+		})
+	defer sub.Dispose()
+
+	dispatchToggle(t, bus, "a", 1)
+	dispatchToggle(t, bus, "a", 2)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-errs:
+		case <-time.After(time.Second):
+			t.Fatalf("expected 2 reported errors, got %d", i)
+		}
+	}
+	waitFor(t, "failures to be counted", func() bool { return sub.Metrics().Failed == 2 })
+}
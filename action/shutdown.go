@@ -0,0 +1,149 @@
+package action
+
+import "context"
+
+// Shutdown stops the bus. Dispatch and Ask return ErrBusClosed from then
+// on, also on buses created from it with Scope; asynchronous dispatches
+// that have not started yet are dropped and OnActionSerialized queues
+// discard their waiting actions. Shutdown then waits until the handlers
+// already running have returned, including those of dispatches on buses
+// created from it with Scope, or until ctx is done, in which case it
+// returns ctx.Err(). Every subscription and query handler is then removed
+// and the bus's dev logger and debug ring buffer are released; after a
+// timeout that happens in the background once the handlers have returned.
+// Calling Shutdown again is safe; it only waits again.
+//
+// A handler must not call Shutdown on its own bus with a context that has
+// no deadline: Shutdown would wait for that handler to return.
+func (b *busImpl) Shutdown(ctx context.Context) error {
+	b.closeOnce.Do(func() {
+		b.closeMu.Lock()
+		b.closed = true
+		hooks := b.onShutdown
+		b.onShutdown = nil
+		b.closeMu.Unlock()
+		for _, hook := range hooks {
+			hook()
+		}
+	})
+
+	drained := make(chan struct{})
+	go func() {
+		b.inflight.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		b.release()
+		return nil
+	case <-ctx.Done():
+		// Dispatches hold b.mu while their handlers run
+		go func() {
+			<-drained
+			b.release()
+		}()
+		return ctx.Err()
+	}
+}
+
+// acquire registers a dispatch as in flight on the bus and every parent,
+// so that shutting down any of them waits for it. It reports false once
+// the bus or one of its parents has been shut down. Each successful
+// acquire must be matched by a call to done.
+func (b *busImpl) acquire() bool {
+	// Hold every close lock so no Shutdown starts waiting between the
+	// check and the registration
+	for bus := b; bus != nil; bus = bus.parent {
+		bus.closeMu.RLock()
+		defer bus.closeMu.RUnlock()
+		if bus.closed {
+			return false
+		}
+	}
+	b.hold()
+	return true
+}
+
+// hold registers more in-flight work on the bus and every parent without
+// checking whether they are closed; callers already hold an acquire.
+func (b *busImpl) hold() {
+	for bus := b; bus != nil; bus = bus.parent {
+		bus.inflight.Add(1)
+	}
+}
+
+// done ends in-flight work registered with acquire or hold.
+func (b *busImpl) done() {
+	for bus := b; bus != nil; bus = bus.parent {
+		bus.inflight.Done()
+	}
+}
+
+// isClosed reports whether the bus or one of its parents has been shut down.
+func (b *busImpl) isClosed() bool {
+	for bus := b; bus != nil; bus = bus.parent {
+		bus.closeMu.RLock()
+		closed := bus.closed
+		bus.closeMu.RUnlock()
+		if closed {
+			return true
+		}
+	}
+	return false
+}
+
+// registerShutdown adds fn to the work Shutdown does before draining and
+// returns a function that removes it again. On a bus that is shut down
+// already fn runs right away.
+func (b *busImpl) registerShutdown(fn func()) (unregister func()) {
+	b.closeMu.Lock()
+	if b.closed {
+		b.closeMu.Unlock()
+		fn()
+		return func() {}
+	}
+	if b.onShutdown == nil {
+		b.onShutdown = make(map[uint64]func())
+	}
+	b.shutdownSeq++
+	id := b.shutdownSeq
+	b.onShutdown[id] = fn
+	b.closeMu.Unlock()
+	return func() {
+		b.closeMu.Lock()
+		delete(b.onShutdown, id)
+		b.closeMu.Unlock()
+	}
+}
+
+// release drops every subscription and the observability state of a shut
+// down bus.
+func (b *busImpl) release() {
+	b.mu.Lock()
+	for _, entries := range b.subscribers {
+		deactivate(entries)
+	}
+	for _, entries := range b.exactPatterns {
+		deactivate(entries)
+	}
+	for _, entries := range b.prefixPatterns {
+		deactivate(entries)
+	}
+	deactivate(b.anyHandlers)
+	b.subscribers = make(map[string][]*subscriptionEntry)
+	b.anyHandlers = make([]*subscriptionEntry, 0)
+	b.exactPatterns = nil
+	b.prefixPatterns = nil
+	b.queryHandlers = make(map[string]*queryHandlerEntry)
+	b.mu.Unlock()
+
+	busObsMutex.Lock()
+	delete(busObservability, b)
+	busObsMutex.Unlock()
+}
+
+func deactivate(entries []*subscriptionEntry) {
+	for _, entry := range entries {
+		entry.active = false
+	}
+}
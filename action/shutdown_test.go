@@ -0,0 +1,184 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShutdownDrainsInFlightAsyncHandler(t *testing.T) {
+	bus := New()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var finished atomic.Bool
+	bus.Subscribe("slow", func(Action[string]) error {
+		close(started)
+		<-release
+		finished.Store(true)
+		return nil
+	})
+
+	if err := bus.Dispatch("slow", WithAsync()); err != nil {
+		t.Fatalf("Dispatch failed: %v", err)
+	}
+	<-started
+
+	result := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		result <- bus.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		t.Fatalf("Shutdown returned %v while a handler was running", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	if err := <-result; err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if !finished.Load() {
+		t.Error("Shutdown returned before the handler finished")
+	}
+}
+
+func TestShutdownRejectsLaterDispatches(t *testing.T) {
+	bus := New()
+	calls := 0
+	bus.Subscribe("ping", func(Action[string]) error {
+		calls++
+		return nil
+	})
+	bus.HandleQuery("q", func(Action[string]) (any, error) { return "ok", nil })
+	scoped := bus.Scope("child")
+
+	if err := bus.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if err := bus.Dispatch("ping"); !errors.Is(err, ErrBusClosed) {
+		t.Errorf("Dispatch after Shutdown = %v, want ErrBusClosed", err)
+	}
+	if err := bus.Dispatch("ping", WithAsync()); !errors.Is(err, ErrBusClosed) {
+		t.Errorf("async Dispatch after Shutdown = %v, want ErrBusClosed", err)
+	}
+	if err := scoped.Dispatch("ping"); !errors.Is(err, ErrBusClosed) {
+		t.Errorf("Dispatch on a scoped bus = %v, want ErrBusClosed", err)
+	}
+	if _, err := bus.Ask("q", Action[string]{Type: "q"}); !errors.Is(err, ErrBusClosed) {
+		t.Errorf("Ask after Shutdown = %v, want ErrBusClosed", err)
+	}
+	if calls != 0 {
+		t.Errorf("handler ran %d times after Shutdown", calls)
+	}
+
+	if err := bus.Shutdown(context.Background()); err != nil {
+		t.Errorf("second Shutdown = %v, want nil", err)
+	}
+}
+
+func TestShutdownHonoursDeadline(t *testing.T) {
+	bus := New()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	bus.Subscribe("stuck", func(Action[string]) error {
+		close(started)
+		<-release
+		return nil
+	})
+	bus.Dispatch("stuck", WithAsync())
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := bus.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestShutdownReleasesObservability(t *testing.T) {
+	bus := New()
+	EnableDebugRingBuffer(bus, 4)
+	EnableDevLogger(bus, func(DevLogEntry) {})
+	bus.Dispatch("tick")
+
+	bus.Shutdown(context.Background())
+	busObsMutex.RLock()
+	_, kept := busObservability[bus.(*busImpl)]
+	busObsMutex.RUnlock()
+	if kept {
+		t.Error("Shutdown kept the bus's observability state")
+	}
+}
+
+func TestShutdownDiscardsSerializedBacklog(t *testing.T) {
+	bus := New()
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var handled atomic.Int32
+	sub := OnActionSerialized(bus, toggleTodoAction, func(p toggleTodo) string { return p.ID },
+		func(ctx Context, p toggleTodo) error {
+			started <- struct{}{}
+			<-release
+			handled.Add(1)
+			return nil
+		})
+	for seq := 1; seq <= 3; seq++ {
+		dispatchToggle(t, bus, "a", seq)
+	}
+	<-started
+
+	result := make(chan error, 1)
+	go func() { result <- bus.Shutdown(context.Background()) }()
+	waitFor(t, "the queue to be disposed", func() bool { return !sub.IsActive() })
+	close(release)
+	if err := <-result; err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if got := handled.Load(); got != 1 {
+		t.Errorf("handled %d actions, want only the running one", got)
+	}
+}
+
+func TestShutdownDrainsScopedDispatches(t *testing.T) {
+	bus := New()
+	child := bus.Scope("child")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var finished atomic.Bool
+	child.Subscribe("slow", func(Action[string]) error {
+		close(started)
+		<-release
+		finished.Store(true)
+		return nil
+	})
+
+	if err := child.Dispatch("slow", WithAsync()); err != nil {
+		t.Fatalf("Dispatch failed: %v", err)
+	}
+	<-started
+
+	result := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		result <- bus.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		t.Fatalf("Shutdown returned %v while a scoped handler was running", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	if err := <-result; err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if !finished.Load() {
+		t.Error("Shutdown returned before the scoped handler finished")
+	}
+}
@@ -0,0 +1,99 @@
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ValidationError is returned by Dispatch, and passed to the bus's OnError
+// handlers, for an action whose payload the validator of its ActionType
+// rejected.
+type ValidationError struct {
+	ActionType string // The action type name
+	Payload    any    // The rejected payload, as dispatched
+	Err        error  // The error returned by the validator
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid payload for action %s: %v", e.ActionType, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Payload validators of the action types defined with
+// DefineActionWithValidation, by action type name
+var (
+	validators   = make(map[string]func(payload any) error)
+	validatorsMu sync.RWMutex
+)
+
+// DefineActionWithValidation creates a new ActionType whose payloads are
+// checked with validate on Dispatch. An action the validator returns an
+// error for never reaches subscribers: Dispatch returns a *ValidationError,
+// which is also passed to the bus's OnError handlers along with the
+// payload. String payloads of non-string types are decoded from JSON first,
+// as OnAction does; a payload that cannot be decoded is rejected too.
+//
+// Use WithoutValidation to skip the check for trusted internal dispatches.
+func DefineActionWithValidation[T any](name string, validate func(T) error) ActionType[T] {
+	validatorsMu.Lock()
+	validators[name] = func(payload any) error {
+		value, err := decodePayload[T](payload)
+		if err != nil {
+			return err
+		}
+		return validate(value)
+	}
+	validatorsMu.Unlock()
+	return DefineAction[T](name)
+}
+
+// decodePayload converts a dispatched payload to T.
+func decodePayload[T any](payload any) (T, error) {
+	var value T
+	if v, ok := payload.(T); ok {
+		return v, nil
+	}
+	if s, ok := payload.(string); ok {
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			return value, fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+		return value, nil
+	}
+	return value, fmt.Errorf("payload has type %T, want %T", payload, value)
+}
+
+// validateAction runs the validator registered for actionType, if any, on
+// the payload of action.
+func validateAction(actionType string, action any) error {
+	validatorsMu.RLock()
+	validate := validators[actionType]
+	validatorsMu.RUnlock()
+	if validate == nil {
+		return nil
+	}
+	_, payload := patternPayload(action)
+	if err := validate(payload); err != nil {
+		return &ValidationError{ActionType: actionType, Payload: payload, Err: err}
+	}
+	return nil
+}
+
+// rejectInvalid validates action unless the dispatch skips validation. A
+// rejected action is counted and reported to the error handlers of the bus
+// with its payload.
+func (b *busImpl) rejectInvalid(action any, actionType string, opts *dispatchOptions) error {
+	if opts.skipValidation {
+		return nil
+	}
+	err := validateAction(actionType, action)
+	if err == nil {
+		return nil
+	}
+	getObservabilityManager(b).countRejected()
+	handleEnhancedError(b, opts.context, err, err.(*ValidationError).Payload)
+	return err
+}
@@ -0,0 +1,106 @@
+package action
+
+import (
+	"errors"
+	"testing"
+)
+
+var errNotPositive = errors.New("amount must be positive")
+
+func positive(n int) error {
+	if n <= 0 {
+		return errNotPositive
+	}
+	return nil
+}
+
+func TestDefineActionWithValidation_RejectsInvalidPayloads(t *testing.T) {
+	bus := New()
+	add := DefineActionWithValidation[int]("test.validation.add", positive)
+
+	var delivered []string
+	bus.Subscribe(add.Name, func(act Action[string]) error {
+		delivered = append(delivered, act.Payload)
+		return nil
+	})
+
+	if err := bus.Dispatch(Action[string]{Type: add.Name, Payload: "2"}); err != nil {
+		t.Fatalf("valid payload rejected: %v", err)
+	}
+
+	for _, payload := range []string{"-1", `"one"`} {
+		err := bus.Dispatch(Action[string]{Type: add.Name, Payload: payload})
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("Dispatch(%s) = %v, want a *ValidationError", payload, err)
+		}
+		if verr.ActionType != add.Name || verr.Payload != payload {
+			t.Errorf("ValidationError = %+v, want the action type and payload %s", verr, payload)
+		}
+	}
+	if !errors.Is(bus.Dispatch(Action[string]{Type: add.Name, Payload: "0"}), errNotPositive) {
+		t.Error("ValidationError does not wrap the validator's error")
+	}
+
+	// A payload of the wrong type is rejected as well
+	if err := bus.Dispatch(Action[any]{Type: add.Name, Payload: 1.5}); err == nil {
+		t.Error("float payload for an int action was not rejected")
+	}
+	if err := bus.Dispatch(Action[any]{Type: add.Name, Payload: 3}); err != nil {
+		t.Errorf("typed valid payload rejected: %v", err)
+	}
+
+	// Asynchronous dispatches are rejected before they are scheduled
+	if err := bus.Dispatch(Action[string]{Type: add.Name, Payload: "-5"}, WithAsync()); err == nil {
+		t.Error("async dispatch of an invalid payload was not rejected")
+	}
+
+	if len(delivered) != 1 || delivered[0] != "2" {
+		t.Errorf("subscriber received %v, want only the valid payload", delivered)
+	}
+	if got := GetObservabilityStats(bus).RejectedActions; got != 5 {
+		t.Errorf("RejectedActions = %d, want 5", got)
+	}
+}
+
+func TestDefineActionWithValidation_RoutesToOnError(t *testing.T) {
+	bus := New()
+	add := DefineActionWithValidation[int]("test.validation.routed", positive)
+
+	var gotErr error
+	var gotPayload any
+	bus.OnError(func(ctx Context, err error, recovered any) {
+		gotErr, gotPayload = err, recovered
+	})
+
+	bus.Dispatch(Action[string]{Type: add.Name, Payload: "-3"}, WithTrace("trace-1"))
+	if !errors.Is(gotErr, errNotPositive) {
+		t.Errorf("OnError got %v, want the validation error", gotErr)
+	}
+	if gotPayload != "-3" {
+		t.Errorf("OnError got payload %v, want -3", gotPayload)
+	}
+}
+
+func TestWithoutValidation_BypassesValidator(t *testing.T) {
+	bus := New()
+	add := DefineActionWithValidation[int]("test.validation.trusted", positive)
+
+	var delivered []string
+	bus.Subscribe(add.Name, func(act Action[string]) error {
+		delivered = append(delivered, act.Payload)
+		return nil
+	})
+	errorsReported := 0
+	bus.OnError(func(Context, error, any) { errorsReported++ })
+
+	if err := bus.Dispatch(Action[string]{Type: add.Name, Payload: "-1"}, WithoutValidation()); err != nil {
+		t.Fatalf("Dispatch with WithoutValidation = %v", err)
+	}
+	if len(delivered) != 1 || delivered[0] != "-1" {
+		t.Errorf("subscriber received %v, want the unvalidated payload", delivered)
+	}
+	if errorsReported != 0 || GetObservabilityStats(bus).RejectedActions != 0 {
+		t.Error("bypassed dispatch was reported as rejected")
+	}
+}
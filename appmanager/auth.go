@@ -0,0 +1,138 @@
+package appmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// AuthState holds the current session. The zero value means signed out.
+type AuthState struct {
+	User      any
+	Token     string
+	ExpiresAt time.Time // zero means the session does not expire
+}
+
+// IsAuthenticated reports whether the session has a token that has not expired.
+func (a AuthState) IsAuthenticated() bool {
+	return a.Token != "" && (a.ExpiresAt.IsZero() || time.Now().Before(a.ExpiresAt))
+}
+
+// RouteGuard decides whether navigation to path may proceed. When it is
+// rejected, redirect names the path to navigate to instead.
+type RouteGuard func(path string) (redirect string, allowed bool)
+
+// authSlice is the manager-owned session state and its expiry timer.
+type authSlice struct {
+	state reactivity.Signal[AuthState]
+	mu    sync.Mutex // guards timer, which fires on another goroutine natively
+	timer *time.Timer
+}
+
+// Auth returns the reactive session state.
+func (am *AppManager) Auth() reactivity.Signal[AuthState] {
+	return am.auth.state
+}
+
+// SetAuth replaces the session and schedules EventAuthExpired for its
+// ExpiresAt. Passing the zero AuthState signs out. When PersistAuth is
+// enabled the token and expiry are saved to storage.
+func (am *AppManager) SetAuth(auth AuthState) {
+	am.auth.state.Set(auth)
+	am.scheduleAuthExpiry(auth)
+	if am.config.PersistAuth {
+		saveAuth(am.authStorageKey(), auth)
+	}
+}
+
+// ClearAuth signs out.
+func (am *AppManager) ClearAuth() {
+	am.SetAuth(AuthState{})
+}
+
+// scheduleAuthExpiry (re)arms the expiry timer. On js/wasm time.AfterFunc is
+// backed by a JS timer.
+func (am *AppManager) scheduleAuthExpiry(auth AuthState) {
+	am.stopAuthTimer()
+	if auth.Token == "" || auth.ExpiresAt.IsZero() {
+		return
+	}
+	am.auth.mu.Lock()
+	defer am.auth.mu.Unlock()
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Until(auth.ExpiresAt), func() {
+		// Ignore a timer that was replaced by a later SetAuth
+		am.auth.mu.Lock()
+		current := am.auth.timer == timer
+		am.auth.mu.Unlock()
+		if current {
+			am.expireAuth(auth)
+		}
+	})
+	am.auth.timer = timer
+}
+
+// stopAuthTimer cancels a pending expiry.
+func (am *AppManager) stopAuthTimer() {
+	am.auth.mu.Lock()
+	defer am.auth.mu.Unlock()
+	if am.auth.timer != nil {
+		am.auth.timer.Stop()
+		am.auth.timer = nil
+	}
+}
+
+// expireAuth signs out and runs EventAuthExpired hooks with the expired session.
+// Hooks may call SetAuth to install a refreshed session.
+func (am *AppManager) expireAuth(expired AuthState) {
+	am.ClearAuth()
+	if err := am.lifecycle.ExecuteHooks(EventAuthExpired, &LifecycleContext{Event: EventAuthExpired, Manager: am, Data: expired}); err != nil {
+		logutil.Logf("authExpired hooks failed: %v", err)
+	}
+}
+
+// restoreAuth loads a persisted session, if persistence is enabled.
+func (am *AppManager) restoreAuth() {
+	if !am.config.PersistAuth {
+		return
+	}
+	if auth, ok := loadAuth(am.authStorageKey()); ok && auth.IsAuthenticated() {
+		am.SetAuth(auth)
+	}
+}
+
+func (am *AppManager) authStorageKey() string {
+	return am.config.AppID + ":auth"
+}
+
+// AddGuard registers a guard consulted by Navigate before every navigation.
+func (am *AppManager) AddGuard(guard RouteGuard) {
+	am.guards = append(am.guards, guard)
+}
+
+// applyGuards returns the path navigation should end up at after guards run.
+func (am *AppManager) applyGuards(path string) string {
+	for _, guard := range am.guards {
+		if redirect, ok := guard(path); !ok && redirect != "" {
+			return redirect
+		}
+	}
+	return path
+}
+
+// RequireAuth returns a guard that redirects unauthenticated navigation to the
+// configured LoginPath. Navigation to the login path itself is always allowed.
+func RequireAuth(am *AppManager) RouteGuard {
+	return func(path string) (string, bool) {
+		login := am.config.LoginPath
+		if login == "" {
+			login = "/login"
+		}
+		if path == login || am.auth.state.Get().IsAuthenticated() {
+			return "", true
+		}
+		return login, false
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !js && !wasm
+
+package appmanager
+
+// saveAuth is a no-op outside the browser.
+func saveAuth(key string, auth AuthState) {}
+
+// loadAuth finds nothing outside the browser.
+func loadAuth(key string) (AuthState, bool) { return AuthState{}, false }
@@ -0,0 +1,49 @@
+//go:build js && wasm
+
+package appmanager
+
+import (
+	"encoding/json"
+	"syscall/js"
+	"time"
+)
+
+// persistedAuth is the stored form of a session; User is not persisted.
+type persistedAuth struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// saveAuth stores the token and expiry in localStorage, or removes them on sign-out.
+func saveAuth(key string, auth AuthState) {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return
+	}
+	if auth.Token == "" {
+		storage.Call("removeItem", key)
+		return
+	}
+	data, err := json.Marshal(persistedAuth{Token: auth.Token, ExpiresAt: auth.ExpiresAt})
+	if err != nil {
+		return
+	}
+	storage.Call("setItem", key, string(data))
+}
+
+// loadAuth reads a session saved by saveAuth.
+func loadAuth(key string) (AuthState, bool) {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return AuthState{}, false
+	}
+	item := storage.Call("getItem", key)
+	if item.IsNull() || item.IsUndefined() {
+		return AuthState{}, false
+	}
+	var p persistedAuth
+	if err := json.Unmarshal([]byte(item.String()), &p); err != nil {
+		return AuthState{}, false
+	}
+	return AuthState{Token: p.Token, ExpiresAt: p.ExpiresAt}, true
+}
@@ -0,0 +1,78 @@
+package appmanager
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAppManager_AuthExpiryFiresHook(t *testing.T) {
+	manager := NewAppManager(nil)
+
+	expired := make(chan AuthState, 1)
+	manager.AddHook(EventAuthExpired, func(ctx *LifecycleContext) error {
+		expired <- ctx.Data.(AuthState)
+		return nil
+	})
+
+	manager.SetAuth(AuthState{User: "ada", Token: "t1", ExpiresAt: time.Now().Add(20 * time.Millisecond)})
+	if !manager.Auth().Get().IsAuthenticated() {
+		t.Fatal("Expected session to be authenticated before expiry")
+	}
+
+	select {
+	case got := <-expired:
+		if got.Token != "t1" {
+			t.Errorf("Expected expired session token t1, got %q", got.Token)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected authExpired hook to fire")
+	}
+
+	if manager.Auth().Get().IsAuthenticated() {
+		t.Error("Expected session to be cleared after expiry")
+	}
+}
+
+func TestAppManager_SetAuthReschedulesExpiry(t *testing.T) {
+	manager := NewAppManager(nil)
+
+	fired := make(chan struct{}, 2)
+	manager.AddHook(EventAuthExpired, func(ctx *LifecycleContext) error {
+		fired <- struct{}{}
+		return nil
+	})
+
+	manager.SetAuth(AuthState{Token: "old", ExpiresAt: time.Now().Add(20 * time.Millisecond)})
+	manager.SetAuth(AuthState{Token: "new", ExpiresAt: time.Now().Add(time.Hour)})
+	defer manager.Cleanup()
+
+	select {
+	case <-fired:
+		t.Fatal("Expected replaced session not to expire")
+	case <-time.After(60 * time.Millisecond):
+	}
+	if manager.Auth().Get().Token != "new" {
+		t.Errorf("Expected refreshed session to remain, got %q", manager.Auth().Get().Token)
+	}
+}
+
+func TestRequireAuth_RedirectsToLogin(t *testing.T) {
+	manager := NewAppManager(&AppConfig{AppID: "test-app", MountElementID: "app", LoginPath: "/signin"})
+	manager.AddGuard(RequireAuth(manager))
+	manager.running.Set(true)
+
+	if err := manager.Navigate("/account"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := manager.GetState().Router.CurrentPath; got != "/signin" {
+		t.Errorf("Expected redirect to /signin, got %q", got)
+	}
+
+	manager.SetAuth(AuthState{Token: "t"})
+	if err := manager.Navigate("/account"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := manager.GetState().Router.CurrentPath; got != "/account" {
+		t.Errorf("Expected authenticated navigation to /account, got %q", got)
+	}
+}
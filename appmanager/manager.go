@@ -20,6 +20,8 @@ type AppManager struct {
 	running      reactivity.Signal[bool]
 	cleanupScope *reactivity.CleanupScope
 	disposer     func()
+	auth         authSlice
+	guards       []RouteGuard
 }
 
 // NewAppManager constructs a new AppManager with given or default config
@@ -33,6 +35,7 @@ func NewAppManager(config *AppConfig) *AppManager {
 		initialized:  reactivity.CreateSignal(false),
 		running:      reactivity.CreateSignal(false),
 		cleanupScope: reactivity.NewCleanupScope(nil),
+		auth:         authSlice{state: reactivity.CreateSignal(AuthState{})},
 	}
 	// Initialize store immediately so tests can verify initial state pre-initialize
 	am.store = NewAppStore(config.InitialState, config.PersistenceKey)
@@ -60,6 +63,9 @@ func (am *AppManager) Initialize(ctx context.Context) error { // ctx reserved fo
 
 	// Defer router creation to Mount where we can target #router-outlet
 
+	// Restore a persisted session (opt-in via PersistAuth)
+	am.restoreAuth()
+
 	// Mark initialized and update lifecycle state
 	am.initialized.Set(true)
 	am.lifecycle.setState(LifecycleStateInitialized)
@@ -83,6 +89,7 @@ func (am *AppManager) Navigate(path string, opts ...router.NavigateOptions) erro
 	if !am.running.Get() {
 		return fmt.Errorf("app manager not running")
 	}
+	path = am.applyGuards(path)
 	if am.router != nil {
 		// Delegate to router; callbacks will handle hooks and store updates
 		var options router.NavigateOptions
@@ -121,6 +128,7 @@ func (am *AppManager) Cleanup() {
 	if am.cleanupScope != nil {
 		am.cleanupScope.Dispose()
 	}
+	am.stopAuthTimer()
 	am.running.Set(false)
 	am.lifecycle.setState(LifecycleStateStopped)
 }
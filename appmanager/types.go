@@ -30,6 +30,7 @@ const (
     EventAfterUnmount  LifecycleEvent = "afterUnmount"
     EventAppReady      LifecycleEvent = "appReady"
     EventError         LifecycleEvent = "error"
+    EventAuthExpired   LifecycleEvent = "authExpired" // session ExpiresAt passed; Data is the expired AuthState
 )
 
 // LifecycleContext provides contextual information to hooks.
@@ -72,6 +73,8 @@ type AppConfig struct {
     PersistenceKey    string
     EnableRouter      bool
     EnablePersistence bool
+    PersistAuth       bool   // opt-in: save the auth token and expiry to storage
+    LoginPath         string // redirect target for RequireAuth; defaults to "/login"
     Timeout           time.Duration
     OnReady           func(*AppManager) error
     OnError           func(error)
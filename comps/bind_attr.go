@@ -0,0 +1,254 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strings"
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type attrBinder struct {
+	apply     func(el js.Value) // updates the element, reading the signals it follows
+	container string            // elementID of the mounted container
+	effect    reactivity.Effect // effect for reactive updates
+}
+
+var attrRegistry = map[string]attrBinder{}
+
+// attrBindingPrefix starts the name of the attribute marking each binding
+// of an element; an element may have several, e.g. a class and an aria-*
+// binding.
+const attrBindingPrefix = "data-uiwgo-attr-"
+
+// BindAttr keeps the attribute name of the enclosing element set to fn's
+// result, updating only the attribute when the signals fn reads change. An
+// empty result removes the attribute. Use it as a child of the element:
+//
+//	g.El("button", comps.BindAttr("aria-pressed", func() string {
+//		return strconv.FormatBool(active.Get())
+//	}), g.Text("Bold"))
+func BindAttr(name string, fn func() string) g.Node {
+	binding := bindAttrs(func(el js.Value) {
+		if value := fn(); value != "" {
+			el.Call("setAttribute", name, value)
+		} else {
+			el.Call("removeAttribute", name)
+		}
+	})
+	if initial := reactivity.Untrack(fn); initial != "" {
+		return g.Group([]g.Node{binding, g.Attr(name, initial)})
+	}
+	return binding
+}
+
+// BindClass adds the space-separated classes returned by fn to the
+// enclosing element, replacing the ones it added before when the signals
+// fn reads change. Classes set in the markup or by other bindings are left
+// alone. The classes are applied when the element is mounted.
+func BindClass(fn func() string) g.Node {
+	var added []string
+	return bindAttrs(func(el js.Value) {
+		next := strings.Fields(fn())
+		classList := el.Get("classList")
+		for _, class := range added {
+			if !containsString(next, class) {
+				classList.Call("remove", class)
+			}
+		}
+		for _, class := range next {
+			classList.Call("add", class)
+		}
+		added = next
+	})
+}
+
+// BindClassMap toggles each class in the map returned by fn on the
+// enclosing element: true adds it, false removes it. A class dropped from
+// the map is removed if the map had it set. Other classes are left alone.
+func BindClassMap(fn func() map[string]bool) g.Node {
+	var added []string
+	return bindAttrs(func(el js.Value) {
+		classes := fn()
+		classList := el.Get("classList")
+		for _, class := range added {
+			if _, ok := classes[class]; !ok {
+				classList.Call("remove", class)
+			}
+		}
+		added = added[:0]
+		for class, on := range classes {
+			classList.Call("toggle", class, on)
+			if on {
+				added = append(added, class)
+			}
+		}
+	})
+}
+
+// BindStyle sets the inline style properties in the map returned by fn on
+// the enclosing element. When the signals fn reads change, only properties
+// whose value differs from the element's are set, and those dropped from
+// the map are removed; other inline styles are left alone. Names may be
+// given in CSS (background-color) or camelCase (backgroundColor) form:
+//
+//	h.Div(h.Style("padding: 1rem"), comps.BindStyle(func() map[string]string {
+//		if !inStock.Get() {
+//			return map[string]string{"opacity": "0.6"}
+//		}
+//		return nil
+//	}))
+func BindStyle(fn func() map[string]string) g.Node {
+	var set []string
+	return bindAttrs(func(el js.Value) {
+		style := el.Get("style")
+		next := make([]string, 0, len(set))
+		for name, value := range fn() {
+			name = cssPropertyName(name)
+			next = append(next, name)
+			if style.Call("getPropertyValue", name).String() != value {
+				style.Call("setProperty", name, value)
+			}
+		}
+		for _, name := range set {
+			if !containsString(next, name) {
+				style.Call("removeProperty", name)
+			}
+		}
+		set = next
+	})
+}
+
+// cssPropertyName converts a camelCase style property name, such as
+// backgroundColor or WebkitTransform, to its CSS form. Names with a dash,
+// custom properties included, are returned unchanged.
+func cssPropertyName(name string) string {
+	if strings.Contains(name, "-") {
+		return name
+	}
+	var b strings.Builder
+	if strings.HasPrefix(name, "ms") && len(name) > 2 && name[2] >= 'A' && name[2] <= 'Z' {
+		// Microsoft's prefix is lower case in camelCase
+		b.WriteByte('-')
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c >= 'A' && c <= 'Z' {
+			b.WriteByte('-')
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// bindAttrs registers apply to run in an effect against the enclosing
+// element once it is attached.
+func bindAttrs(apply func(el js.Value)) g.Node {
+	id := nextID("a")
+	attrRegistry[id] = attrBinder{apply: apply, container: getCurrentMountContainer()}
+	return g.Group([]g.Node{
+		g.Attr("data-uiwgo-attr", ""),
+		g.Attr(attrBindingPrefix+id, ""),
+	})
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// attrBindingIDs returns the ids of the attribute bindings of el.
+func attrBindingIDs(el js.Value) []string {
+	var ids []string
+	attrs := el.Get("attributes")
+	for i := 0; i < attrs.Length(); i++ {
+		if name := attrs.Index(i).Get("name").String(); strings.HasPrefix(name, attrBindingPrefix) {
+			ids = append(ids, strings.TrimPrefix(name, attrBindingPrefix))
+		}
+	}
+	return ids
+}
+
+// attachAttrBindersIn attaches the attribute bindings of root and the
+// elements under it. Rows of a For bind their own element this way.
+func attachAttrBindersIn(root js.Value) {
+	if root.Call("matches", "[data-uiwgo-attr]").Bool() {
+		attachAttrBindings(root)
+	}
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-attr]")
+	for i := 0; i < nodes.Length(); i++ {
+		attachAttrBindings(nodes.Index(i))
+	}
+}
+
+func attachAttrBindings(el js.Value) {
+	// avoid duplicate attachment
+	if el.Call("hasAttribute", "data-uiwgo-bound-attr").Bool() {
+		return
+	}
+	el.Call("setAttribute", "data-uiwgo-bound-attr", "1")
+
+	for _, id := range attrBindingIDs(el) {
+		binder, ok := attrRegistry[id]
+		if !ok {
+			continue
+		}
+		if binder.effect != nil {
+			binder.effect.Dispose()
+		}
+		if binder.container == "" {
+			binder.container = getCurrentMountContainer()
+		}
+		apply := binder.apply
+		binder.effect = reactivity.CreateRenderEffect(func() {
+			apply(el)
+		})
+		attrRegistry[id] = binder
+	}
+
+	// Register element with current scope for mutation observer tracking
+	if currentScope := reactivity.GetCurrentCleanupScope(); currentScope != nil {
+		dom.RegisterElementScope(el, currentScope)
+	}
+}
+
+// cleanupAttrBindersIn disposes the attribute bindings of node and the
+// elements under it, keeping them registered so they can be attached again.
+func cleanupAttrBindersIn(node js.Value) {
+	elements := js.Global().Get("Array").Call("from", node.Call("querySelectorAll", "[data-uiwgo-attr]"))
+	if node.Call("matches", "[data-uiwgo-attr]").Bool() {
+		elements.Call("push", node)
+	}
+	for i := 0; i < elements.Length(); i++ {
+		el := elements.Index(i)
+		el.Call("removeAttribute", "data-uiwgo-bound-attr")
+		for _, id := range attrBindingIDs(el) {
+			if binder, ok := attrRegistry[id]; ok && binder.effect != nil {
+				binder.effect.Dispose()
+				binder.effect = nil
+				attrRegistry[id] = binder
+			}
+		}
+	}
+}
+
+// cleanupAttrBindersForContainer disposes and forgets the attribute
+// bindings of a container being unmounted.
+func cleanupAttrBindersForContainer(containerID string) {
+	for id, binder := range attrRegistry {
+		if binder.container == containerID {
+			if binder.effect != nil {
+				binder.effect.Dispose()
+			}
+			delete(attrRegistry, id)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestBindAttrUpdatesOnlyTheAttribute(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	label := reactivity.CreateSignal("Close")
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div", g.Attr("class", "dialog"),
+			BindAttr("aria-label", label.Get),
+			g.El("input", g.Attr("class", "name")),
+		)
+	})
+	defer disposer()
+
+	dialog := container.Call("querySelector", ".dialog")
+	input := container.Call("querySelector", ".name")
+	input.Set("value", "typed")
+	if got := dialog.Call("getAttribute", "aria-label").String(); got != "Close" {
+		t.Fatalf("initial aria-label = %q, want Close", got)
+	}
+
+	label.Set("Dismiss")
+	if got := dialog.Call("getAttribute", "aria-label").String(); got != "Dismiss" {
+		t.Errorf("aria-label = %q, want Dismiss", got)
+	}
+	if !container.Call("querySelector", ".name").Equal(input) || input.Get("value").String() != "typed" {
+		t.Error("updating the attribute touched the children")
+	}
+
+	label.Set("")
+	if dialog.Call("hasAttribute", "aria-label").Bool() {
+		t.Error("empty value did not remove the attribute")
+	}
+}
+
+func TestBindClassKeepsStaticClasses(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	filter := reactivity.CreateSignal("all")
+	tab := func(name string) g.Node {
+		return g.El("button", g.Attr("class", "tab tab-"+name),
+			BindClass(func() string {
+				if filter.Get() == name {
+					return "active selected"
+				}
+				return ""
+			}),
+			g.Text(name),
+		)
+	}
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("nav", tab("all"), tab("following"))
+	})
+	defer disposer()
+
+	all := container.Call("querySelector", ".tab-all")
+	following := container.Call("querySelector", ".tab-following")
+	if got := all.Get("className").String(); got != "tab tab-all active selected" {
+		t.Fatalf("active tab classes = %q", got)
+	}
+
+	filter.Set("following")
+	if got := all.Get("className").String(); got != "tab tab-all" {
+		t.Errorf("inactive tab classes = %q, want tab tab-all", got)
+	}
+	if !hasClass(following, "active") || !hasClass(following, "tab") {
+		t.Errorf("active tab classes = %q", following.Get("className").String())
+	}
+}
+
+func TestBindClassMapTogglesClasses(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	loading := reactivity.CreateSignal(false)
+	items := reactivity.CreateSignal([]string{"a", "b"})
+	selected := reactivity.CreateSignal("a")
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[string]{
+			Items: items,
+			Key:   func(s string) string { return s },
+			Children: func(s string, _ int) g.Node {
+				return g.El("li", g.Attr("class", "row-"+s),
+					BindClassMap(func() map[string]bool {
+						return map[string]bool{"selected": selected.Get() == s, "busy": loading.Get()}
+					}),
+				)
+			},
+		})
+	})
+	defer disposer()
+
+	a := container.Call("querySelector", ".row-a")
+	b := container.Call("querySelector", ".row-b")
+	if !hasClass(a, "selected") || hasClass(b, "selected") {
+		t.Fatal("rows were not bound on mount")
+	}
+
+	selected.Set("b")
+	loading.Set(true)
+	if hasClass(a, "selected") || !hasClass(b, "selected") {
+		t.Error("selected class was not toggled")
+	}
+	if !hasClass(a, "busy") || !hasClass(b, "busy") || !hasClass(a, "row-a") {
+		t.Errorf("row classes = %q, %q", a.Get("className").String(), b.Get("className").String())
+	}
+}
+
+func TestBindStyleSetsAndRemovesProperties(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	inStock := reactivity.CreateSignal(false)
+	accent := reactivity.CreateSignal("red")
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div", g.Attr("class", "card"), g.Attr("style", "padding: 4px"),
+			BindStyle(func() map[string]string {
+				styles := map[string]string{"backgroundColor": accent.Get(), "--accent": accent.Get()}
+				if !inStock.Get() {
+					styles["opacity"] = "0.6"
+				}
+				return styles
+			}),
+		)
+	})
+	defer disposer()
+
+	style := container.Call("querySelector", ".card").Get("style")
+	prop := func(name string) string { return style.Call("getPropertyValue", name).String() }
+	if prop("opacity") != "0.6" || prop("background-color") != "red" || prop("--accent") != "red" {
+		t.Fatalf("style after mount = %q", style.Get("cssText").String())
+	}
+
+	inStock.Set(true)
+	if prop("opacity") != "" {
+		t.Errorf("opacity = %q after it left the map, want it removed", prop("opacity"))
+	}
+	accent.Set("blue")
+	if prop("background-color") != "blue" || prop("--accent") != "blue" {
+		t.Errorf("style after the accent changed = %q", style.Get("cssText").String())
+	}
+	if prop("padding") != "4px" {
+		t.Errorf("static padding = %q, want it kept", prop("padding"))
+	}
+}
+
+func TestCSSPropertyName(t *testing.T) {
+	for name, want := range map[string]string{
+		"opacity":          "opacity",
+		"backgroundColor":  "background-color",
+		"background-color": "background-color",
+		"WebkitTransform":  "-webkit-transform",
+		"msTransform":      "-ms-transform",
+		"--main-color":     "--main-color",
+	} {
+		if got := cssPropertyName(name); got != want {
+			t.Errorf("cssPropertyName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestBindHTMLDepsRerendersOnlyOnDeclaredDeps(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	declared := reactivity.CreateSignal(1)
+	undeclared := reactivity.CreateSignal("a")
+	renders := 0
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return BindHTMLDeps(func() g.Node {
+			renders++
+			return g.El("span", g.Text(fmt.Sprintf("%d-%s", declared.Get(), undeclared.Get())))
+		}, func() any { return declared.Get() })
+	})
+	defer disposer()
+
+	text := func() string {
+		return container.Call("querySelector", "[data-uiwgo-html]").Get("textContent").String()
+	}
+	if text() != "1-a" || renders != 1 {
+		t.Fatalf("initial render: text %q, renders %d", text(), renders)
+	}
+
+	undeclared.Set("b")
+	undeclared.Set("c")
+	if renders != 1 || text() != "1-a" {
+		t.Errorf("undeclared signal should not re-render: text %q, renders %d", text(), renders)
+	}
+
+	declared.Set(2)
+	if renders != 2 || text() != "2-c" {
+		t.Errorf("declared signal should re-render: text %q, renders %d", text(), renders)
+	}
+
+	// Setting an equal value is not a change.
+	declared.Set(2)
+	if renders != 2 {
+		t.Errorf("equal dependency value should not re-render, renders %d", renders)
+	}
+
+	undeclared.Set("d")
+	if renders != 2 || text() != "2-c" {
+		t.Errorf("undeclared signal should not re-render after a declared change: text %q, renders %d", text(), renders)
+	}
+}
+
+func TestBindHTMLDepsWithoutDepsNeverRerenders(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	sig := reactivity.CreateSignal("x")
+	renders := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return BindHTMLDeps(func() g.Node {
+			renders++
+			return g.Text(sig.Get())
+		})
+	})
+	defer disposer()
+
+	sig.Set("y")
+	if renders != 1 {
+		t.Errorf("expected a single render with no deps, got %d", renders)
+	}
+}
@@ -0,0 +1,85 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"github.com/ozanturksever/uiwgo/router"
+	g "maragu.dev/gomponents"
+)
+
+// BreadcrumbsProps configures the Breadcrumbs component.
+type BreadcrumbsProps struct {
+	// Router whose matches are shown; defaults to router.Current().
+	Router *router.Router
+	// Separator is rendered between crumbs; defaults to "/".
+	Separator g.Node
+	// Render, when set, renders a crumb instead of the default link (or,
+	// for the last crumb, plain text with aria-current="page").
+	Render func(m router.Match, isLast bool) g.Node
+}
+
+// Breadcrumbs renders the matched route chain as a breadcrumb trail that
+// follows navigation. Each route contributes a crumb through its
+// Meta["breadcrumb"], either a string or a func(params map[string]string)
+// string; routes without one are skipped. Every crumb but the last links to
+// its match's Pathname with router.A.
+func Breadcrumbs(p BreadcrumbsProps) g.Node {
+	r := p.Router
+	if r == nil {
+		r = router.Current()
+	}
+	if r == nil {
+		return g.Group(nil)
+	}
+	separator := p.Separator
+	if separator == nil {
+		separator = g.Text("/")
+	}
+	matches := r.UseMatches()
+
+	return BindHTMLAs("nav", func() g.Node {
+		var crumbs []router.Match
+		for _, m := range matches.Get() {
+			if _, ok := BreadcrumbLabel(m); ok {
+				crumbs = append(crumbs, m)
+			}
+		}
+
+		items := make([]g.Node, 0, len(crumbs))
+		for i, m := range crumbs {
+			isLast := i == len(crumbs)-1
+			children := []g.Node{}
+			if i > 0 {
+				children = append(children, g.El("span", g.Attr("aria-hidden", "true"), separator))
+			}
+			if p.Render != nil {
+				children = append(children, p.Render(m, isLast))
+			} else {
+				label, _ := BreadcrumbLabel(m)
+				if isLast {
+					children = append(children, g.El("span", g.Attr("aria-current", "page"), g.Text(label)))
+				} else {
+					children = append(children, router.A(m.Pathname, label))
+				}
+			}
+			items = append(items, g.El("li", children...))
+		}
+		return g.El("ol", items...)
+	}, g.Attr("aria-label", "Breadcrumb"))
+}
+
+// BreadcrumbLabel returns the breadcrumb label of a match from its route's
+// Meta["breadcrumb"], and false when the route has none.
+func BreadcrumbLabel(m router.Match) (string, bool) {
+	if m.Route == nil {
+		return "", false
+	}
+	switch crumb := m.Route.Meta["breadcrumb"].(type) {
+	case string:
+		return crumb, true
+	case func(params map[string]string) string:
+		return crumb(m.Params), true
+	default:
+		return "", false
+	}
+}
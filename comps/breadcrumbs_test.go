@@ -0,0 +1,70 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/router"
+	g "maragu.dev/gomponents"
+)
+
+func TestBreadcrumbsFollowNestedRoutes(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-breadcrumbs")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	component := func(props ...any) interface{} { return g.Text("") }
+	users := router.Route("/users", component,
+		router.Route("/:userId", component,
+			router.Route("/settings", component),
+			router.Route("/posts/:postId", component),
+		),
+	)
+	users.Meta = map[string]any{"breadcrumb": "Users"}
+	user := users.Children[0]
+	user.Meta = map[string]any{"breadcrumb": func(params map[string]string) string { return "User " + params["userId"] }}
+	// "/settings" has no breadcrumb meta and is skipped
+	user.Children[1].Meta = map[string]any{"breadcrumb": func(params map[string]string) string { return "Post " + params["postId"] }}
+	r := router.New([]*router.RouteDefinition{users}, nil)
+
+	disposer := Mount("test-breadcrumbs", func() Node {
+		return Breadcrumbs(BreadcrumbsProps{Router: r})
+	})
+	defer disposer()
+
+	r.Navigate("/users/42/posts/7")
+
+	items := container.Call("querySelectorAll", "li")
+	if items.Length() != 3 {
+		t.Fatalf("Expected 3 crumbs, got %q", container.Get("innerHTML").String())
+	}
+	wantLinks := []struct{ text, href string }{{"Users", "/users"}, {"User 42", "/users/42"}}
+	for i, want := range wantLinks {
+		link := items.Index(i).Call("querySelector", "a")
+		if !link.Truthy() || link.Get("textContent").String() != want.text || link.Call("getAttribute", "href").String() != want.href {
+			t.Errorf("crumb %d: got %q, want link %q to %s", i, items.Index(i).Get("innerHTML").String(), want.text, want.href)
+		}
+	}
+	last := items.Index(2)
+	if last.Call("querySelector", "a").Truthy() {
+		t.Error("Expected the last crumb not to be a link")
+	}
+	if current := last.Call("querySelector", "[aria-current=page]"); !current.Truthy() || current.Get("textContent").String() != "Post 7" {
+		t.Errorf("Expected last crumb \"Post 7\", got %q", last.Get("innerHTML").String())
+	}
+
+	r.Navigate("/users/42/settings")
+	items = container.Call("querySelectorAll", "li")
+	if items.Length() != 2 || items.Index(1).Get("textContent").String() != "/User 42" {
+		t.Errorf("Expected crumbs to update and skip routes without meta, got %q", container.Get("innerHTML").String())
+	}
+}
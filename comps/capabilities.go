@@ -0,0 +1,21 @@
+//go:build js && wasm
+
+package comps
+
+import "github.com/ozanturksever/uiwgo/dom"
+
+// BrowserCapabilities reports which optional browser APIs are available;
+// see dom.BrowserCapabilities.
+type BrowserCapabilities = dom.BrowserCapabilities
+
+// Capabilities and OnMissingCapability are re-exported from dom. Helpers
+// that need a missing capability fall back instead of failing, e.g.
+// OnVisibleInline polls element positions, and tell the handlers once:
+//
+//	comps.OnMissingCapability(func(name string) {
+//		analytics.Track("degraded", name)
+//	})
+var (
+	Capabilities        = dom.Capabilities
+	OnMissingCapability = dom.OnMissingCapability
+)
@@ -4,9 +4,11 @@ package comps
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"syscall/js"
 
+	"github.com/ozanturksever/logutil"
 	"github.com/ozanturksever/uiwgo/dom"
 	"github.com/ozanturksever/uiwgo/reactivity"
 	g "maragu.dev/gomponents"
@@ -23,8 +25,31 @@ var (
 	mountQueue []func()
 	// registry of mounted containers and their cleanup scopes
 	mountedContainers = make(map[string]*MountContext)
+	// error handlers of containers mounted with MountWithRecovery
+	mountErrorHandlers = make(map[string]func(error))
+	// devMode enables development-only behaviour such as the Mount error box
+	devMode bool
+	// mountPolicy decides what mounting into an already mounted container does
+	mountPolicy = MountReplace
 )
 
+// MountPolicy decides what Mount and MountWithRecovery do when the target
+// container already holds a mounted tree.
+type MountPolicy int
+
+const (
+	// MountReplace disposes the existing tree before mounting the new one.
+	// The disposer returned for the existing tree becomes a no-op.
+	MountReplace MountPolicy = iota
+	// MountReject leaves the existing tree alone and makes Mount panic with
+	// an error wrapping ErrAlreadyMounted.
+	MountReject
+)
+
+// ErrAlreadyMounted reports a Mount into a container that already holds a
+// mounted tree under the MountReject policy.
+var ErrAlreadyMounted = errors.New("container is already mounted")
+
 // MountContext holds the cleanup scope and disposer for a mounted container
 type MountContext struct {
 	ElementID    string
@@ -36,7 +61,128 @@ type MountContext struct {
 // Mount renders a root component into a specific DOM element identified by its ID.
 // It runs any OnMount functions and attaches reactive binders.
 // Returns a disposer function that cleans up all effects, listeners, and registry entries.
+// Mounting into an element that already holds a mounted tree follows the
+// mount policy (see SetMountPolicy): by default the old tree is disposed.
+//
+// If rendering panics, everything attached so far is cleaned up and the
+// panic is re-raised; in dev mode (see SetDevMode) a minimal error box is
+// rendered into the container instead. Use MountWithRecovery for a custom
+// error screen with retry.
 func Mount(elementID string, root func() Node) func() {
+	container := mountTarget(elementID)
+	if err := claimContainer(elementID, mountPolicy); err != nil {
+		panic(err)
+	}
+	disposer, err := mount(elementID, container, root)
+	if err != nil {
+		if !devMode {
+			panic(err)
+		}
+		logutil.Logf("Mount: rendering #%s failed: %v", elementID, err)
+		container.Set("innerHTML", renderToString(mountErrorBox(err, nil)))
+		return func() { container.Set("innerHTML", "") }
+	}
+	return disposer
+}
+
+// MountWithRecovery is like Mount but shows fallback in the container when
+// the root render panics, or when a binder or an effect of the mounted tree
+// fails later (a BindHTML re-render, a For row or a panicking effect re-run)
+// and no ErrorBoundary handles the error. Calling
+// retry disposes the fallback and mounts root again in a fresh cleanup
+// scope. A nil fallback renders a minimal error box with a retry button.
+func MountWithRecovery(elementID string, root func() Node, fallback func(err error, retry func()) Node) func() {
+	container := mountTarget(elementID)
+	if err := claimContainer(elementID, mountPolicy); err != nil {
+		panic(err)
+	}
+	if fallback == nil {
+		fallback = mountErrorBox
+	}
+
+	var dispose func()
+	var attempt func()
+	showFallback := func(err error) {
+		logutil.Logf("Mount: #%s failed, showing fallback: %v", elementID, err)
+		if dispose != nil {
+			dispose()
+			dispose = nil
+		}
+		retried := false
+		retry := func() {
+			if !retried {
+				retried = true
+				attempt()
+			}
+		}
+		d, ferr := mount(elementID, container, func() Node { return fallback(err, retry) })
+		if ferr != nil {
+			logutil.Logf("Mount: fallback for #%s failed: %v", elementID, ferr)
+			container.Set("innerHTML", renderToString(mountErrorBox(err, nil)))
+			d = func() { container.Set("innerHTML", "") }
+		}
+		dispose = d
+	}
+	attempt = func() {
+		if dispose != nil {
+			dispose()
+			dispose = nil
+		}
+		d, err := mount(elementID, container, root)
+		if err != nil {
+			showFallback(err)
+			return
+		}
+		dispose = d
+		failed := false
+		handler := func(err error) {
+			if !failed {
+				failed = true
+				// Reached from inside a binder effect; keep the fallback's
+				// reads from subscribing that effect.
+				reactivity.UntrackVoid(func() { showFallback(err) })
+			}
+		}
+		mountErrorHandlers[elementID] = handler
+		// Effects of the mounted tree that panic when re-run land here too
+		if ctx, ok := mountedContainers[elementID]; ok && ctx.CleanupScope != nil {
+			ctx.CleanupScope.OnError(handler)
+		}
+	}
+
+	attempt()
+	return func() {
+		if dispose != nil {
+			dispose()
+			dispose = nil
+		}
+	}
+}
+
+// Remount replaces whatever is mounted in the element with root, regardless
+// of the mount policy, reusing the container. It returns the disposer of
+// the new tree; the previous tree's disposer becomes a no-op.
+func Remount(elementID string, root func() Node) func() {
+	_ = claimContainer(elementID, MountReplace)
+	return Mount(elementID, root)
+}
+
+// SetMountPolicy sets what mounting into an already mounted container does.
+// The default is MountReplace.
+func SetMountPolicy(policy MountPolicy) {
+	mountPolicy = policy
+}
+
+// SetDevMode turns development behaviour on or off. In dev mode Mount
+// renders a minimal error box when the root render panics instead of
+// leaving the page blank.
+func SetDevMode(enabled bool) {
+	devMode = enabled
+}
+
+// mountTarget returns the container element for elementID, panicking when
+// it cannot exist.
+func mountTarget(elementID string) js.Value {
 	doc := js.Global().Get("document")
 	if doc.IsUndefined() || doc.IsNull() {
 		panic("document is not available (not running in a browser)")
@@ -45,6 +191,45 @@ func Mount(elementID string, root func() Node) func() {
 	if container.IsUndefined() || container.IsNull() {
 		panic(fmt.Sprintf("Mount: element with id '%s' not found", elementID))
 	}
+	return container
+}
+
+// claimContainer frees elementID for a new mount according to policy,
+// disposing the tree mounted there, if any.
+func claimContainer(elementID string, policy MountPolicy) error {
+	existing, ok := mountedContainers[elementID]
+	if !ok {
+		return nil
+	}
+	if policy == MountReject {
+		return fmt.Errorf("Mount: #%s: %w", elementID, ErrAlreadyMounted)
+	}
+	logutil.Logf("Mount: #%s is already mounted, disposing the previous tree", elementID)
+	existing.Disposer()
+	return nil
+}
+
+// mount renders root into container and attaches binders. A panic while
+// doing so is returned as an error after the partial mount is torn down.
+func mount(elementID string, container js.Value, root func() Node) (disposer func(), err error) {
+	var cleanupScope *reactivity.CleanupScope
+	previous := reactivity.GetCurrentCleanupScope()
+	rootEl := dom.GetElementByID(elementID)
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+			setCurrentMountContainer("")
+			reactivity.SetCurrentCleanupScope(previous)
+			// OnMount callbacks of a failed render must not run later
+			mountQueue = nil
+			if cleanupScope != nil {
+				cleanupScope.Dispose()
+			}
+			dom.CleanupAllEvents(rootEl)
+			container.Set("innerHTML", "")
+			cleanupRegistriesForContainer(elementID)
+		}
+	}()
 
 	// Set current mount container during component rendering and mounting
 	setCurrentMountContainer(elementID)
@@ -55,22 +240,24 @@ func Mount(elementID string, root func() Node) func() {
 	container.Set("innerHTML", buf.String())
 
 	// Create a cleanup scope for this mount
-	cleanupScope := reactivity.NewCleanupScope(nil)
+	cleanupScope = reactivity.NewCleanupScope(nil)
 
 	// Set cleanup scope as current during binder attachment and OnMount execution
-	previous := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(cleanupScope)
 	
-	attachBinders(container)
-	
-	// Reset current mount container after binders are attached
-	setCurrentMountContainer("")
-	// Execute queued OnMount callbacks
-	for len(mountQueue) > 0 {
-		callback := mountQueue[0]
-		mountQueue = mountQueue[1:]
-		callback()
-	}
+	// Scope event delegation to this container so multiple mounted apps don't interfere
+	dom.WithRoot(rootEl, func() {
+		attachBinders(container)
+
+		// Reset current mount container after binders are attached
+		setCurrentMountContainer("")
+		// Execute queued OnMount callbacks
+		for len(mountQueue) > 0 {
+			callback := mountQueue[0]
+			mountQueue = mountQueue[1:]
+			callback()
+		}
+	})
 	
 	// Start MutationObserver for automatic cleanup
 	dom.StartContainerObserver(elementID, container)
@@ -78,14 +265,23 @@ func Mount(elementID string, root func() Node) func() {
 	// Restore previous cleanup scope
 	reactivity.SetCurrentCleanupScope(previous)
 
-	// Create disposer function
-	disposer := func() {
+	// Create disposer function; it runs once, so a disposer kept after the
+	// tree was replaced cannot tear down its successor
+	disposed := false
+	disposer = func() {
+		if disposed {
+			return
+		}
+		disposed = true
 		// Stop MutationObserver for this container
 		dom.StopContainerObserver(elementID)
 		// Remove from mounted containers registry
 		delete(mountedContainers, elementID)
+		delete(mountErrorHandlers, elementID)
 		// Dispose the cleanup scope (this will clean up all effects and listeners)
 		cleanupScope.Dispose()
+		// Dispose delegated event bindings registered within this container
+		dom.CleanupAllEvents(rootEl)
 		// Clear the container's innerHTML
 		container.Set("innerHTML", "")
 		// Clean up registries for this container
@@ -100,7 +296,45 @@ func Mount(elementID string, root func() Node) func() {
 		Disposer:     disposer,
 	}
 
-	return disposer
+	return disposer, nil
+}
+
+// reportToMount routes an error that no ErrorBoundary handled to the
+// container mounted with MountWithRecovery, if any. It reports whether the
+// error was handled.
+func reportToMount(elementID string, err error) bool {
+	if handler, ok := mountErrorHandlers[elementID]; ok {
+		handler(err)
+		return true
+	}
+	return false
+}
+
+// mountErrorBox renders the minimal error screen used in dev mode and as
+// the default MountWithRecovery fallback. The retry button is omitted when
+// retry is nil.
+func mountErrorBox(err error, retry func()) Node {
+	nodes := []Node{
+		g.Attr("role", "alert"),
+		g.Attr("data-uiwgo-mount-error", ""),
+		g.Attr("style", "margin:16px;padding:16px;border:1px solid #d33;border-radius:6px;background:#fff5f5;color:#900;font-family:sans-serif"),
+		g.El("strong", g.Text("Something went wrong")),
+		g.El("pre", g.Attr("style", "white-space:pre-wrap;margin:8px 0"), g.Text(err.Error())),
+	}
+	if retry != nil {
+		nodes = append(nodes, g.El("button",
+			g.Attr("type", "button"),
+			dom.OnClickInline(func(dom.Element) { retry() }),
+			g.Text("Retry"),
+		))
+	}
+	return g.El("div", nodes...)
+}
+
+func renderToString(n Node) string {
+	var buf bytes.Buffer
+	_ = n.Render(&buf)
+	return buf.String()
 }
 
 // enqueueOnMount adds a function to be executed after Mount completes
@@ -116,3 +350,48 @@ func executeMountQueue() {
 		callback()
 	}
 }
+
+// collectOnMount runs render with an empty mount queue and returns the
+// OnMount callbacks it queued, leaving the surrounding queue as it was.
+// Callbacks queued by a render that panics are dropped.
+func collectOnMount(render func()) (callbacks []func()) {
+	outer := mountQueue
+	mountQueue = nil
+	defer func() {
+		callbacks = mountQueue
+		mountQueue = outer
+	}()
+	render()
+	return
+}
+
+// mountSubtree finishes mounting content a binder rendered after Mount:
+// el is attached to the document, so its binders and inline delegates are
+// attached in scope before the OnMount callbacks queued while rendering it
+// run. The callbacks therefore always see a fully wired subtree. Nested
+// binders mount their own content while being attached, so child callbacks
+// run before the callbacks of the content around them.
+func mountSubtree(el js.Value, scope *reactivity.CleanupScope, callbacks []func()) {
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	defer reactivity.SetCurrentCleanupScope(prev)
+
+	// Reached from inside binder effects; keep the callbacks' reads from
+	// subscribing them.
+	reactivity.UntrackVoid(func() {
+		attachBinders(el)
+		// The binder observer sees el (or, for replaced content, its
+		// children) added later; they are wired already.
+		el.Set(subtreeMountedProp, true)
+		for child := el.Get("firstElementChild"); child.Truthy(); child = child.Get("nextElementSibling") {
+			child.Set(subtreeMountedProp, true)
+		}
+		for _, callback := range callbacks {
+			callback()
+		}
+	})
+}
+
+// subtreeMountedProp marks elements mountSubtree has attached so the
+// binder observer skips them.
+const subtreeMountedProp = "__uiwgoMounted"
@@ -0,0 +1,110 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// Context passes a value down the component tree, so render functions deep
+// in it need not take it as a parameter. Create one with CreateContext,
+// provide a value for a subtree with Provider and read it with Use.
+type Context[T any] struct {
+	defaultValue T
+}
+
+// CreateContext returns a context whose Use returns defaultValue outside
+// any Provider. Create contexts once, typically as package variables:
+//
+//	var ThemeContext = comps.CreateContext("light")
+func CreateContext[T any](defaultValue T) *Context[T] {
+	return &Context[T]{defaultValue: defaultValue}
+}
+
+type contextProvider struct {
+	key   any
+	value any
+	scope *reactivity.CleanupScope
+}
+
+var contextRegistry = map[string]*contextProvider{}
+
+// Provider provides value to the content rendered under children. It is
+// wrapped in a layout-neutral element; a Provider nested in it overrides
+// the value for its own children.
+func (c *Context[T]) Provider(value T, children ...g.Node) g.Node {
+	id := nextID("ctx")
+	contextRegistry[id] = &contextProvider{key: c, value: value}
+	return g.El("div",
+		g.Attr("data-uiwgo-context", id),
+		g.Attr("style", "display: contents"),
+		g.Group(children),
+	)
+}
+
+// Use returns the value of the nearest Provider enclosing the code running
+// now, or the context's default value. Children passed to Provider are
+// built before it, so read the value in code that runs once the provider
+// is mounted: the render functions of For, Index, ShowWhen, SwitchOn and
+// BindHTML, and OnMount callbacks of the content they render.
+func (c *Context[T]) Use() T {
+	if v, ok := reactivity.GetCurrentCleanupScope().Value(c); ok {
+		return v.(T)
+	}
+	return c.defaultValue
+}
+
+// attachContextProvidersIn mounts the Providers under root, and root if it
+// is a new one, each in a scope holding its value, so the binders inside
+// them attach in it. Outer providers come first in document order and so
+// enclose the scopes of the inner ones. Binders added under a provider
+// mounted already, which the binder observer may report with an ancestor,
+// are attached in its scope too.
+func attachContextProvidersIn(root js.Value) {
+	if root.Get("matches").Truthy() && root.Call("matches", "[data-uiwgo-context]:not([data-uiwgo-bound-context])").Bool() {
+		attachContextProvider(root)
+	}
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-context]")
+	for i := 0; i < nodes.Length(); i++ {
+		attachContextProvider(nodes.Index(i))
+	}
+}
+
+func attachContextProvider(el js.Value) {
+	id := el.Call("getAttribute", "data-uiwgo-context").String()
+	p, ok := contextRegistry[id]
+	if !ok {
+		return
+	}
+	if el.Call("hasAttribute", "data-uiwgo-bound-context").Bool() {
+		if p.scope != nil {
+			runInScope(p.scope, func() { attachBinders(el) })
+		}
+		return
+	}
+	el.Call("setAttribute", "data-uiwgo-bound-context", "1")
+	p.scope = reactivity.NewCleanupScope(reactivity.GetCurrentCleanupScope())
+	p.scope.SetValue(p.key, p.value)
+	p.scope.RegisterDisposer(func() { delete(contextRegistry, id) })
+	mountSubtree(el, p.scope, nil)
+}
+
+// contextScopeOf returns the scope of the nearest mounted Provider
+// enclosing el, or nil.
+func contextScopeOf(el js.Value) *reactivity.CleanupScope {
+	parent := el.Get("parentElement")
+	if !parent.Truthy() {
+		return nil
+	}
+	provider := parent.Call("closest", "[data-uiwgo-bound-context]")
+	if !provider.Truthy() {
+		return nil
+	}
+	if p, ok := contextRegistry[provider.Call("getAttribute", "data-uiwgo-context").String()]; ok {
+		return p.scope
+	}
+	return nil
+}
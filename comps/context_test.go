@@ -0,0 +1,93 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestContextNestedProvidersOverrideOuterValues(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	theme := CreateContext("light")
+	items := reactivity.CreateSignal([]string{"a"})
+	mode := reactivity.CreateSignal(1)
+	var mounted []string
+	row := func(item string, _ int) g.Node {
+		value := theme.Use()
+		return g.El("li", g.Text(item+":"+value), OnMount(func() {
+			mounted = append(mounted, theme.Use())
+		}))
+	}
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div",
+			BindHTML(func() g.Node { return g.El("p", g.Attr("class", "outside"), g.Text(theme.Use())) }),
+			theme.Provider("dark",
+				g.El("ul", g.Attr("class", "outer"), For(ForProps[string]{Items: items, Key: func(s string) string { return s }, Children: row})),
+				theme.Provider("contrast",
+					SwitchOn(SwitchOnProps[int]{
+						When:  mode,
+						Cases: []Case[int]{{When: 1, Render: func() g.Node { return g.El("p", g.Attr("class", "inner"), g.Text(theme.Use())) }}},
+						Fallback: func() g.Node {
+							return g.El("p", g.Attr("class", "inner"), g.Text("fallback:"+theme.Use()))
+						},
+					}),
+				),
+			),
+		)
+	})
+	defer disposer()
+
+	text := func(selector string) string {
+		return container.Call("querySelector", selector).Get("textContent").String()
+	}
+	if got := text(".outside"); got != "light" {
+		t.Errorf("outside any provider Use = %q, want the default", got)
+	}
+	if got := text(".outer"); got != "a:dark" {
+		t.Errorf("For row content = %q, want the outer value", got)
+	}
+	if got := text(".inner"); got != "contrast" {
+		t.Errorf("nested provider content = %q, want the inner value", got)
+	}
+
+	// Rows and branches rendered by later updates still see their provider
+	items.Set([]string{"a", "b"})
+	mode.Set(2)
+	if got := text(".outer"); got != "a:darkb:dark" {
+		t.Errorf("For rows after an update = %q", got)
+	}
+	if got := text(".inner"); got != "fallback:contrast" {
+		t.Errorf("switched branch = %q, want the inner value", got)
+	}
+	if strings.Join(mounted, ",") != "dark,dark,dark" {
+		t.Errorf("row OnMount callbacks read %v, want the outer value", mounted)
+	}
+}
+
+func TestContextReachesShowChildren(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	user := CreateContext("guest")
+	open := reactivity.CreateSignal(false)
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return user.Provider("ada",
+			Show(ShowProps{
+				When:     open,
+				Children: g.El("section", BindHTML(func() g.Node { return g.Text("hello " + user.Use()) })),
+			}),
+		)
+	})
+	defer disposer()
+
+	open.Set(true)
+	waitFor(t, "the Show children to render with the provided value", func() bool {
+		return container.Get("textContent").String() == "hello ada"
+	})
+}
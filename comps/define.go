@@ -0,0 +1,135 @@
+package comps
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	g "maragu.dev/gomponents"
+)
+
+// maxDefinitionCache bounds the number of props values a Definition keeps
+// rendered HTML for.
+const maxDefinitionCache = 256
+
+// DefinitionStats is the render profile of a Definition.
+type DefinitionStats struct {
+	Name string
+	// Uses counts calls of Use.
+	Uses int
+	// Renders counts calls of the render function; the other uses were
+	// served from the static cache.
+	Renders int
+	// CacheHits counts uses served from the static cache.
+	CacheHits int
+	// RenderTime is the time spent rendering, including the definitions
+	// used inside this one.
+	RenderTime time.Duration
+}
+
+// definition is the part of a Definition the registry sees.
+type definition interface {
+	Stats() DefinitionStats
+	reset()
+}
+
+// definitions holds the registered definitions by name.
+var definitions = map[string]definition{}
+
+// Definition is a reusable fragment with typed props, created with Define.
+type Definition[P any] struct {
+	name   string
+	render func(P) g.Node
+	// cache holds the HTML of static renders by props value
+	cache map[any]string
+	stats DefinitionStats
+}
+
+// Define creates a reusable fragment rendered by render. Its name, used by
+// the render profile, is taken from render's function name; use
+// DefineNamed to choose one.
+func Define[P any](render func(P) g.Node) *Definition[P] {
+	name := runtime.FuncForPC(reflect.ValueOf(render).Pointer()).Name()
+	return DefineNamed(name, render)
+}
+
+// DefineNamed is like Define with an explicit name. The definition is
+// registered under it for Definitions; defining the same name again
+// replaces the earlier definition there.
+func DefineNamed[P any](name string, render func(P) g.Node) *Definition[P] {
+	d := &Definition[P]{name: name, render: render, cache: make(map[any]string)}
+	d.stats.Name = name
+	definitions[name] = d
+	return d
+}
+
+// Name returns the name the definition is registered under.
+func (d *Definition[P]) Name() string { return d.name }
+
+// Use renders the definition with props p. When p is comparable and the
+// output has no reactive parts (binders, inline event handlers or OnMount
+// callbacks), the HTML is kept and later uses with equal props reuse it
+// without calling the render function. Props are compared with ==, so data
+// behind pointers in them is not looked at.
+func (d *Definition[P]) Use(p P) g.Node {
+	d.stats.Uses++
+	key, cacheable := definitionCacheKey(p)
+	if cacheable {
+		if html, ok := d.cache[key]; ok {
+			d.stats.CacheHits++
+			return g.Raw(html)
+		}
+	}
+
+	start := time.Now()
+	queued := len(mountQueue)
+	var buf bytes.Buffer
+	_ = d.render(p).Render(&buf)
+	d.stats.Renders++
+	d.stats.RenderTime += time.Since(start)
+
+	html := buf.String()
+	static := len(mountQueue) == queued && !strings.Contains(html, "data-uiwgo-")
+	if cacheable && static && len(d.cache) < maxDefinitionCache {
+		d.cache[key] = html
+	}
+	return g.Raw(html)
+}
+
+// Stats returns the render profile of the definition.
+func (d *Definition[P]) Stats() DefinitionStats { return d.stats }
+
+func (d *Definition[P]) reset() {
+	d.stats = DefinitionStats{Name: d.name}
+}
+
+// definitionCacheKey returns p as a map key, or false when p cannot be one.
+func definitionCacheKey(p any) (any, bool) {
+	v := reflect.ValueOf(p)
+	if !v.IsValid() || !v.Comparable() {
+		return nil, false
+	}
+	return p, true
+}
+
+// Definitions returns the render profiles of the registered definitions,
+// sorted by name, e.g. for a DevTools overlay.
+func Definitions() []DefinitionStats {
+	out := make([]DefinitionStats, 0, len(definitions))
+	for _, d := range definitions {
+		out = append(out, d.Stats())
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// ResetDefinitionStats clears the render profiles of the registered
+// definitions. Cached HTML is kept.
+func ResetDefinitionStats() {
+	for _, d := range definitions {
+		d.reset()
+	}
+}
@@ -0,0 +1,106 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type cardProps struct {
+	Title string
+	Body  string
+}
+
+func TestDefinitionUseReusesStaticRenders(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	card := DefineNamed("test-card", func(p cardProps) g.Node {
+		return g.El("div", g.Attr("class", "card"),
+			g.El("h3", g.Text(p.Title)),
+			g.El("p", g.Text(p.Body)),
+		)
+	})
+	titles := []string{"a", "b", "c"}
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		var nodes []g.Node
+		for i := 0; i < 30; i++ {
+			title := titles[i%len(titles)]
+			nodes = append(nodes, card.Use(cardProps{Title: title, Body: "body " + title}))
+		}
+		return g.El("div", nodes...)
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	cards := container.Call("querySelectorAll", ".card")
+	if n := cards.Length(); n != 30 {
+		t.Fatalf("rendered %d cards, want 30", n)
+	}
+	for i := 0; i < 30; i++ {
+		title := titles[i%len(titles)]
+		if got, want := cards.Index(i).Get("textContent").String(), title+"body "+title; got != want {
+			t.Fatalf("card %d text = %q, want %q", i, got, want)
+		}
+	}
+
+	stats := card.Stats()
+	if stats.Name != "test-card" || stats.Uses != 30 || stats.Renders != 3 || stats.CacheHits != 27 {
+		t.Errorf("stats = %+v, want 30 uses, 3 renders and 27 cache hits", stats)
+	}
+	found := false
+	for _, s := range Definitions() {
+		if s.Name == "test-card" {
+			found = s.Uses == 30
+		}
+	}
+	if !found {
+		t.Errorf("test-card missing from Definitions(): %+v", Definitions())
+	}
+
+	ResetDefinitionStats()
+	if stats := card.Stats(); stats.Uses != 0 || stats.Name != "test-card" {
+		t.Errorf("stats after reset = %+v", stats)
+	}
+}
+
+func TestDefinitionUseRendersReactivePartsEachTime(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	count := reactivity.CreateSignal(0)
+	counter := Define(func(label string) g.Node {
+		return g.El("p", g.Attr("class", "counter"),
+			g.Text(label+":"),
+			BindText(func() string { return strings.Repeat("*", count.Get()) }),
+		)
+	})
+	if !strings.Contains(counter.Name(), "TestDefinitionUseRendersReactivePartsEachTime") {
+		t.Errorf("derived name = %q, want the render function's name", counter.Name())
+	}
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div", counter.Use("x"), counter.Use("x"))
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	if stats := counter.Stats(); stats.Renders != 2 || stats.CacheHits != 0 {
+		t.Errorf("stats = %+v, want every use rendered", stats)
+	}
+
+	count.Set(2)
+	time.Sleep(10 * time.Millisecond)
+	counters := container.Call("querySelectorAll", ".counter")
+	for i := 0; i < counters.Length(); i++ {
+		if got := counters.Index(i).Get("textContent").String(); got != "x:**" {
+			t.Errorf("counter %d = %q, want x:**", i, got)
+		}
+	}
+}
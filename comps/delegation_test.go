@@ -0,0 +1,68 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// TestDelegationIsScopedToMountContainer mounts two apps using the same
+// selector and checks that delegated handlers only see their own app.
+func TestDelegationIsScopedToMountContainer(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	for _, id := range []string{"app-a", "app-b"} {
+		container := document.Call("createElement", "div")
+		container.Set("id", id)
+		document.Get("body").Call("appendChild", container)
+		defer document.Get("body").Call("removeChild", container)
+	}
+
+	clicks := map[string]int{}
+	app := func(name string) func() Node {
+		return func() Node {
+			return g.El("div",
+				g.El("button", g.Attr("class", "item"), g.Text(name)),
+				OnMount(func() {
+					dom.DelegateEvent(nil, "click", ".item", func(e domv2.Event, target domv2.Element) {
+						clicks[name]++
+					})
+				}),
+			)
+		}
+	}
+
+	disposeA := Mount("app-a", app("a"))
+	disposeB := Mount("app-b", app("b"))
+	defer disposeB()
+
+	click := func(containerID string) {
+		document.Call("querySelector", "#"+containerID+" .item").Call("click")
+	}
+
+	click("app-a")
+	if clicks["a"] != 1 || clicks["b"] != 0 {
+		t.Fatalf("Expected only app a to handle its click, got %v", clicks)
+	}
+
+	click("app-b")
+	if clicks["a"] != 1 || clicks["b"] != 1 {
+		t.Fatalf("Expected only app b to handle its click, got %v", clicks)
+	}
+
+	// Disposing app a must not remove app b's handler
+	disposeA()
+	click("app-b")
+	if clicks["b"] != 2 {
+		t.Errorf("Expected app b handler to survive disposal of app a, got %v", clicks)
+	}
+}
@@ -0,0 +1,64 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type testGreetingProps struct {
+	Name string
+}
+
+func TestDynamicDisposesPreviousComponent(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	ticks := reactivity.CreateSignal(0)
+	oldRuns, renders := 0, 0
+	counter := func() g.Node {
+		renders++
+		local := reactivity.CreateSignal(1)
+		reactivity.CreateEffect(func() {
+			_ = ticks.Get()
+			oldRuns++
+		})
+		return g.El("p", g.Attr("class", "counter"), BindText(func() string {
+			return "count " + strconv.Itoa(local.Get()+ticks.Get())
+		}))
+	}
+	greeting := ComponentFunc[testGreetingProps](func(p testGreetingProps) Node {
+		return g.El("p", g.Attr("class", "greeting"), g.Text("hello "+p.Name))
+	})
+
+	current := reactivity.CreateSignal[any](counter)
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Dynamic(DynamicProps{Component: current, Props: testGreetingProps{Name: "ada"}})
+	})
+	defer disposer()
+
+	ticks.Set(1)
+	if oldRuns != 2 || renders != 1 {
+		t.Fatalf("effect runs %d, renders %d; want 2 runs of a single render", oldRuns, renders)
+	}
+	if text := container.Call("querySelector", ".counter").Get("textContent").String(); text != "count 2" {
+		t.Errorf("counter text = %q, want count 2", text)
+	}
+
+	current.Set(greeting)
+	ticks.Set(2)
+	if oldRuns != 2 {
+		t.Errorf("effect of the swapped-out component ran %d times, want 2", oldRuns)
+	}
+	if container.Call("querySelector", ".counter").Truthy() {
+		t.Error("previous component is still shown")
+	}
+	greet := container.Call("querySelector", ".greeting")
+	if !greet.Truthy() || greet.Get("textContent").String() != "hello ada" {
+		t.Errorf("greeting did not render with its props: %v", container.Get("innerHTML").String())
+	}
+}
@@ -0,0 +1,199 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// TestErrorBoundaryCatchesEffectPanic mounts a child whose effect panics
+// once a signal changes and checks the boundary swaps in its fallback.
+func TestErrorBoundaryCatchesEffectPanic(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	count := reactivity.CreateSignal(0)
+	runs := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div",
+			g.El("p", g.Attr("class", "outside"), g.Text("outside")),
+			ErrorBoundary(ErrorBoundaryProps{
+				Fallback: func(err error, reset func()) g.Node {
+					return g.El("p", g.Attr("class", "fallback"), g.Text(err.Error()))
+				},
+				Render: func() g.Node {
+					reactivity.CreateEffect(func() {
+						runs++
+						if count.Get() == 2 {
+							panic("count reached 2")
+						}
+					})
+					return g.El("p", g.Attr("class", "content"), g.Text("content"))
+				},
+			}),
+		)
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	if !container.Call("querySelector", ".content").Truthy() {
+		t.Fatal("children did not render")
+	}
+
+	count.Set(1)
+	count.Set(2)
+	time.Sleep(10 * time.Millisecond)
+
+	fallback := container.Call("querySelector", ".fallback")
+	if !fallback.Truthy() {
+		t.Fatal("fallback did not render after the effect panicked")
+	}
+	if text := fallback.Get("textContent").String(); !strings.Contains(text, "count reached 2") {
+		t.Errorf("fallback text = %q, want the panic message", text)
+	}
+	if container.Call("querySelector", ".content").Truthy() {
+		t.Error("children are still shown next to the fallback")
+	}
+	if !container.Call("querySelector", ".outside").Truthy() {
+		t.Error("content outside the boundary was removed")
+	}
+
+	// The failed children's effects are disposed with them
+	count.Set(3)
+	if runs != 3 {
+		t.Errorf("effect runs = %d, want 3", runs)
+	}
+}
+
+// TestErrorBoundaryCatchesDeferredEffectPanic checks that a panic in the
+// re-run of a deferred effect reaches the boundary it was created in.
+func TestErrorBoundaryCatchesDeferredEffectPanic(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	count := reactivity.CreateSignal(0)
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ErrorBoundary(ErrorBoundaryProps{
+			Fallback: func(err error, reset func()) g.Node {
+				return g.El("p", g.Attr("class", "fallback"), g.Text(err.Error()))
+			},
+			Render: func() g.Node {
+				reactivity.CreateDeferredEffect(func() {
+					if count.Get() == 1 {
+						panic("deferred effect failed")
+					}
+				})
+				return g.El("p", g.Attr("class", "content"), g.Text("content"))
+			},
+		})
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	count.Set(1)
+	reactivity.FlushSync()
+	time.Sleep(10 * time.Millisecond)
+
+	fallback := container.Call("querySelector", ".fallback")
+	if !fallback.Truthy() {
+		t.Fatal("fallback did not render after the deferred effect panicked")
+	}
+	if text := fallback.Get("textContent").String(); !strings.Contains(text, "deferred effect failed") {
+		t.Errorf("fallback text = %q, want the panic message", text)
+	}
+}
+
+// TestErrorBoundaryOnErrorWithoutFallback checks that a boundary without a
+// Fallback reports effect panics to OnError and keeps its children.
+func TestErrorBoundaryOnErrorWithoutFallback(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	fail := reactivity.CreateSignal(false)
+	var reported []error
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ErrorBoundary(ErrorBoundaryProps{
+			OnError: func(err error, stack string) { reported = append(reported, err) },
+			Render: func() g.Node {
+				reactivity.CreateEffect(func() {
+					if fail.Get() {
+						panic("failed")
+					}
+				})
+				return g.El("p", g.Attr("class", "content"), g.Text("content"))
+			},
+		})
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	fail.Set(true)
+	if len(reported) != 1 || !strings.Contains(reported[0].Error(), "failed") {
+		t.Fatalf("OnError got %v, want the panic once", reported)
+	}
+	if !container.Call("querySelector", ".content").Truthy() {
+		t.Error("children were removed without a Fallback")
+	}
+}
+
+// TestErrorBoundaryReset checks that the reset passed to Fallback mounts
+// the children again and that a later panic shows the fallback again.
+func TestErrorBoundaryReset(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	fail := reactivity.CreateSignal(false)
+	mounts := 0
+	var reset func()
+	var stacks []string
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ErrorBoundary(ErrorBoundaryProps{
+			Fallback: func(err error, r func()) g.Node {
+				reset = r
+				return g.El("p", g.Attr("class", "fallback"), g.Text(err.Error()))
+			},
+			OnError: func(err error, stack string) { stacks = append(stacks, stack) },
+			Render: func() g.Node {
+				reactivity.CreateEffect(func() {
+					if fail.Get() {
+						panic("broken")
+					}
+				})
+				return g.El("p", g.Attr("class", "content"), g.Text("content"), OnMount(func() { mounts++ }))
+			},
+		})
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	if mounts != 1 {
+		t.Fatalf("children OnMount ran %d times, want 1", mounts)
+	}
+
+	for round := 1; round <= 2; round++ {
+		fail.Set(true)
+		if !container.Call("querySelector", ".fallback").Truthy() {
+			t.Fatalf("round %d: fallback did not render after the panic", round)
+		}
+		if len(stacks) != round || !strings.Contains(stacks[round-1], "error_boundary_test.go") {
+			t.Fatalf("round %d: OnError got %d stacks, want the panic's stack", round, len(stacks))
+		}
+
+		fail.Set(false)
+		reset()
+		if container.Call("querySelector", ".fallback").Truthy() {
+			t.Errorf("round %d: fallback still shown after reset", round)
+		}
+		if !container.Call("querySelector", ".content").Truthy() {
+			t.Fatalf("round %d: children did not render after reset", round)
+		}
+		if mounts != round+1 {
+			t.Errorf("round %d: children OnMount ran %d times, want %d", round, mounts, round+1)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"fmt"
+	"syscall/js"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/dom"
+)
+
+// ForAnimation animates the rows of a For with the Web Animations API.
+// Rows that move when the list is reordered slide from their old position
+// to the new one (the FLIP technique). Enter and Exit, when set, are the
+// keyframes played by rows added and removed after the first render, e.g.
+//
+//	Exit: []map[string]string{{"opacity": "1"}, {"opacity": "0"}}
+//
+// Exit keyframes take the place of ForProps.ExitClass. Nothing is animated
+// when the user prefers reduced motion.
+type ForAnimation struct {
+	Duration time.Duration
+	Easing   string // CSS easing function; "ease" when empty
+	Enter    []map[string]string
+	Exit     []map[string]string
+}
+
+// rowBox is the position of a row in the viewport.
+type rowBox struct{ left, top float64 }
+
+// prefersReducedMotion reports whether the user asked for less motion; tests
+// replace it.
+var prefersReducedMotion = func() bool {
+	return dom.HasCapability(dom.CapMatchMedia) &&
+		js.Global().Call("matchMedia", "(prefers-reduced-motion: reduce)").Get("matches").Bool()
+}
+
+// enabled reports whether a animates anything: it has a duration, the
+// browser implements element.animate and the user does not prefer reduced
+// motion.
+func (a ForAnimation) enabled() bool {
+	return a.Duration > 0 &&
+		js.Global().Get("Element").Get("prototype").Get("animate").Type() == js.TypeFunction &&
+		!prefersReducedMotion()
+}
+
+// exits reports whether removed rows play exit keyframes.
+func (a ForAnimation) exits() bool {
+	return len(a.Exit) > 0 && a.enabled()
+}
+
+// measure records the position of each row of records before the list is
+// reconciled. It returns nil when a does not animate.
+func (a ForAnimation) measure(records map[string]*childRecord) map[string]rowBox {
+	if len(records) == 0 || !a.enabled() {
+		return nil
+	}
+	boxes := make(map[string]rowBox, len(records))
+	for key, rec := range records {
+		if rec.element.Truthy() {
+			rect := rec.element.Call("getBoundingClientRect")
+			boxes[key] = rowBox{rect.Get("left").Float(), rect.Get("top").Float()}
+		}
+	}
+	return boxes
+}
+
+// flip slides each row of records still in the list from the position
+// measure recorded for its key to where it is now. A row re-rendered under
+// the same key slides like a moved one.
+func (a ForAnimation) flip(before map[string]rowBox, records map[string]*childRecord) {
+	for key, rec := range records {
+		old, ok := before[key]
+		if !ok || !rec.element.Truthy() {
+			continue
+		}
+		rect := rec.element.Call("getBoundingClientRect")
+		dx := old.left - rect.Get("left").Float()
+		dy := old.top - rect.Get("top").Float()
+		if dx == 0 && dy == 0 {
+			continue
+		}
+		a.play(rec.element, []map[string]string{
+			{"transform": fmt.Sprintf("translate(%gpx, %gpx)", dx, dy)},
+			{"transform": "none"},
+		})
+	}
+}
+
+// enter plays the enter keyframes on the element of a row just added.
+func (a ForAnimation) enter(el js.Value) {
+	if len(a.Enter) > 0 && a.enabled() {
+		a.play(el, a.Enter)
+	}
+}
+
+// exit plays the exit keyframes on the element of a removed row and calls
+// done when they finish. cancel stops them, and done is then not called.
+func (a ForAnimation) exit(el js.Value, done func()) (cancel func()) {
+	animation := a.play(el, a.Exit)
+	var onFinish js.Func
+	finished := false
+	finish := func(completed bool) {
+		if finished {
+			return
+		}
+		finished = true
+		animation.Set("onfinish", js.Null())
+		onFinish.Release()
+		if completed {
+			done()
+			return
+		}
+		animation.Call("cancel")
+	}
+	onFinish = js.FuncOf(func(this js.Value, args []js.Value) any {
+		finish(true)
+		return nil
+	})
+	animation.Set("onfinish", onFinish)
+	return func() { finish(false) }
+}
+
+// play runs keyframes on el for the animation's duration.
+func (a ForAnimation) play(el js.Value, keyframes []map[string]string) js.Value {
+	frames := make([]any, len(keyframes))
+	for i, keyframe := range keyframes {
+		frame := make(map[string]any, len(keyframe))
+		for property, value := range keyframe {
+			frame[property] = value
+		}
+		frames[i] = frame
+	}
+	easing := a.Easing
+	if easing == "" {
+		easing = "ease"
+	}
+	return el.Call("animate", frames, map[string]any{
+		"duration": a.Duration.Milliseconds(),
+		"easing":   easing,
+	})
+}
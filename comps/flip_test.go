@@ -0,0 +1,166 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strings"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// animateCall is a recorded call of element.animate.
+type animateCall struct {
+	row       string // text of the animated element
+	keyframes []map[string]string
+	duration  int
+	easing    string
+	animation js.Value // the stub Animation returned
+}
+
+// stubAnimate replaces Element.prototype.animate with a recorder returning
+// Animations that only finish when their onfinish is called by the test.
+func stubAnimate(t *testing.T) *[]animateCall {
+	t.Helper()
+	proto := js.Global().Get("Element").Get("prototype")
+	original := proto.Get("animate")
+	calls := &[]animateCall{}
+	stub := js.FuncOf(func(this js.Value, args []js.Value) any {
+		call := animateCall{
+			row:      this.Get("textContent").String(),
+			duration: args[1].Get("duration").Int(),
+			easing:   args[1].Get("easing").String(),
+		}
+		frames := args[0]
+		for i := 0; i < frames.Length(); i++ {
+			frame := map[string]string{}
+			keys := js.Global().Get("Object").Call("keys", frames.Index(i))
+			for j := 0; j < keys.Length(); j++ {
+				key := keys.Index(j).String()
+				frame[key] = frames.Index(i).Get(key).String()
+			}
+			call.keyframes = append(call.keyframes, frame)
+		}
+		call.animation = js.Global().Get("Object").New()
+		call.animation.Set("cancel", js.FuncOf(func(js.Value, []js.Value) any { return nil }))
+		*calls = append(*calls, call)
+		return call.animation
+	})
+	proto.Set("animate", stub)
+	t.Cleanup(func() {
+		proto.Set("animate", original)
+		stub.Release()
+	})
+	return calls
+}
+
+func mountAnimatedList(t *testing.T, items reactivity.Signal[[]string], animate ForAnimation) js.Value {
+	t.Helper()
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[string]{
+			Items: items,
+			Key:   func(s string) string { return s },
+			Children: func(s string, _ int) g.Node {
+				return g.El("div", g.Attr("style", "height: 20px"), g.Text(s))
+			},
+			Animate: animate,
+		})
+	})
+	t.Cleanup(disposer)
+	return container
+}
+
+func TestForAnimateSlidesReorderedRows(t *testing.T) {
+	calls := stubAnimate(t)
+	items := reactivity.CreateSignal([]string{"a", "b", "c"})
+	mountAnimatedList(t, items, ForAnimation{Duration: 200 * time.Millisecond, Easing: "ease-out"})
+	if len(*calls) != 0 {
+		t.Fatalf("first render animated %d rows", len(*calls))
+	}
+
+	items.Set([]string{"c", "a", "b"})
+	want := map[string]string{
+		"c": "translate(0px, 40px)",
+		"a": "translate(0px, -20px)",
+		"b": "translate(0px, -20px)",
+	}
+	if len(*calls) != len(want) {
+		t.Fatalf("animate called %d times, want %d", len(*calls), len(want))
+	}
+	for _, call := range *calls {
+		if got := call.keyframes[0]["transform"]; got != want[call.row] {
+			t.Errorf("row %s starts at %q, want %q", call.row, got, want[call.row])
+		}
+		if got := call.keyframes[1]["transform"]; got != "none" {
+			t.Errorf("row %s ends at %q, want none", call.row, got)
+		}
+		if call.duration != 200 || call.easing != "ease-out" {
+			t.Errorf("row %s animated for %dms with %q", call.row, call.duration, call.easing)
+		}
+	}
+
+	// Rows that keep their position are not animated
+	*calls = nil
+	items.Set([]string{"c", "a", "b"})
+	if len(*calls) != 0 {
+		t.Errorf("an unchanged order animated %d rows", len(*calls))
+	}
+}
+
+func TestForAnimatePlaysEnterAndExitKeyframes(t *testing.T) {
+	calls := stubAnimate(t)
+	items := reactivity.CreateSignal([]string{"a", "b"})
+	container := mountAnimatedList(t, items, ForAnimation{
+		Duration: 100 * time.Millisecond,
+		Enter:    []map[string]string{{"opacity": "0"}, {"opacity": "1"}},
+		Exit:     []map[string]string{{"opacity": "1"}, {"opacity": "0"}},
+	})
+
+	items.Set([]string{"b", "c"})
+	var enter, exit *animateCall
+	for i, call := range *calls {
+		switch {
+		case call.row == "c" && call.keyframes[0]["opacity"] == "0":
+			enter = &(*calls)[i]
+		case call.row == "a" && call.keyframes[0]["opacity"] == "1":
+			exit = &(*calls)[i]
+		}
+	}
+	if enter == nil || exit == nil {
+		t.Fatalf("animate calls = %+v, want enter keyframes on c and exit keyframes on a", *calls)
+	}
+
+	text := func() string { return container.Get("textContent").String() }
+	if !strings.Contains(text(), "a") {
+		t.Fatal("the removed row left before its exit animation finished")
+	}
+	exit.animation.Call("onfinish")
+	if got := text(); got != "bc" {
+		t.Errorf("rows after the exit finished = %q, want bc", got)
+	}
+}
+
+func TestForAnimateRespectsReducedMotion(t *testing.T) {
+	calls := stubAnimate(t)
+	original := prefersReducedMotion
+	prefersReducedMotion = func() bool { return true }
+	defer func() { prefersReducedMotion = original }()
+
+	items := reactivity.CreateSignal([]string{"a", "b"})
+	container := mountAnimatedList(t, items, ForAnimation{
+		Duration: 100 * time.Millisecond,
+		Exit:     []map[string]string{{"opacity": "1"}, {"opacity": "0"}},
+	})
+	items.Set([]string{"b"})
+	if len(*calls) != 0 {
+		t.Errorf("animate called %d times with reduced motion", len(*calls))
+	}
+	if got := container.Get("textContent").String(); got != "b" {
+		t.Errorf("rows = %q, want the removed row gone at once", got)
+	}
+}
@@ -0,0 +1,123 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+var errPoisonedItem = errors.New("poisoned item")
+
+func tenItemsWithPoison(poisoned string) []TestItem {
+	items := make([]TestItem, 10)
+	for i := range items {
+		id := fmt.Sprintf("%d", i+1)
+		items[i] = TestItem{ID: id, Name: "Item " + id}
+		if id == poisoned {
+			items[i].Name = "poison"
+		}
+	}
+	return items
+}
+
+func poisonableRow(item TestItem, index int) g.Node {
+	if item.Name == "poison" {
+		panic(errPoisonedItem)
+	}
+	return g.El("div", g.Attr("class", "row"), g.Attr("data-id", item.ID), g.Text(item.Name))
+}
+
+// TestForIsolatesRowPanics renders ten rows with one poisoned item and
+// checks the other nine render while the failed row shows its fallback.
+func TestForIsolatesRowPanics(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	itemsSignal := reactivity.CreateSignal(tenItemsWithPoison("4"))
+	var reported []error
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ErrorBoundary(ErrorBoundaryProps{
+			OnError: func(err error, stack string) { reported = append(reported, err) },
+			Children: For(ForProps[TestItem]{
+				Items:    itemsSignal,
+				Key:      func(item TestItem) string { return item.ID },
+				Children: poisonableRow,
+				RowErrorFallback: func(err error, item TestItem) g.Node {
+					return g.El("div", g.Attr("class", "row-failed"), g.Text(item.ID+": "+err.Error()))
+				},
+			}),
+		})
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	if n := container.Call("querySelectorAll", ".row").Get("length").Int(); n != 9 {
+		t.Fatalf("expected 9 rendered rows, got %d", n)
+	}
+	failed := container.Call("querySelectorAll", ".row-failed")
+	if failed.Get("length").Int() != 1 {
+		t.Fatalf("expected 1 fallback row, got %d", failed.Get("length").Int())
+	}
+	if text := failed.Call("item", 0).Get("textContent").String(); text != "4: poisoned item" {
+		t.Errorf("unexpected fallback content %q", text)
+	}
+
+	// The fallback keeps the failed row's position in the list.
+	forEl := container.Call("querySelector", "[data-uiwgo-for]")
+	if cls := forEl.Get("children").Call("item", 3).Get("className").String(); cls != "row-failed" {
+		t.Errorf("expected fallback at index 3, got class %q", cls)
+	}
+
+	if len(reported) != 1 || !errors.Is(reported[0], errPoisonedItem) {
+		t.Fatalf("expected the poisoned error to reach the boundary once, got %v", reported)
+	}
+
+	// Fixing the item retries the row without unmounting the list.
+	itemsSignal.Set(tenItemsWithPoison(""))
+	time.Sleep(20 * time.Millisecond)
+
+	if n := container.Call("querySelectorAll", ".row").Get("length").Int(); n != 10 {
+		t.Errorf("expected 10 rows after the item changed, got %d", n)
+	}
+	if n := container.Call("querySelectorAll", "[data-uiwgo-row-error]").Get("length").Int(); n != 0 {
+		t.Errorf("expected no fallback rows after the item changed, got %d", n)
+	}
+	if container.Call("querySelector", "[data-uiwgo-for]").IsNull() {
+		t.Error("For container should stay mounted")
+	}
+}
+
+// TestForRowPanicWithoutFallback uses an empty placeholder when no
+// RowErrorFallback is configured.
+func TestForRowPanicWithoutFallback(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[TestItem]{
+			Items:    func() []TestItem { return tenItemsWithPoison("10") },
+			Key:      func(item TestItem) string { return item.ID },
+			Children: poisonableRow,
+		})
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	if n := container.Call("querySelectorAll", ".row").Get("length").Int(); n != 9 {
+		t.Errorf("expected 9 rendered rows, got %d", n)
+	}
+	placeholders := container.Call("querySelectorAll", "[data-uiwgo-row-error]")
+	if placeholders.Get("length").Int() != 1 {
+		t.Fatalf("expected 1 placeholder, got %d", placeholders.Get("length").Int())
+	}
+	if text := placeholders.Call("item", 0).Get("textContent").String(); text != "" {
+		t.Errorf("placeholder should be empty, got %q", text)
+	}
+}
@@ -0,0 +1,87 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strconv"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func rowsByID(container js.Value) map[string]js.Value {
+	rows := map[string]js.Value{}
+	nodes := container.Call("querySelectorAll", "[data-id]")
+	for i := 0; i < nodes.Length(); i++ {
+		rows[nodes.Index(i).Call("getAttribute", "data-id").String()] = nodes.Index(i)
+	}
+	return rows
+}
+
+func rowTexts(container js.Value) string {
+	out := ""
+	nodes := container.Call("querySelectorAll", "[data-id]")
+	for i := 0; i < nodes.Length(); i++ {
+		out += "[" + nodes.Index(i).Get("textContent").String() + "]"
+	}
+	return out
+}
+
+func TestForChildrenWithIndexKeepsRowsAcrossShuffles(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	items := reactivity.CreateSignal([]TestItem{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}, {ID: "c", Name: "C"}})
+	renders := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[TestItem]{
+			Items: items,
+			Key:   func(item TestItem) string { return item.ID },
+			ChildrenWithIndex: func(item TestItem, index reactivity.Signal[int]) g.Node {
+				renders++
+				return g.El("div", g.Attr("data-id", item.ID),
+					BindText(func() string { return strconv.Itoa(index.Get()) + ":" + item.Name }),
+				)
+			},
+		})
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	if got, want := rowTexts(container), "[0:A][1:B][2:C]"; got != want {
+		t.Fatalf("initial rows = %s, want %s", got, want)
+	}
+	before := rowsByID(container)
+
+	items.Set([]TestItem{{ID: "c", Name: "C"}, {ID: "a", Name: "A"}, {ID: "b", Name: "B"}})
+	time.Sleep(10 * time.Millisecond)
+
+	if got, want := rowTexts(container), "[0:C][1:A][2:B]"; got != want {
+		t.Fatalf("rows after shuffle = %s, want %s", got, want)
+	}
+	after := rowsByID(container)
+	for id, el := range before {
+		if !after[id].Equal(el) {
+			t.Errorf("row %s was recreated by the shuffle", id)
+		}
+	}
+	if renders != 3 {
+		t.Errorf("ChildrenWithIndex called %d times, want 3", renders)
+	}
+
+	// Changing an item re-renders only its row; removing one drops it
+	items.Set([]TestItem{{ID: "a", Name: "A2"}, {ID: "c", Name: "C"}})
+	time.Sleep(10 * time.Millisecond)
+	if got, want := rowTexts(container), "[0:A2][1:C]"; got != want {
+		t.Fatalf("rows after edit = %s, want %s", got, want)
+	}
+	if renders != 4 {
+		t.Errorf("ChildrenWithIndex called %d times after the edit, want 4", renders)
+	}
+	if !rowsByID(container)["c"].Equal(before["c"]) {
+		t.Error("an unchanged row was recreated by the edit")
+	}
+}
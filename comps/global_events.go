@@ -0,0 +1,135 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// eventOptions holds addEventListener options for global event helpers.
+type eventOptions struct {
+	passive bool
+	capture bool
+}
+
+// EventOpt configures UseWindowEvent and UseDocumentEvent listeners.
+type EventOpt func(*eventOptions)
+
+// Passive marks the listener as passive so it never calls preventDefault,
+// letting the browser optimize scroll and touch handling.
+func Passive() EventOpt {
+	return func(o *eventOptions) { o.passive = true }
+}
+
+// Capture registers the listener for the capture phase.
+func Capture() EventOpt {
+	return func(o *eventOptions) { o.capture = true }
+}
+
+// globalListenerKey identifies a shared listener on window or document.
+// Subscriptions with the same key share a single addEventListener call.
+type globalListenerKey struct {
+	target  string
+	name    string
+	passive bool
+	capture bool
+}
+
+// globalListener is one underlying DOM listener that fans out to handlers.
+type globalListener struct {
+	fn       js.Func
+	handlers map[int]func(evt js.Value)
+	order    []int
+}
+
+var (
+	globalListeners      = make(map[globalListenerKey]*globalListener)
+	globalListenerNextID int
+)
+
+// UseWindowEvent subscribes handler to a window event (resize, keydown,
+// beforeunload, ...). The listener is added on mount and removed when the
+// surrounding cleanup scope is disposed. Subscriptions to the same event with
+// the same options share one underlying listener.
+func UseWindowEvent(name string, handler func(evt js.Value), opts ...EventOpt) g.Node {
+	return useGlobalEvent("window", name, handler, opts)
+}
+
+// UseDocumentEvent is like UseWindowEvent but listens on document.
+func UseDocumentEvent(name string, handler func(evt js.Value), opts ...EventOpt) g.Node {
+	return useGlobalEvent("document", name, handler, opts)
+}
+
+func useGlobalEvent(target, name string, handler func(evt js.Value), opts []EventOpt) g.Node {
+	var o eventOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	key := globalListenerKey{target: target, name: name, passive: o.passive, capture: o.capture}
+	return OnMount(func() {
+		unsubscribe := subscribeGlobalEvent(key, handler)
+		reactivity.RegisterCleanup(unsubscribe)
+	})
+}
+
+// subscribeGlobalEvent adds handler to the shared listener for key, creating
+// the DOM listener on first use. The returned function removes the handler and
+// detaches the DOM listener once no handlers remain.
+func subscribeGlobalEvent(key globalListenerKey, handler func(evt js.Value)) func() {
+	targetObj := js.Global().Get(key.target)
+	if targetObj.IsUndefined() || targetObj.IsNull() {
+		return func() {}
+	}
+
+	listener, ok := globalListeners[key]
+	if !ok {
+		listener = &globalListener{handlers: make(map[int]func(evt js.Value))}
+		listener.fn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			var evt js.Value
+			if len(args) > 0 {
+				evt = args[0]
+			}
+			// Copy the order so handlers may unsubscribe while dispatching
+			for _, id := range append([]int(nil), listener.order...) {
+				if h, ok := listener.handlers[id]; ok {
+					h(evt)
+				}
+			}
+			return nil
+		})
+		targetObj.Call("addEventListener", key.name, listener.fn, listenerOptions(key))
+		globalListeners[key] = listener
+	}
+
+	globalListenerNextID++
+	id := globalListenerNextID
+	listener.handlers[id] = handler
+	listener.order = append(listener.order, id)
+
+	removed := false
+	return func() {
+		if removed {
+			return
+		}
+		removed = true
+		delete(listener.handlers, id)
+		for i, v := range listener.order {
+			if v == id {
+				listener.order = append(listener.order[:i], listener.order[i+1:]...)
+				break
+			}
+		}
+		if len(listener.handlers) == 0 {
+			targetObj.Call("removeEventListener", key.name, listener.fn, listenerOptions(key))
+			listener.fn.Release()
+			delete(globalListeners, key)
+		}
+	}
+}
+
+func listenerOptions(key globalListenerKey) map[string]any {
+	return map[string]any{"passive": key.passive, "capture": key.capture}
+}
@@ -0,0 +1,84 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	g "maragu.dev/gomponents"
+)
+
+func TestUseWindowEventSharesListenerAndRemovesOnDispose(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-window-events")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	var first, second int
+	disposer := Mount("test-window-events", func() Node {
+		return g.El("div",
+			UseWindowEvent("uiwgo-test", func(evt js.Value) { first++ }),
+			UseWindowEvent("uiwgo-test", func(evt js.Value) { second++ }),
+		)
+	})
+
+	key := globalListenerKey{target: "window", name: "uiwgo-test"}
+	listener, ok := globalListeners[key]
+	if !ok {
+		t.Fatal("Expected a shared window listener after mount")
+	}
+	if len(listener.handlers) != 2 {
+		t.Fatalf("Expected 2 handlers on the shared listener, got %d", len(listener.handlers))
+	}
+
+	dispatch := func() {
+		evt := js.Global().Get("Event").New("uiwgo-test")
+		js.Global().Get("window").Call("dispatchEvent", evt)
+	}
+
+	dispatch()
+	if first != 1 || second != 1 {
+		t.Fatalf("Expected both handlers to run once, got first=%d second=%d", first, second)
+	}
+
+	disposer()
+	if _, ok := globalListeners[key]; ok {
+		t.Error("Expected shared listener to be removed after disposal")
+	}
+
+	dispatch()
+	if first != 1 || second != 1 {
+		t.Errorf("Expected no dispatch after disposal, got first=%d second=%d", first, second)
+	}
+}
+
+func TestUseDocumentEventOptionsUseSeparateListeners(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	passive := subscribeGlobalEvent(globalListenerKey{target: "document", name: "scroll", passive: true}, func(js.Value) {})
+	capture := subscribeGlobalEvent(globalListenerKey{target: "document", name: "scroll", capture: true}, func(js.Value) {})
+
+	if len(globalListeners) < 2 {
+		t.Errorf("Expected distinct listeners per option set, got %d", len(globalListeners))
+	}
+
+	passive()
+	capture()
+	passive() // unsubscribing twice is a no-op
+
+	for key := range globalListeners {
+		if key.target == "document" && key.name == "scroll" {
+			t.Errorf("Expected listener %+v to be removed", key)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"sort"
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// GroupedForProps configures the GroupedFor control flow.
+type GroupedForProps[T any, G any] struct {
+	Items   reactivity.Signal[[]T]
+	GroupBy func(T) G
+	// GroupKey identifies a group across updates.
+	GroupKey func(G) string
+	// GroupHeader renders the header of a group; it is re-rendered when the
+	// group's item count changes. When nil groups have no header.
+	GroupHeader func(group G, count int) g.Node
+	ItemKey     func(T) string
+	// Children renders an item once per key; index is its position within
+	// its group at that time. Rows are kept while their key is present, so
+	// content that must follow changes to an item should read it from a
+	// signal.
+	Children func(item T, index int) g.Node
+	// GroupOrder sorts groups; when nil they keep the order in which they
+	// first appear in Items.
+	GroupOrder func(a, b G) bool
+}
+
+// groupRecord is a rendered group of a GroupedFor.
+type groupRecord[G any] struct {
+	key           string
+	value         G
+	count         int
+	element       js.Value
+	body          js.Value
+	header        js.Value
+	headerCleanup func()
+}
+
+// group is one group of a GroupedFor update.
+type group[T any, G any] struct {
+	key   string
+	value G
+	items []T
+	keys  []string
+}
+
+// GroupedFor renders Items split into groups with keyed reconciliation at
+// both levels. It outputs a <div data-uiwgo-grouped-for="id"> holding one
+// <div data-uiwgo-group="key"> per group, each with its header followed by
+// a <div data-uiwgo-group-items> of rows. When an item's group changes its
+// row element is moved into the new group instead of being re-rendered.
+func GroupedFor[T any, G any](p GroupedForProps[T, G]) g.Node {
+	id := nextID("gf")
+	containerID := getCurrentMountContainer()
+	return g.El("div",
+		g.Attr("data-uiwgo-grouped-for", id),
+		OnMount(func() {
+			attachGroupedFor(id, containerID, p)
+		}),
+	)
+}
+
+// attachGroupedFor starts the reconcile effect of a mounted GroupedFor.
+func attachGroupedFor[T any, G any](id, mountContainer string, p GroupedForProps[T, G]) {
+	container := js.Global().Get("document").Call("querySelector", `[data-uiwgo-grouped-for="`+id+`"]`)
+	if !container.Truthy() {
+		return
+	}
+
+	groups := make(map[string]*groupRecord[G])
+	rows := make(map[string]*childRecord)
+	reactivity.RegisterCleanup(func() {
+		for _, row := range rows {
+			if row.cleanup != nil {
+				row.cleanup()
+			}
+		}
+		for _, gr := range groups {
+			if gr.headerCleanup != nil {
+				gr.headerCleanup()
+			}
+		}
+	})
+
+	reactivity.CreateRenderEffect(func() {
+		next := groupItems(p.Items.Get(), p)
+		// Rows and headers render inside this effect; keep their reads out
+		// of it
+		reactivity.UntrackVoid(func() {
+			reconcileGroups(container, mountContainer, p, next, groups, rows)
+		})
+	})
+}
+
+// groupItems splits items into groups in display order.
+func groupItems[T any, G any](items []T, p GroupedForProps[T, G]) []*group[T, G] {
+	var ordered []*group[T, G]
+	byKey := make(map[string]*group[T, G])
+	for _, item := range items {
+		value := p.GroupBy(item)
+		key := p.GroupKey(value)
+		gr, ok := byKey[key]
+		if !ok {
+			gr = &group[T, G]{key: key, value: value}
+			byKey[key] = gr
+			ordered = append(ordered, gr)
+		}
+		gr.items = append(gr.items, item)
+		gr.keys = append(gr.keys, p.ItemKey(item))
+	}
+	if p.GroupOrder != nil {
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return p.GroupOrder(ordered[i].value, ordered[j].value)
+		})
+	}
+	return ordered
+}
+
+// reconcileGroups updates the DOM under container to show next, reusing
+// the group and row elements whose keys are still present.
+func reconcileGroups[T any, G any](container js.Value, mountContainer string, p GroupedForProps[T, G], next []*group[T, G], groups map[string]*groupRecord[G], rows map[string]*childRecord) {
+	doc := js.Global().Get("document")
+
+	// Drop the rows whose keys are gone
+	present := make(map[string]bool)
+	for _, gr := range next {
+		for _, key := range gr.keys {
+			present[key] = true
+		}
+	}
+	for key, row := range rows {
+		if present[key] {
+			continue
+		}
+		if row.element.Truthy() {
+			row.element.Call("remove")
+		}
+		if row.cleanup != nil {
+			row.cleanup()
+		}
+		delete(rows, key)
+	}
+
+	var mounts []func()
+	keep := make(map[string]bool, len(next))
+	cursor := container.Get("firstElementChild")
+	for _, gr := range next {
+		keep[gr.key] = true
+		rec, ok := groups[gr.key]
+		if !ok {
+			rec = &groupRecord[G]{key: gr.key, count: -1}
+			rec.element = doc.Call("createElement", "div")
+			rec.element.Call("setAttribute", "data-uiwgo-group", gr.key)
+			rec.body = doc.Call("createElement", "div")
+			rec.body.Call("setAttribute", "data-uiwgo-group-items", "")
+			rec.element.Call("appendChild", rec.body)
+			groups[gr.key] = rec
+		}
+		rec.value = gr.value
+
+		// Place the group, moving it only when it is out of order
+		if cursor.Equal(rec.element) {
+			cursor = cursor.Get("nextElementSibling")
+		} else {
+			container.Call("insertBefore", rec.element, cursor)
+		}
+
+		if p.GroupHeader != nil && rec.count != len(gr.items) {
+			element, cleanup, mount := createItemElement(p.GroupHeader, gr.value, len(gr.items), mountContainer)
+			if rec.headerCleanup != nil {
+				rec.headerCleanup()
+			}
+			if rec.header.Truthy() {
+				rec.header.Call("remove")
+			}
+			rec.header, rec.headerCleanup = element, cleanup
+			if element.Truthy() {
+				rec.element.Call("insertBefore", element, rec.body)
+				if mount != nil {
+					mounts = append(mounts, mount)
+				}
+			}
+		}
+		rec.count = len(gr.items)
+
+		rowCursor := rec.body.Get("firstElementChild")
+		for i, key := range gr.keys {
+			row, ok := rows[key]
+			if !ok {
+				element, cleanup, mount := createItemElement(p.Children, gr.items[i], i, mountContainer)
+				if !element.Truthy() {
+					continue
+				}
+				row = &childRecord{key: key, index: i, element: element, cleanup: cleanup, mount: mount}
+				rows[key] = row
+			}
+			row.index = i
+			if rowCursor.Equal(row.element) {
+				rowCursor = rowCursor.Get("nextElementSibling")
+			} else {
+				rec.body.Call("insertBefore", row.element, rowCursor)
+			}
+			if row.mount != nil {
+				mounts = append(mounts, row.mount)
+				row.mount = nil
+			}
+		}
+	}
+
+	// Drop the groups that are gone; their rows have moved out already
+	for key, rec := range groups {
+		if keep[key] {
+			continue
+		}
+		rec.element.Call("remove")
+		if rec.headerCleanup != nil {
+			rec.headerCleanup()
+		}
+		delete(groups, key)
+	}
+
+	// Attach the new rows and headers now that they are in the document
+	for _, mount := range mounts {
+		mount()
+	}
+}
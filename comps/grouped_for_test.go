@@ -0,0 +1,106 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strconv"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type groupedTask struct {
+	ID     string
+	Status string
+}
+
+func groupedTaskProps(items reactivity.Signal[[]groupedTask], label reactivity.Signal[string]) GroupedForProps[groupedTask, string] {
+	order := map[string]int{"todo": 0, "doing": 1, "done": 2}
+	return GroupedForProps[groupedTask, string]{
+		Items:    items,
+		GroupBy:  func(task groupedTask) string { return task.Status },
+		GroupKey: func(status string) string { return status },
+		GroupHeader: func(status string, count int) g.Node {
+			return g.El("h2", g.Text(status+" "+strconv.Itoa(count)))
+		},
+		ItemKey: func(task groupedTask) string { return task.ID },
+		Children: func(task groupedTask, index int) g.Node {
+			return g.El("div",
+				g.Attr("data-task", task.ID),
+				BindText(func() string { return task.ID + ":" + label.Get() }),
+			)
+		},
+		GroupOrder: func(a, b string) bool { return order[a] < order[b] },
+	}
+}
+
+func groupedLayout(container js.Value) string {
+	out := ""
+	groups := container.Call("querySelectorAll", "[data-uiwgo-group]")
+	for i := 0; i < groups.Length(); i++ {
+		gr := groups.Index(i)
+		out += "[" + gr.Call("querySelector", "h2").Get("textContent").String()
+		tasks := gr.Call("querySelectorAll", "[data-task]")
+		for j := 0; j < tasks.Length(); j++ {
+			out += " " + tasks.Index(j).Call("getAttribute", "data-task").String()
+		}
+		out += "]"
+	}
+	return out
+}
+
+func TestGroupedForMovesItemBetweenGroups(t *testing.T) {
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+
+	items := reactivity.CreateSignal([]groupedTask{
+		{ID: "a", Status: "doing"},
+		{ID: "b", Status: "todo"},
+		{ID: "c", Status: "todo"},
+	})
+	label := reactivity.CreateSignal("x")
+	dispose := Mount(container.Get("id").String(), func() g.Node {
+		return GroupedFor(groupedTaskProps(items, label))
+	})
+	t.Cleanup(dispose)
+	time.Sleep(10 * time.Millisecond)
+
+	if got, want := groupedLayout(container), "[todo 2 b c][doing 1 a]"; got != want {
+		t.Fatalf("initial layout = %q, want %q", got, want)
+	}
+	moved := container.Call("querySelector", `[data-task="b"]`)
+	kept := container.Call("querySelector", `[data-task="a"]`)
+
+	items.Set([]groupedTask{
+		{ID: "a", Status: "doing"},
+		{ID: "b", Status: "done"},
+		{ID: "c", Status: "todo"},
+	})
+	time.Sleep(10 * time.Millisecond)
+
+	if got, want := groupedLayout(container), "[todo 1 c][doing 1 a][done 1 b]"; got != want {
+		t.Fatalf("layout after move = %q, want %q", got, want)
+	}
+	if !container.Call("querySelector", `[data-task="b"]`).Equal(moved) {
+		t.Error("moving an item to another group recreated its element")
+	}
+	if !container.Call("querySelector", `[data-task="a"]`).Equal(kept) {
+		t.Error("an untouched item was recreated")
+	}
+
+	// The moved row's binders keep working
+	label.Set("y")
+	time.Sleep(10 * time.Millisecond)
+	if got := moved.Get("textContent").String(); got != "b:y" {
+		t.Errorf("moved row text = %q, want b:y", got)
+	}
+
+	items.Set([]groupedTask{{ID: "c", Status: "done"}})
+	time.Sleep(10 * time.Millisecond)
+	if got, want := groupedLayout(container), "[done 1 c]"; got != want {
+		t.Errorf("layout after removals = %q, want %q", got, want)
+	}
+}
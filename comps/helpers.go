@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"hash/fnv"
 	"reflect"
+	"runtime/debug"
+	"sort"
 	"strconv"
 	"sync/atomic"
 	"syscall/js"
+	"time"
 
+	"github.com/ozanturksever/logutil"
 	"github.com/ozanturksever/uiwgo/dom"
 	"github.com/ozanturksever/uiwgo/reactivity"
 	g "maragu.dev/gomponents"
@@ -25,6 +29,7 @@ var (
 	indexRegistry         = map[string]indexBinder{}
 	switchRegistry        = map[string]switchBinder{}
 	dynamicRegistry       = map[string]dynamicBinder{}
+	errorBoundaryRegistry = map[string]errorBoundaryBinder{}
 	currentMountContainer string // tracks the current mount container during binding
 	binderObserver        js.Value
 	binderObserverCb      js.Func
@@ -85,10 +90,21 @@ func cleanupRegistriesForContainer(containerID string) {
 					record.cleanup()
 				}
 			}
+			finishExits(binder)
 			delete(forRegistry, id)
 		}
 	}
 
+	cleanupAttrBindersForContainer(containerID)
+	cleanupPortalsForContainer(containerID)
+
+	// Clean up error boundary registry
+	for id, binder := range errorBoundaryRegistry {
+		if binder.container == containerID {
+			delete(errorBoundaryRegistry, id)
+		}
+	}
+
 	// Clean up index registry
 	for id, binder := range indexRegistry {
 		if binder.mountContainer == containerID {
@@ -145,22 +161,38 @@ type textBinder struct {
 
 type htmlBinder struct {
 	fn        func() g.Node
+	deps      []func() any      // explicit dependencies; nil means fn is tracked
+	depValues []any             // dependency values the current content was rendered with
 	container string            // elementID of the mounted container
 	effect    reactivity.Effect // effect for reactive updates
+	// replaced is set when a BindHTMLDeps inside a Provider renders again
+	// on attach; the OnMount callbacks of its first render are then dropped
+	replaced *bool
 }
 
 type showBinder struct {
-	when      reactivity.Signal[bool]
+	when      reactivity.ReadonlySignal[bool]
 	html      string
 	container string            // elementID of the mounted container
 	effect    reactivity.Effect // effect for reactive updates
+	// keepAlive Shows render children on first show and keep them
+	keepAlive bool
+	children  g.Node
+	// transition animates the children in and out
+	transition transition
 }
 
 type forBinder struct {
-	items          any // reactivity.Signal[[]T] or func() []T
-	keyFn          any // func(T) string
-	childrenFn     any // func(item T, index int) g.Node
-	childRecords   map[string]*childRecord
+	items        any // reactivity.Signal[[]T] or func() []T
+	keyFn        any // func(T) string
+	childrenFn   any // func(item T, index int) g.Node
+	indexedFn    func(item any, index reactivity.Signal[int]) g.Node
+	rowFallback  func(err error, item any) g.Node
+	childRecords map[string]*childRecord
+	transition   transition
+	animation    ForAnimation
+	// exiting holds the removed rows still running their exit transition
+	exiting        map[string]*childRecord
 	container      js.Value
 	effect         reactivity.Effect
 	mountContainer string // elementID of the mounted container
@@ -180,6 +212,16 @@ type childRecord struct {
 	index   int
 	element js.Value
 	cleanup func()
+	mount   func() // attaches a new row once it is in the document
+	// item and indexSig are kept for rows of a For with ChildrenWithIndex
+	item     any
+	indexSig reactivity.Signal[int]
+	// itemSig holds the value at the position of an Index row
+	itemSig reactivity.Signal[any]
+	// For rows running their exit transition: cancelExit stops it and
+	// anchor is the key of the row they were removed in front of
+	cancelExit func()
+	anchor     string
 }
 
 type switchBinder struct {
@@ -198,7 +240,8 @@ type matchCase struct {
 }
 
 type dynamicBinder struct {
-	component      any // reactivity.Signal[ComponentFunc] or func() ComponentFunc
+	component      any // see DynamicProps.Component
+	props          any
 	container      js.Value
 	effect         reactivity.Effect
 	currentCleanup func()
@@ -218,6 +261,14 @@ func nextID(prefix string) string {
 }
 
 // OnMount schedules a function to run after Mount has attached the DOM.
+//
+// When fn runs, the content it was rendered with is in the document, every
+// binder in it (For, Index, BindHTML, ...) has rendered its children and the
+// inline event delegates (dom.OnClickInline, ...) are attached, so fn can
+// look elements up and bind to them. OnMount callbacks of child content run
+// before those of the content around it. Content rendered later by a binder,
+// such as a new For row or a BindHTML re-render, runs its OnMount callbacks
+// as soon as it is inserted and attached.
 func OnMount(fn func()) g.Node {
 	// We return a no-op node so it can be used in gomponents trees.
 	enqueueOnMount(fn)
@@ -254,6 +305,39 @@ func BindHTML(fn func() g.Node) g.Node {
 	return g.El("div", g.Attr("data-uiwgo-html", id), g.Raw(buf.String()))
 }
 
+// BindHTMLDeps is like BindHTML but only re-renders when the value returned
+// by one of deps changes (compared with reflect.DeepEqual). Signals read
+// inside fn never trigger a re-render on their own, which keeps expensive
+// regions built from many signals from updating on unrelated changes.
+func BindHTMLDeps(fn func() g.Node, deps ...func() any) g.Node {
+	id := nextID("h")
+	if deps == nil {
+		deps = []func() any{}
+	}
+	replaced := new(bool)
+	htmlRegistry[id] = htmlBinder{fn: fn, deps: deps, depValues: readDeps(deps), container: getCurrentMountContainer(), replaced: replaced}
+	var buf bytes.Buffer
+	callbacks := collectOnMount(func() { _ = fn().Render(&buf) })
+	for _, callback := range callbacks {
+		callback := callback
+		enqueueOnMount(func() {
+			if !*replaced {
+				callback()
+			}
+		})
+	}
+	return g.El("div", g.Attr("data-uiwgo-html", id), g.Raw(buf.String()))
+}
+
+// readDeps evaluates explicit dependencies, tracking only the signals they read.
+func readDeps(deps []func() any) []any {
+	values := make([]any, len(deps))
+	for i, dep := range deps {
+		values[i] = dep()
+	}
+	return values
+}
+
 // BindHTMLAs is like BindHTML but uses the provided tag name as the container element.
 // This is useful to keep valid HTML structure (e.g., <li> inside <ul>).
 func BindHTMLAs(tag string, fn func() g.Node, attrs ...g.Node) g.Node {
@@ -365,6 +449,8 @@ func cleanupBinders(node js.Value) {
 	cleanupRegistry("[data-uiwgo-index]", indexRegistry, "data-uiwgo-bound-index")
 	cleanupRegistry("[data-uiwgo-switch]", switchRegistry, "data-uiwgo-bound-switch")
 	cleanupRegistry("[data-uiwgo-dynamic]", dynamicRegistry, "data-uiwgo-bound-dynamic")
+	cleanupAttrBindersIn(node)
+	cleanupPortalsIn(node)
 }
 
 // attachBinders scans the mounted DOM (or a subtree) and attaches reactive behaviors.
@@ -379,23 +465,33 @@ func attachBinders(root js.Value) {
 				addedNodes := m.Get("addedNodes")
 				for j := 0; j < addedNodes.Length(); j++ {
 					node := addedNodes.Index(j)
-					if node.Get("nodeType").Int() == 1 { // ELEMENT_NODE
-						attachBinders(node)
+					if node.Get("nodeType").Int() == 1 && !node.Get(subtreeMountedProp).Truthy() { // ELEMENT_NODE
+						// Content shown inside a Provider attaches in its scope
+						if scope := contextScopeOf(node); scope != nil {
+							runInScope(scope, func() { attachBinders(node) })
+						} else {
+							attachBinders(node)
+						}
 					}
 				}
-				// Handle removed nodes
+				// Handle removed nodes; nodes moved elsewhere in the document
+				// (such as GroupedFor rows) keep their binders
 				removedNodes := m.Get("removedNodes")
 				for j := 0; j < removedNodes.Length(); j++ {
 					node := removedNodes.Index(j)
+					if node.Get("isConnected").Truthy() {
+						continue
+					}
 					cleanupBinders(node)
 				}
 			}
 			return nil
 		})
 
-		observerCtor := js.Global().Get("MutationObserver")
-		if observerCtor.Truthy() {
-			binderObserver = observerCtor.New(binderObserverCb)
+		// Without MutationObserver only the content binders render
+		// themselves is attached
+		if dom.HasCapability(dom.CapMutationObserver) {
+			binderObserver = js.Global().Get("MutationObserver").New(binderObserverCb)
 			opts := js.Global().Get("Object").New()
 			opts.Set("childList", true)
 			opts.Set("subtree", true)
@@ -415,13 +511,19 @@ func attachBinders(root js.Value) {
 		}
 	}
 
+	// Providers first, so the binders inside them attach in their scopes
+	attachContextProvidersIn(root)
+	// Portals leave root before its binders attach; they attach on their own
+	attachPortalsIn(root)
 	attachTextBindersIn(root)
+	attachAttrBindersIn(root)
 	attachHTMLBindersIn(root)
 	attachShowBindersIn(root)
 	attachForBindersIn(root)
 	attachIndexBindersIn(root)
 	attachSwitchBindersIn(root)
 	attachDynamicBindersIn(root)
+	attachErrorBoundariesIn(root)
 	// Enable inline DOM event handlers (e.g., dom.OnClickInline) via delegated listeners
 	dom.AttachInlineDelegates(root)
 }
@@ -440,7 +542,7 @@ func attachTextBindersIn(root js.Value) {
 		id := el.Call("getAttribute", "data-uiwgo-txt").String()
 		if binder, ok := textRegistry[id]; ok {
 			// Create a reactive effect that updates textContent
-			effect := reactivity.CreateEffect(func() {
+			effect := reactivity.CreateRenderEffect(func() {
 				newText := binder.fn()
 				el.Set("textContent", newText)
 			})
@@ -458,26 +560,65 @@ func attachTextBindersIn(root js.Value) {
 
 // ShowProps configures the Show control flow.
 type ShowProps struct {
-	When     reactivity.Signal[bool]
+	When     reactivity.ReadonlySignal[bool] // Signal[bool] or a ReadOnly view
 	Children g.Node
+	// KeepAlive hides the children with display:none instead of removing
+	// them, so their DOM state such as typed input survives. Children are
+	// rendered the first time When is true; while hidden their effects are
+	// suspended and those triggered meanwhile run once they are shown.
+	KeepAlive bool
+	// EnterClass is added to the children's elements when they are shown
+	// and removed a frame later, so a CSS transition on them animates
+	// from the class's style. The first render is not animated.
+	EnterClass string
+	// ExitClass is added to the children's elements when they are hidden;
+	// they are removed, or hidden with KeepAlive, after ExitDuration or
+	// on their transitionend or animationend event, whichever is first.
+	// Showing them again before then cancels the exit. Without an
+	// ExitDuration they are removed right away.
+	ExitClass    string
+	ExitDuration time.Duration
 }
 
 // ForProps configures the For control flow for keyed list rendering.
 type ForProps[T any] struct {
-	Items    any // reactivity.Signal[[]T] or func() []T
+	Items    any // reactivity.Signal[[]T], reactivity.ReadonlySignal[[]T] or func() []T
 	Key      func(T) string
 	Children func(item T, index int) g.Node
+	// ChildrenWithIndex is used instead of Children when set. Rows are then
+	// kept by key: reordering moves their elements instead of re-rendering
+	// them and index is updated to the row's new position, so only content
+	// bound to it changes. A row is re-rendered when its key's item changes.
+	ChildrenWithIndex func(item T, index reactivity.Signal[int]) g.Node
+	// RowErrorFallback renders in place of a row whose Children or Key
+	// function panicked. The remaining rows keep rendering; when nil an empty
+	// placeholder is used. The failed row is retried on the next update.
+	RowErrorFallback func(err error, item T) g.Node
+	// EnterClass, ExitClass and ExitDuration animate rows added and
+	// removed after the first render, as for ShowProps. A removed row
+	// stays in place while it exits; when its key comes back meanwhile the
+	// exit is cancelled.
+	EnterClass   string
+	ExitClass    string
+	ExitDuration time.Duration
+	// Animate slides rows to their new position when the list is
+	// reordered and plays its Enter and Exit keyframes on added and removed
+	// rows. It is off while Animate.Duration is zero.
+	Animate ForAnimation
 }
 
 // IndexProps configures the Index control flow for index-based rendering.
 type IndexProps[T any] struct {
-	Items    any // reactivity.Signal[[]T] or func() []T
+	Items any // reactivity.Signal[[]T], reactivity.ReadonlySignal[[]T] or func() []T
+	// Children renders the row at index once. getItem is reactive: reading
+	// it in a binder or effect follows the value at that position, which
+	// is updated in place when the list changes.
 	Children func(getItem func() T, index int) g.Node
 }
 
 // SwitchProps configures the Switch control flow for branch selection.
 type SwitchProps struct {
-	When     any // reactivity.Signal[any] or func() any
+	When     any // reactivity.Signal[any], reactivity.ReadonlySignal[any] or func() any
 	Fallback g.Node
 	Children []g.Node // Array of Match nodes
 }
@@ -490,13 +631,27 @@ type MatchProps struct {
 
 // DynamicProps configures the Dynamic control flow for reactive component rendering.
 type DynamicProps struct {
-	Component any // reactivity.Signal[ComponentFunc] or func() ComponentFunc
+	// Component selects the component to render: a signal holding it, a
+	// function returning it or the component itself. A component is a
+	// func() g.Node or a function of one argument, such as a
+	// ComponentFunc[P], that is passed Props.
+	Component any
+	// Props is passed to components taking an argument; it must be
+	// assignable to the argument's type. Without Props they receive its
+	// zero value.
+	Props any
 }
 
 // Show renders its children only when the When signal is true.
 // It outputs a <span data-uiwgo-show="id">[initial child html]</span>
 // and attaches a reactive toggle after mount.
 func Show(p ShowProps) g.Node {
+	if p.KeepAlive {
+		id := nextID("ka")
+		showRegistry[id] = showBinder{when: p.When, container: getCurrentMountContainer(), keepAlive: true, children: p.Children, transition: p.transition()}
+		return g.El("span", g.Attr("data-uiwgo-show", id))
+	}
+
 	// Generate a unique ID that combines signal pointer with content hash for stability
 	// This ensures each Show component gets a unique ID even when sharing the same signal
 	var buf bytes.Buffer
@@ -513,7 +668,7 @@ func Show(p ShowProps) g.Node {
 	// Store the binder with container info, but don't store effect yet
 	// Only update if not already registered to preserve existing effects
 	if _, exists := showRegistry[id]; !exists {
-		showRegistry[id] = showBinder{when: p.When, html: html, container: containerID}
+		showRegistry[id] = showBinder{when: p.When, html: html, container: containerID, transition: p.transition()}
 	}
 
 	if p.When.Get() {
@@ -522,17 +677,40 @@ func Show(p ShowProps) g.Node {
 	return g.El("span", g.Attr("data-uiwgo-show", id))
 }
 
+func (p ShowProps) transition() transition {
+	return transition{p.EnterClass, p.ExitClass, p.ExitDuration}
+}
+
 // For renders a list of items with keyed reconciliation.
 // It outputs a <div data-uiwgo-for="id"></div> container and manages
 // efficient insertion/removal/move operations based on keys.
 func For[T any](p ForProps[T]) g.Node {
 	id := nextID("f")
 	containerID := getCurrentMountContainer()
+	var rowFallback func(err error, item any) g.Node
+	if p.RowErrorFallback != nil {
+		rowFallback = func(err error, item any) g.Node {
+			typed, _ := item.(T)
+			return p.RowErrorFallback(err, typed)
+		}
+	}
+	var indexedFn func(item any, index reactivity.Signal[int]) g.Node
+	if p.ChildrenWithIndex != nil {
+		indexedFn = func(item any, index reactivity.Signal[int]) g.Node {
+			typed, _ := item.(T)
+			return p.ChildrenWithIndex(typed, index)
+		}
+	}
 	forRegistry[id] = forBinder{
 		items:          p.Items,
 		keyFn:          p.Key,
 		childrenFn:     p.Children,
+		indexedFn:      indexedFn,
+		rowFallback:    rowFallback,
 		childRecords:   make(map[string]*childRecord),
+		transition:     transition{p.EnterClass, p.ExitClass, p.ExitDuration},
+		animation:      p.Animate,
+		exiting:        make(map[string]*childRecord),
 		mountContainer: containerID,
 	}
 	return g.El("div", g.Attr("data-uiwgo-for", id))
@@ -589,11 +767,17 @@ func Match(p MatchProps) g.Node {
 // Dynamic renders a component reactively based on a signal or function.
 // It outputs a <div data-uiwgo-dynamic="id"></div> container and reactively
 // switches between different components with proper cleanup.
+//
+// Each component renders in a cleanup scope of its own, disposed before
+// the next one renders, so the effects and binders it created stop with
+// it. Only the signals read to select the component cause a swap; those
+// the component reads while rendering do not.
 func Dynamic(p DynamicProps) g.Node {
 	id := nextID("dyn")
 	containerID := getCurrentMountContainer()
 	dynamicRegistry[id] = dynamicBinder{
 		component:      p.Component,
+		props:          p.Props,
 		mountContainer: containerID,
 	}
 	return g.El("div", g.Attr("data-uiwgo-dynamic", id))
@@ -605,16 +789,6 @@ func Fragment(children ...g.Node) g.Node {
 	return g.Group(children)
 }
 
-// Portal renders children into a different part of the DOM tree.
-// The target should be a CSS selector for the element where children will be rendered.
-func Portal(target string, children g.Node) g.Node {
-	// Store portal information for later attachment
-	// In a real implementation, this would be handled by the mount system
-	// For now, we return the children directly since portal functionality
-	// requires DOM manipulation that happens during mounting
-	return children
-}
-
 // MemoProps configures the Memo component for memoization
 type MemoProps struct {
 	Component    func() g.Node
@@ -633,24 +807,197 @@ type LazyProps struct {
 	Loader func() func() g.Node
 }
 
-// Lazy loads a component asynchronously, showing a fallback while loading
+// Lazy renders the component returned by loader, which is called right
+// away. Use LazyAsync for loaders that wait, e.g. on a fetch.
 func Lazy(loader func() func() g.Node) g.Node {
-	// In a basic implementation, we load synchronously
-	// A full implementation would handle async loading with fallback
 	return loader()()
 }
 
 // ErrorBoundaryProps configures the ErrorBoundary component
 type ErrorBoundaryProps struct {
-	Fallback func(error) g.Node
+	// Fallback replaces the children when an effect created by Render
+	// panics. Calling reset, e.g. from a retry button, unmounts it and runs
+	// Render again; the next panic shows Fallback again.
+	Fallback func(err error, reset func()) g.Node
 	Children g.Node
+	// Render builds the children in the boundary's own cleanup scope, so
+	// that panics of the effects they create later reach this boundary.
+	// When set it is used instead of Children.
+	Render func() g.Node
+	// OnError is notified of every error recovered inside the boundary,
+	// with the stack of the panic, e.g. for reporting. Errors that do not
+	// unmount the children, such as a panicking For row, only reach
+	// OnError.
+	OnError func(err error, stack string)
+}
+
+type errorBoundaryBinder struct {
+	props     ErrorBoundaryProps
+	container string // elementID of the mounted container
+	// scope owns what Render created; nil without Render
+	scope  *reactivity.CleanupScope
+	failed bool
+	// fallback owns the mounted Fallback while failed
+	fallback *reactivity.CleanupScope
 }
 
-// ErrorBoundary catches errors in child components and displays a fallback UI
+// ErrorBoundary catches errors in child components and displays a fallback UI.
+// Children are wrapped in a layout-neutral element so that descendants can
+// route recovered errors to the nearest boundary.
 func ErrorBoundary(props ErrorBoundaryProps) g.Node {
-	// In a basic implementation, we just return the children
-	// A full implementation would wrap in try/catch and handle errors
-	return props.Children
+	id := nextID("eb")
+	binder := errorBoundaryBinder{props: props, container: getCurrentMountContainer()}
+	children := props.Children
+	if props.Render != nil {
+		binder.scope = newErrorBoundaryScope(id, props, reactivity.GetCurrentCleanupScope())
+		children = renderInScope(binder.scope, props.Render)
+	}
+	errorBoundaryRegistry[id] = binder
+	return g.El("div",
+		g.Attr("data-uiwgo-error-boundary", id),
+		g.Attr("style", "display: contents"),
+		children,
+	)
+}
+
+// renderInScope calls render with scope as the current cleanup scope. The
+// scope is disposed if render panics.
+func renderInScope(scope *reactivity.CleanupScope, render func() g.Node) g.Node {
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	rendered := false
+	defer func() {
+		reactivity.SetCurrentCleanupScope(prev)
+		if !rendered {
+			scope.Dispose()
+		}
+	}()
+	node := render()
+	rendered = true
+	return node
+}
+
+// runInScope calls fn with scope as the current cleanup scope. Unlike
+// reactivity.RunWithOwner it keeps the current effect, so a binder effect
+// can create its rows in the scope it was attached in.
+func runInScope(scope *reactivity.CleanupScope, fn func()) {
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	defer reactivity.SetCurrentCleanupScope(prev)
+	fn()
+}
+
+// attachErrorBoundariesIn ties the scopes of the boundaries rendered
+// outside any cleanup scope, as the root of a Mount is, to the current one.
+func attachErrorBoundariesIn(root js.Value) {
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-error-boundary]")
+	for i := 0; i < nodes.Length(); i++ {
+		id := nodes.Index(i).Call("getAttribute", "data-uiwgo-error-boundary").String()
+		b, ok := errorBoundaryRegistry[id]
+		if !ok || b.scope == nil || b.scope.GetParent() != nil {
+			continue
+		}
+		if current := reactivity.GetCurrentCleanupScope(); current != nil && current != b.scope {
+			b.scope.SetParent(current)
+		}
+	}
+}
+
+// newErrorBoundaryScope returns the scope Render of boundary id runs in,
+// with a handler for the panics of its effects.
+func newErrorBoundaryScope(id string, props ErrorBoundaryProps, parent *reactivity.CleanupScope) *reactivity.CleanupScope {
+	scope := reactivity.NewCleanupScope(parent)
+	if props.Fallback != nil || props.OnError != nil {
+		scope.OnError(func(err error) { failErrorBoundary(id, err, reactivity.PanicStack(err)) })
+	}
+	return scope
+}
+
+// failErrorBoundary handles a panic of an effect created by the boundary's
+// Render: the error goes to OnError, and with a Fallback the children are
+// disposed and Fallback is mounted in their place.
+func failErrorBoundary(id string, err error, stack string) {
+	b, ok := errorBoundaryRegistry[id]
+	if !ok || b.failed {
+		return
+	}
+	if b.props.OnError != nil {
+		b.props.OnError(err, stack)
+	}
+	if b.props.Fallback == nil {
+		return
+	}
+	logutil.Logf("ErrorBoundary: showing fallback: %v", err)
+	b.failed = true
+	b.scope.Dispose()
+	b.fallback = reactivity.NewCleanupScope(b.scope.GetParent())
+	errorBoundaryRegistry[id] = b
+
+	reset := func() { resetErrorBoundary(id) }
+	mountErrorBoundary(id, b, b.fallback, func() g.Node { return b.props.Fallback(err, reset) })
+}
+
+// resetErrorBoundary unmounts the Fallback of a failed boundary and mounts
+// its Render again in a new scope. A panic of Render itself shows Fallback
+// again.
+func resetErrorBoundary(id string) {
+	b, ok := errorBoundaryRegistry[id]
+	if !ok || !b.failed {
+		return
+	}
+	b.fallback.Dispose()
+	b.scope = newErrorBoundaryScope(id, b.props, b.fallback.GetParent())
+	b.failed, b.fallback = false, nil
+	errorBoundaryRegistry[id] = b
+
+	defer func() {
+		if r := recover(); r != nil {
+			failErrorBoundary(id, panicError(r), string(debug.Stack()))
+		}
+	}()
+	mountErrorBoundary(id, b, b.scope, b.props.Render)
+}
+
+// mountErrorBoundary replaces the content of boundary id with what render
+// returns, rendered and attached in scope.
+func mountErrorBoundary(id string, b errorBoundaryBinder, scope *reactivity.CleanupScope, render func() g.Node) {
+	el := js.Global().Get("document").Call("querySelector", `[data-uiwgo-error-boundary="`+id+`"]`)
+	if !el.Truthy() {
+		return
+	}
+	prevContainer := getCurrentMountContainer()
+	setCurrentMountContainer(b.container)
+	defer setCurrentMountContainer(prevContainer)
+
+	var html string
+	callbacks := collectOnMount(func() {
+		html = renderToString(renderInScope(scope, render))
+	})
+	el.Set("innerHTML", html)
+	mountSubtree(el, scope, callbacks)
+}
+
+// reportToErrorBoundary routes err, recovered with stack, to the OnError
+// hook of the nearest ErrorBoundary enclosing el. It reports whether a hook
+// handled the error.
+func reportToErrorBoundary(el js.Value, err error, stack string) bool {
+	if !el.Truthy() {
+		return false
+	}
+	boundary := el.Call("closest", "[data-uiwgo-error-boundary]")
+	for boundary.Truthy() {
+		id := boundary.Call("getAttribute", "data-uiwgo-error-boundary").String()
+		if b, ok := errorBoundaryRegistry[id]; ok && b.props.OnError != nil {
+			b.props.OnError(err, stack)
+			return true
+		}
+		parent := boundary.Get("parentElement")
+		if !parent.Truthy() {
+			break
+		}
+		boundary = parent.Call("closest", "[data-uiwgo-error-boundary]")
+	}
+	return false
 }
 
 func attachShowBindersIn(root js.Value) {
@@ -675,14 +1022,38 @@ func attachShowBindersIn(root js.Value) {
 				b.container = getCurrentMountContainer()
 			}
 
+			if b.keepAlive {
+				b.effect = keepAliveShowEffect(el, b)
+				showRegistry[id] = b
+				el.Call("setAttribute", "data-uiwgo-bound-show", "1")
+				continue
+			}
+
 			// Create effect within the current cleanup scope context
 			// This ensures Show components within For items are properly cleaned up
-			effect := reactivity.CreateEffect(func() {
-				if b.when.Get() {
+			var cancelExit func()
+			first := true
+			effect := reactivity.CreateRenderEffect(func() {
+				shown := b.when.Get()
+				switch {
+				case shown && cancelExit != nil:
+					// Still exiting: keep the children
+					cancelExit()
+					cancelExit = nil
+				case shown:
 					el.Set("innerHTML", b.html)
-				} else {
+					if !first {
+						b.transition.enter(elementChildren(el)...)
+					}
+				case first:
 					el.Set("innerHTML", "")
+				case cancelExit == nil:
+					cancelExit = b.transition.exit(func() {
+						cancelExit = nil
+						el.Set("innerHTML", "")
+					}, elementChildren(el)...)
 				}
+				first = false
 			})
 			// Store the effect in the binder for cleanup
 			b.effect = effect
@@ -694,6 +1065,63 @@ func attachShowBindersIn(root js.Value) {
 	}
 }
 
+// keepAliveShowEffect toggles a KeepAlive Show: the children are rendered
+// into their own scope when first shown, then hidden and suspended, or shown
+// and resumed, with when. Disposing the returned effect disposes the
+// children's scope too.
+func keepAliveShowEffect(el js.Value, b showBinder) reactivity.Effect {
+	owner := reactivity.GetCurrentCleanupScope()
+	style := el.Get("style")
+	var scope *reactivity.CleanupScope
+	var cancelExit func()
+	first := true
+	toggle := reactivity.CreateRenderEffect(func() {
+		shown := b.when.Get()
+		switch {
+		case shown && scope == nil:
+			scope = reactivity.NewCleanupScope(owner)
+			var html string
+			var callbacks []func()
+			reactivity.UntrackVoid(func() {
+				callbacks = collectOnMount(func() { html = renderToString(b.children) })
+			})
+			el.Set("innerHTML", html)
+			mountSubtree(el, scope, callbacks)
+			if !first {
+				b.transition.enter(elementChildren(el)...)
+			}
+		case shown && cancelExit != nil:
+			cancelExit()
+			cancelExit = nil
+		case shown:
+			style.Call("removeProperty", "display")
+			scope.Resume()
+			b.transition.enter(elementChildren(el)...)
+		case scope != nil && cancelExit == nil:
+			cancelExit = b.transition.exit(func() {
+				cancelExit = nil
+				scope.Suspend()
+				style.Set("display", "none")
+			}, elementChildren(el)...)
+		}
+		first = false
+	})
+	return disposeFunc(func() {
+		toggle.Dispose()
+		if cancelExit != nil {
+			cancelExit()
+		}
+		if scope != nil {
+			scope.Dispose()
+		}
+	})
+}
+
+// disposeFunc is a reactivity.Effect made of its Dispose function.
+type disposeFunc func()
+
+func (f disposeFunc) Dispose() { f() }
+
 func attachHTMLBindersIn(root js.Value) {
 	nodes := root.Call("querySelectorAll", "[data-uiwgo-html]")
 	ln := nodes.Get("length").Int()
@@ -707,11 +1135,51 @@ func attachHTMLBindersIn(root js.Value) {
 
 		id := el.Call("getAttribute", "data-uiwgo-html").String()
 		if binder, ok := htmlRegistry[id]; ok {
-			effect := reactivity.CreateEffect(func() {
+			// Each render's content gets its own scope, disposed when the
+			// content is replaced
+			owner := reactivity.GetCurrentCleanupScope()
+			var content *reactivity.CleanupScope
+			render := func() {
+				// Render in the new content's scope so the render function
+				// sees the values provided to the binder
+				next := reactivity.NewCleanupScope(owner)
 				var buf bytes.Buffer
-				_ = binder.fn().Render(&buf)
+				callbacks := collectOnMount(func() {
+					_ = renderInScope(next, binder.fn).Render(&buf)
+				})
+				if content != nil {
+					content.Dispose()
+				}
+				content = next
 				el.Set("innerHTML", buf.String())
-			})
+				mountSubtree(el, content, callbacks)
+			}
+			if containerID := binder.container; containerID != "" {
+				// Errors no ErrorBoundary handles reach MountWithRecovery
+				render = recoverTo(el, containerID, render)
+			}
+			effectFn := render
+			if binder.deps != nil {
+				// Re-render only when a declared dependency changes; signals
+				// fn reads are not tracked.
+				prev := binder.depValues
+				// The first render ran while the tree was built, outside
+				// any Provider; inside one it renders again to see the
+				// provided values
+				stale := contextScopeOf(el) != nil
+				effectFn = func() {
+					next := readDeps(binder.deps)
+					if stale {
+						stale = false
+						*binder.replaced = true
+					} else if reflect.DeepEqual(prev, next) {
+						return
+					}
+					prev = next
+					reactivity.UntrackVoid(render)
+				}
+			}
+			effect := reactivity.CreateRenderEffect(effectFn)
 			// Store the effect in the binder for cleanup
 			binder.effect = effect
 			htmlRegistry[id] = binder
@@ -739,10 +1207,14 @@ func attachForBindersIn(root js.Value) {
 		if binder, ok := forRegistry[id]; ok {
 			binder.container = el
 			forRegistry[id] = binder
-			// Create reactive effect for list reconciliation
-			effect := reactivity.CreateEffect(func() {
-				reconcileForList(id)
+			// Create reactive effect for list reconciliation; rows added
+			// later belong to the scope the list was attached in
+			owner := reactivity.GetCurrentCleanupScope()
+			effect := reactivity.CreateRenderEffect(func() {
+				runInScope(owner, func() { reconcileForList(id) })
 			})
+			// The first reconcile stored the rows; keep them
+			binder = forRegistry[id]
 			binder.effect = effect
 			forRegistry[id] = binder
 			// Register cleanup
@@ -753,6 +1225,7 @@ func attachForBindersIn(root js.Value) {
 							record.cleanup()
 						}
 					}
+					finishExits(b)
 					if b.effect != nil {
 						b.effect.Dispose()
 					}
@@ -778,12 +1251,17 @@ func attachIndexBindersIn(root js.Value) {
 		if binder, ok := indexRegistry[id]; ok {
 			binder.container = el
 			indexRegistry[id] = binder
-			// Create reactive effect for list reconciliation
-			effect := reactivity.CreateEffect(func() {
+			// Create reactive effect for list reconciliation; rows added
+			// later belong to the scope the list was attached in
+			owner := reactivity.GetCurrentCleanupScope()
+			effect := reactivity.CreateRenderEffect(func() {
 				if b, exists := indexRegistry[id]; exists {
-					reconcileIndexList(&b)
+					runInScope(owner, func() { reconcileIndexList(&b) })
+					indexRegistry[id] = b
 				}
 			})
+			// The first reconcile already stored the rows
+			binder = indexRegistry[id]
 			binder.effect = effect
 			indexRegistry[id] = binder
 			// Register cleanup
@@ -822,7 +1300,7 @@ func attachSwitchBindersIn(root js.Value) {
 			binder.container = el
 			switchRegistry[id] = binder
 			// Create reactive effect for branch switching
-			effect := reactivity.CreateEffect(func() {
+			effect := reactivity.CreateRenderEffect(func() {
 				reconcileSwitchBranch(id)
 			})
 			binder.effect = effect
@@ -865,8 +1343,9 @@ func attachDynamicBindersIn(root js.Value) {
 			continue
 		}
 		binder.container = node
-		binder.effect = reactivity.CreateEffect(func() {
-			reconcileDynamicComponent(&binder)
+		owner := reactivity.GetCurrentCleanupScope()
+		binder.effect = reactivity.CreateRenderEffect(func() {
+			runInScope(owner, func() { reconcileDynamicComponent(&binder) })
 		})
 		// Register cleanup
 		reactivity.OnCleanup(func() {
@@ -901,7 +1380,7 @@ func reconcileForList(id string) {
 	// Build new keys
 	newKeys := make([]string, len(items))
 	for i, item := range items {
-		key := callKeyFunc(binder.keyFn, item)
+		key := safeCallKeyFunc(binder, item)
 		if key == "" {
 			// Use index-based key when no key function or key function returns empty
 			key = fmt.Sprintf("__index_%d", i)
@@ -909,49 +1388,75 @@ func reconcileForList(id string) {
 		newKeys[i] = key
 	}
 
+	// Row positions before the update, for sliding moved rows
+	before := binder.animation.measure(binder.childRecords)
+
+	if binder.indexedFn != nil {
+		reconcileKeyedRows(&binder, items, newKeys)
+		binder.animation.flip(before, binder.childRecords)
+		forRegistry[id] = binder
+		return
+	}
+
 	// Track which keys are new, removed, or moved
 	oldRecords := binder.childRecords
 	newRecords := make(map[string]*childRecord)
+	kept := make(map[string]bool, len(newKeys))
+	for _, key := range newKeys {
+		kept[key] = true
+	}
 
 	// Remove items that are no longer present
 	for key, record := range oldRecords {
-		found := false
-		for _, newKey := range newKeys {
-			if newKey == key {
-				found = true
-				break
+		if !kept[key] {
+			// An exiting row keeps its place before the next kept row
+			record.anchor = ""
+			anchorIndex := -1
+			for other, rec := range oldRecords {
+				if kept[other] && rec.index > record.index && (anchorIndex < 0 || rec.index < anchorIndex) {
+					record.anchor, anchorIndex = other, rec.index
+				}
 			}
-		}
-		if !found {
 			// Remove from DOM and cleanup
-			if !record.element.IsUndefined() {
-				record.element.Call("remove")
-			}
-			if record.cleanup != nil {
-				record.cleanup()
-			}
+			removeForRow(&binder, record)
 		}
 	}
 
 	// Process new items and reorder
+	initial := binder.effect == nil
 	for i, key := range newKeys {
+		// A row shown again while exiting is rendered anew in its place
+		returning := false
+		if rec, ok := binder.exiting[key]; ok {
+			finishExit(&binder, rec)
+			returning = true
+		}
 		// Always recreate elements to ensure content is up-to-date
 		item := items[i]
-		element, cleanup := createItemElement(binder.childrenFn, item, i, binder.mountContainer)
+		element, cleanup, mount := createForRow(binder, binder.childrenFn, item, i)
 		newRecords[key] = &childRecord{
 			key:     key,
 			index:   i,
 			element: element,
 			cleanup: cleanup,
+			mount:   mount,
+		}
+		if _, ok := oldRecords[key]; !ok && !returning && !initial && element.Truthy() {
+			binder.transition.enter(element)
+			binder.animation.enter(element)
 		}
 	}
 
 	// Reorder DOM elements to match new order
 	container := binder.container
 
-	// Clear container first to avoid duplication
-	for container.Get("firstChild").Truthy() {
-		container.Call("removeChild", container.Get("firstChild"))
+	// Clear container first to avoid duplication, keeping exiting rows
+	for child := container.Get("firstChild"); child.Truthy(); {
+		next := child.Get("nextSibling")
+		if child.Get("nodeType").Int() != 1 || !child.Call("hasAttribute", "data-uiwgo-exiting").Bool() {
+			container.Call("removeChild", child)
+		}
+		child = next
 	}
 
 	// Append elements in correct order
@@ -959,12 +1464,182 @@ func reconcileForList(id string) {
 		record := newRecords[key]
 		container.Call("appendChild", record.element)
 	}
+	placeExitingRows(&binder, newRecords)
+
+	// Attach each row's binders and run its OnMount callbacks now that the
+	// rows are in the document
+	for _, key := range newKeys {
+		if record := newRecords[key]; record.mount != nil {
+			record.mount()
+			record.mount = nil
+		}
+	}
+
+	binder.animation.flip(before, newRecords)
 
 	// Update registry
 	binder.childRecords = newRecords
 	forRegistry[id] = binder
 }
 
+// reconcileKeyedRows updates a For with ChildrenWithIndex: rows whose key
+// and item are unchanged keep their element, which is moved only when out
+// of order, and get their new position through their index signal.
+func reconcileKeyedRows(binder *forBinder, items []any, keys []string) {
+	old := binder.childRecords
+	next := make(map[string]*childRecord, len(keys))
+	initial := binder.effect == nil
+	var moved []*childRecord
+	for i, key := range keys {
+		if _, dup := next[key]; dup {
+			key = fmt.Sprintf("__index_%d", i)
+			keys[i] = key
+		}
+		// A row shown again while exiting is kept when its item is the same
+		returning := false
+		if rec, ok := binder.exiting[key]; ok {
+			if reflect.DeepEqual(rec.item, items[i]) {
+				rec.cancelExit()
+				rec.element.Call("removeAttribute", "data-uiwgo-exiting")
+				delete(binder.exiting, key)
+				old[key] = rec
+			} else {
+				finishExit(binder, rec)
+			}
+			returning = true
+		}
+		if rec, ok := old[key]; ok && rec.indexSig != nil && reflect.DeepEqual(rec.item, items[i]) {
+			delete(old, key)
+			rec.index = i
+			next[key] = rec
+			moved = append(moved, rec)
+			continue
+		}
+		index := reactivity.CreateSignal(i)
+		render := func(item any, _ int) g.Node { return binder.indexedFn(item, index) }
+		var element js.Value
+		var cleanup, mount func()
+		// Rows render inside the list's effect; keep their reads out of it
+		reactivity.UntrackVoid(func() {
+			element, cleanup, mount = createForRow(*binder, render, items[i], i)
+		})
+		next[key] = &childRecord{key: key, index: i, element: element, cleanup: cleanup, mount: mount, item: items[i], indexSig: index}
+		if !returning && !initial && element.Truthy() {
+			binder.transition.enter(element)
+			binder.animation.enter(element)
+		}
+	}
+
+	// Drop the rows whose key is gone or whose item changed
+	for _, rec := range old {
+		removeForRow(binder, rec)
+	}
+
+	// Place the rows, moving an element only when it is out of order;
+	// exiting rows stay where they are
+	container := binder.container
+	cursor := container.Get("firstElementChild")
+	for _, key := range keys {
+		rec := next[key]
+		if !rec.element.Truthy() {
+			continue
+		}
+		for cursor.Truthy() && cursor.Call("hasAttribute", "data-uiwgo-exiting").Bool() {
+			cursor = cursor.Get("nextElementSibling")
+		}
+		if cursor.Equal(rec.element) {
+			cursor = cursor.Get("nextElementSibling")
+		} else {
+			container.Call("insertBefore", rec.element, cursor)
+		}
+	}
+
+	reactivity.UntrackVoid(func() {
+		for _, key := range keys {
+			if rec := next[key]; rec.mount != nil {
+				rec.mount()
+				rec.mount = nil
+			}
+		}
+		reactivity.Batch(func() {
+			for _, rec := range moved {
+				rec.indexSig.Set(rec.index)
+			}
+		})
+	})
+	binder.childRecords = next
+}
+
+// removeForRow removes the row of rec from a For and disposes it, after
+// its exit transition or animation when the For has one.
+func removeForRow(binder *forBinder, rec *childRecord) {
+	animates := binder.animation.exits()
+	if !(animates || binder.transition.exits()) || !rec.element.Truthy() || rec.element.Call("hasAttribute", "data-uiwgo-row-error").Bool() {
+		if rec.element.Truthy() {
+			rec.element.Call("remove")
+		}
+		if rec.cleanup != nil {
+			rec.cleanup()
+		}
+		return
+	}
+	exiting := binder.exiting
+	rec.element.Call("setAttribute", "data-uiwgo-exiting", "")
+	exiting[rec.key] = rec
+	done := func() {
+		if exiting[rec.key] == rec {
+			delete(exiting, rec.key)
+		}
+		rec.element.Call("remove")
+		if rec.cleanup != nil {
+			rec.cleanup()
+		}
+	}
+	if animates {
+		rec.cancelExit = binder.animation.exit(rec.element, done)
+		return
+	}
+	rec.cancelExit = binder.transition.exit(done, rec.element)
+}
+
+// finishExit ends the exit transition of rec now, removing its row.
+func finishExit(binder *forBinder, rec *childRecord) {
+	rec.cancelExit()
+	delete(binder.exiting, rec.key)
+	rec.element.Call("remove")
+	if rec.cleanup != nil {
+		rec.cleanup()
+	}
+}
+
+// finishExits ends the exit transitions of all rows of a For being
+// disposed.
+func finishExits(binder forBinder) {
+	for _, rec := range binder.exiting {
+		finishExit(&binder, rec)
+	}
+}
+
+// placeExitingRows moves the exiting rows of a For, which were left at the
+// start of its container, back in front of the rows they preceded.
+func placeExitingRows(binder *forBinder, records map[string]*childRecord) {
+	if len(binder.exiting) == 0 {
+		return
+	}
+	exiting := make([]*childRecord, 0, len(binder.exiting))
+	for _, rec := range binder.exiting {
+		exiting = append(exiting, rec)
+	}
+	sort.Slice(exiting, func(i, j int) bool { return exiting[i].index < exiting[j].index })
+	for _, rec := range exiting {
+		if next, ok := records[rec.anchor]; ok && next.element.Truthy() {
+			binder.container.Call("insertBefore", rec.element, next.element)
+		} else {
+			binder.container.Call("appendChild", rec.element)
+		}
+	}
+}
+
 // extractMatchCases extracts match cases from template children within a switch container
 func extractMatchCases(container js.Value) []matchCase {
 	cases := make([]matchCase, 0)
@@ -1072,7 +1747,7 @@ func reconcileDynamicComponent(binder *dynamicBinder) {
 	// Get current component function
 	currentComponent := getComponentFromSource(binder.component)
 
-	// Clean up previous component
+	// Clean up previous component before the next one renders
 	if binder.currentCleanup != nil {
 		binder.currentCleanup()
 		binder.currentCleanup = nil
@@ -1082,59 +1757,88 @@ func reconcileDynamicComponent(binder *dynamicBinder) {
 	binder.container.Set("innerHTML", "")
 
 	// Render new component if available
-	if currentComponent != nil {
-		// Create a temporary container for the component
-		tempDiv := js.Global().Get("document").Call("createElement", "div")
-
-		// Render the component to HTML
-		var buf bytes.Buffer
-		_ = currentComponent().Render(&buf)
-		tempDiv.Set("innerHTML", buf.String())
-
-		// Move all children from temp div to actual container
-		for tempDiv.Get("firstChild").Truthy() {
-			child := tempDiv.Get("firstChild")
-			binder.container.Call("appendChild", child)
-		}
+	if currentComponent == nil {
+		return
 	}
+	scope := reactivity.NewCleanupScope(reactivity.GetCurrentCleanupScope())
+	binder.currentCleanup = scope.Dispose
+	var html string
+	callbacks := collectOnMount(func() {
+		reactivity.UntrackVoid(func() {
+			html = renderToString(renderInScope(scope, func() g.Node {
+				return renderDynamicComponent(currentComponent, binder.props)
+			}))
+		})
+	})
+	binder.container.Set("innerHTML", html)
+	mountSubtree(binder.container, scope, callbacks)
 }
 
-// getComponentFromSource extracts ComponentFunc from Signal or function
-func getComponentFromSource(source any) func() g.Node {
-	if source == nil {
+// getComponentFromSource returns the component selected by a Dynamic's
+// Component: the value of a signal, the result of a function returning a
+// component or the component itself. It returns nil for no component.
+func getComponentFromSource(source any) any {
+	var component any
+	switch s := source.(type) {
+	case nil:
 		return nil
+	case reactivity.Signal[func() g.Node]:
+		component = s.Get()
+	case func() g.Node:
+		component = s
+	default:
+		v := reflect.ValueOf(source)
+		if get := v.MethodByName("Get"); get.IsValid() && get.Type().NumIn() == 0 && get.Type().NumOut() == 1 {
+			// A signal or memo holding the component
+			component = get.Call(nil)[0].Interface()
+		} else if v.Kind() == reflect.Func && v.Type().NumIn() == 0 && v.Type().NumOut() == 1 && v.Type().Out(0).Kind() == reflect.Func {
+			// A function returning the component
+			component = v.Call(nil)[0].Interface()
+		} else {
+			component = source
+		}
 	}
-
-	// Check if it's a Signal[func() g.Node] specifically
-	if signal, ok := source.(reactivity.Signal[func() g.Node]); ok {
-		return signal.Get()
-	}
-
-	// Try to get the value using reflection
-	v := reflect.ValueOf(source)
-	if !v.IsValid() {
+	if v := reflect.ValueOf(component); !v.IsValid() || (v.Kind() == reflect.Func && v.IsNil()) {
 		return nil
 	}
+	return component
+}
 
-	// If it's a function, call it
-	if v.Kind() == reflect.Func {
-		result := v.Call(nil)
-		if len(result) > 0 && result[0].IsValid() {
-			if fn, ok := result[0].Interface().(func() g.Node); ok {
-				return fn
+// renderDynamicComponent calls component, passing it props if it takes an
+// argument.
+func renderDynamicComponent(component any, props any) g.Node {
+	if fn, ok := component.(func() g.Node); ok {
+		return fn()
+	}
+	v := reflect.ValueOf(component)
+	if v.Kind() != reflect.Func || v.Type().NumIn() > 1 || v.Type().NumOut() != 1 {
+		logutil.Logf("Dynamic: %T is not a component", component)
+		return g.Group(nil)
+	}
+	var args []reflect.Value
+	if v.Type().NumIn() == 1 {
+		in := v.Type().In(0)
+		arg := reflect.Zero(in)
+		if props != nil {
+			if pv := reflect.ValueOf(props); pv.Type().AssignableTo(in) {
+				arg = pv
+			} else {
+				logutil.Logf("Dynamic: props of type %T do not fit %v, passing its zero value", props, in)
 			}
 		}
+		args = []reflect.Value{arg}
 	}
-
-	// If it's already a ComponentFunc
-	if fn, ok := source.(func() g.Node); ok {
-		return fn
+	node, ok := v.Call(args)[0].Interface().(g.Node)
+	if !ok || node == nil {
+		return g.Group(nil)
 	}
-
-	return nil
+	return node
 }
 
-// reconcileIndexList implements index-based reconciliation for Index components
+// reconcileIndexList implements index-based reconciliation for Index
+// components. Rows stay in place while the list keeps its length; the value
+// at each position is passed on through the row's item signal, so only the
+// rows whose value changed update.
 func reconcileIndexList(binder *indexBinder) {
 	// Get current items
 	items := getItemsFromSource(binder.items)
@@ -1168,32 +1872,44 @@ func reconcileIndexList(binder *indexBinder) {
 		}
 	}
 
-	// Create or update records
-	for i := 0; i < newLen; i++ {
-		if binder.childRecords[i] == nil {
-			// Create new record with getter function
-			getItem := createItemGetter(binder.items, i)
-			element, cleanup := createIndexItemElement(binder.childrenFn, getItem, i, binder.mountContainer)
+	reactivity.UntrackVoid(func() {
+		// Pass the new values to the existing rows
+		reactivity.Batch(func() {
+			for i, record := range binder.childRecords {
+				if record != nil && record.itemSig != nil && !reflect.DeepEqual(record.itemSig.Peek(), items[i]) {
+					record.itemSig.Set(items[i])
+				}
+			}
+		})
+
+		// Create the rows for new positions and append them in order
+		for i := 0; i < newLen; i++ {
+			if binder.childRecords[i] != nil {
+				continue
+			}
+			itemSig := reactivity.CreateSignal(items[i])
+			element, cleanup, mount := createIndexItemElement(binder.childrenFn, itemSig.Get, i, binder.mountContainer)
 			binder.childRecords[i] = &childRecord{
 				key:     strconv.Itoa(i),
 				index:   i,
 				element: element,
 				cleanup: cleanup,
+				mount:   mount,
+				itemSig: itemSig,
+			}
+			if element.Truthy() {
+				binder.container.Call("appendChild", element)
 			}
 		}
-	}
-
-	// Clear container and append elements in correct order
-	for binder.container.Get("firstChild").Truthy() {
-		binder.container.Call("removeChild", binder.container.Get("firstChild"))
-	}
 
-	// Append elements in correct order
-	for _, record := range binder.childRecords {
-		if record != nil {
-			binder.container.Call("appendChild", record.element)
+		// Attach the new rows now that they are in the document
+		for _, record := range binder.childRecords {
+			if record != nil && record.mount != nil {
+				record.mount()
+				record.mount = nil
+			}
 		}
-	}
+	})
 }
 
 // getItemsFromSource extracts items from either a Signal or a function
@@ -1262,15 +1978,17 @@ func callKeyFunc(keyFn any, item any) string {
 	return results[0].String()
 }
 
-// createItemElement creates a DOM element for a For item
-func createItemElement(childrenFn any, item any, index int, mountContainer string) (js.Value, func()) {
+// createItemElement creates a DOM element for a For item. Once the element
+// is in the document, mount attaches its binders and runs its OnMount
+// callbacks.
+func createItemElement(childrenFn any, item any, index int, mountContainer string) (element js.Value, cleanup func(), mount func()) {
 	if childrenFn == nil {
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	v := reflect.ValueOf(childrenFn)
 	if v.Kind() != reflect.Func {
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Store the current mount container context
@@ -1279,26 +1997,34 @@ func createItemElement(childrenFn any, item any, index int, mountContainer strin
 	// Set the mount container to the For component's container for proper Show component binding
 	setCurrentMountContainer(mountContainer)
 
-	// Create a reactivity scope for this item to ensure proper cleanup
-	var element js.Value
-
 	// Create a new cleanup scope for this item
 	scope := reactivity.NewCleanupScope(reactivity.GetCurrentCleanupScope())
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(scope)
 
-	// Call childrenFn(item, index) within the scope
+	// Restore the previous scope and container context even if childrenFn
+	// panics, and drop the item scope unless an element was produced.
+	created := false
+	defer func() {
+		reactivity.SetCurrentCleanupScope(prevScope)
+		setCurrentMountContainer(prevContainer)
+		if !created {
+			scope.Dispose()
+		}
+	}()
+
+	// Call childrenFn(item, index) within the scope, keeping the row's
+	// OnMount callbacks until the row is attached
 	args := []reflect.Value{
 		reflect.ValueOf(item),
 		reflect.ValueOf(index),
 	}
-	results := v.Call(args)
+	var results []reflect.Value
+	callbacks := collectOnMount(func() {
+		results = v.Call(args)
+	})
 	if len(results) == 0 {
-		// Restore previous scope and container context
-		reactivity.SetCurrentCleanupScope(prevScope)
-		setCurrentMountContainer(prevContainer)
-		scope.Dispose()
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Render the Node to HTML
@@ -1319,44 +2045,127 @@ func createItemElement(childrenFn any, item any, index int, mountContainer strin
 		element = wrapper
 	}
 
-	// Restore previous scope and container context
-	reactivity.SetCurrentCleanupScope(prevScope)
-	setCurrentMountContainer(prevContainer)
-
 	if element.IsUndefined() {
-		scope.Dispose()
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
+	created = true
 
 	// Create cleanup function that disposes the scope
-	cleanup := func() {
+	cleanup = func() {
 		scope.Dispose()
 	}
+	mount = func() {
+		mountSubtree(element, scope, callbacks)
+	}
 
-	return element, cleanup
+	return element, cleanup, mount
+}
+
+// createForRow renders a single For row, isolating panics from its
+// Children function so the rest of the list keeps rendering. A failed row is
+// replaced by the binder's RowErrorFallback (or an empty placeholder) and the
+// error is routed to the nearest ErrorBoundary. Rows are re-rendered on every
+// reconciliation, or with ChildrenWithIndex when their item changes, so a
+// failed row is retried once its item changes.
+func createForRow(binder forBinder, childrenFn any, item any, index int) (element js.Value, cleanup func(), mount func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			err := panicError(r)
+			element, cleanup, mount = renderRowFallback(binder, err, item), nil, nil
+			if !reportToErrorBoundary(binder.container, err, string(debug.Stack())) && !reportToMount(binder.mountContainer, err) {
+				logutil.Logf("For: row %d failed to render: %v", index, err)
+			}
+		}
+	}()
+	return createItemElement(childrenFn, item, index, binder.mountContainer)
+}
+
+// safeCallKeyFunc calls the binder's key function, returning "" (and thus an
+// index-based key) if it panics.
+func safeCallKeyFunc(binder forBinder, item any) (key string) {
+	defer func() {
+		if r := recover(); r != nil {
+			logutil.Logf("For: key function failed: %v", r)
+			key = ""
+		}
+	}()
+	return callKeyFunc(binder.keyFn, item)
+}
+
+// renderRowFallback builds the element shown in place of a failed For row.
+// It is marked with data-uiwgo-row-error so it can be told apart from rows.
+func renderRowFallback(binder forBinder, err error, item any) (element js.Value) {
+	doc := js.Global().Get("document")
+	defer func() {
+		if r := recover(); r != nil {
+			logutil.Logf("For: RowErrorFallback failed: %v", r)
+			element = doc.Call("createElement", "div")
+			element.Call("setAttribute", "data-uiwgo-row-error", "")
+		}
+	}()
+
+	wrapper := doc.Call("createElement", "div")
+	if binder.rowFallback != nil {
+		var buf bytes.Buffer
+		if node := binder.rowFallback(err, item); node != nil {
+			_ = node.Render(&buf)
+		}
+		wrapper.Set("innerHTML", buf.String())
+	}
+	element = wrapper
+	if child := wrapper.Get("firstElementChild"); child.Truthy() && wrapper.Get("childElementCount").Int() == 1 {
+		element = child
+	}
+	element.Call("setAttribute", "data-uiwgo-row-error", "")
+	return element
+}
+
+// recoverTo wraps a binder update so that, once mounted, a panic is routed
+// to the nearest ErrorBoundary of el or else to the MountWithRecovery
+// handler of containerID. With neither in place the panic propagates.
+func recoverTo(el js.Value, containerID string, fn func()) func() {
+	return func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err := panicError(r)
+				if !reportToErrorBoundary(el, err, string(debug.Stack())) && !reportToMount(containerID, err) {
+					panic(r)
+				}
+			}
+		}()
+		fn()
+	}
+}
+
+// panicError converts a recovered panic value into an error.
+func panicError(r any) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf("%v", r)
 }
 
 // createIndexItemElement creates a DOM element for an Index item
-func createIndexItemElement(childrenFn any, getItem func() any, index int, mountContainer string) (js.Value, func()) {
+func createIndexItemElement(childrenFn any, getItem func() any, index int, mountContainer string) (js.Value, func(), func()) {
 	if childrenFn == nil {
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	v := reflect.ValueOf(childrenFn)
 	if v.Kind() != reflect.Func {
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Create a typed wrapper function that matches the expected signature
 	funcType := v.Type()
 	if funcType.NumIn() != 2 {
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Get the expected type of the first parameter (getItem function)
 	getItemType := funcType.In(0)
 	if getItemType.Kind() != reflect.Func {
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Create a typed wrapper function
@@ -1387,13 +2196,16 @@ func createIndexItemElement(childrenFn any, getItem func() any, index int, mount
 		wrapperFn,
 		reflect.ValueOf(index),
 	}
-	results := v.Call(args)
+	var results []reflect.Value
+	callbacks := collectOnMount(func() {
+		results = v.Call(args)
+	})
 	if len(results) == 0 {
 		// Restore previous scope and container context
 		reactivity.SetCurrentCleanupScope(prevScope)
 		setCurrentMountContainer(prevMountContainer)
 		scope.Dispose()
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Render the Node to HTML
@@ -1420,7 +2232,7 @@ func createIndexItemElement(childrenFn any, getItem func() any, index int, mount
 
 	if element.IsUndefined() {
 		scope.Dispose()
-		return js.Undefined(), nil
+		return js.Undefined(), nil, nil
 	}
 
 	// Create cleanup function that disposes the scope
@@ -1428,16 +2240,9 @@ func createIndexItemElement(childrenFn any, getItem func() any, index int, mount
 		scope.Dispose()
 	}
 
-	return element, cleanup
-}
-
-// createItemGetter creates a getter function for Index components
-func createItemGetter(source any, index int) func() any {
-	return func() any {
-		items := getItemsFromSource(source)
-		if items == nil || index >= len(items) {
-			return nil
-		}
-		return items[index]
+	mount := func() {
+		mountSubtree(element, scope, callbacks)
 	}
+
+	return element, cleanup, mount
 }
@@ -0,0 +1,102 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strconv"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// TestIndexUpdatesOnlyChangedPosition sets a list with one changed value
+// and checks that the rows are kept and only that row's text changes.
+func TestIndexUpdatesOnlyChangedPosition(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	items := reactivity.CreateSignal([]string{"a", "b", "c"})
+	renders := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Index(IndexProps[string]{
+			Items: items,
+			Children: func(getItem func() string, index int) g.Node {
+				renders++
+				return g.El("p", BindText(func() string { return getItem() }))
+			},
+		})
+	})
+	defer disposer()
+
+	rows := container.Call("querySelectorAll", "p")
+	if rows.Get("length").Int() != 3 || renders != 3 {
+		t.Fatalf("rendered %d rows with %d renders, want 3", rows.Get("length").Int(), renders)
+	}
+	before := make([]js.Value, 3)
+	for i := range before {
+		before[i] = rows.Index(i)
+	}
+
+	observer := js.Global().Get("MutationObserver").New(js.FuncOf(func(js.Value, []js.Value) any { return nil }))
+	defer observer.Call("disconnect")
+	observer.Call("observe", container, map[string]any{"childList": true, "subtree": true, "characterData": true})
+
+	items.Set([]string{"a", "B", "c"})
+
+	records := observer.Call("takeRecords")
+	for i := 0; i < records.Get("length").Int(); i++ {
+		target := records.Index(i).Get("target")
+		if !before[1].Call("contains", target).Bool() {
+			t.Errorf("mutation outside the changed row: %s on %s", records.Index(i).Get("type").String(), target.Get("nodeName").String())
+		}
+	}
+	if records.Get("length").Int() == 0 {
+		t.Error("the changed row was not updated")
+	}
+
+	rows = container.Call("querySelectorAll", "p")
+	for i, want := range []string{"a", "B", "c"} {
+		if !rows.Index(i).Equal(before[i]) {
+			t.Errorf("row %d was recreated", i)
+		}
+		if text := rows.Index(i).Get("textContent").String(); text != want {
+			t.Errorf("row %d text = %q, want %q", i, text, want)
+		}
+	}
+	if renders != 3 {
+		t.Errorf("Children ran %d times, want 3", renders)
+	}
+}
+
+// TestIndexGrowsAndShrinksInPlace checks that changing the length only adds
+// or removes rows at the end.
+func TestIndexGrowsAndShrinksInPlace(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	items := reactivity.CreateSignal([]int{1, 2})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Index(IndexProps[int]{
+			Items: items,
+			Children: func(getItem func() int, index int) g.Node {
+				return g.El("p", BindText(func() string { return strconv.Itoa(getItem()) }))
+			},
+		})
+	})
+	defer disposer()
+
+	first := container.Call("querySelector", "p")
+	items.Set([]int{1, 2, 3})
+	if got := container.Get("textContent").String(); got != "123" {
+		t.Fatalf("text after growing = %q, want 123", got)
+	}
+	items.Set([]int{4})
+	if got := container.Get("textContent").String(); got != "4" {
+		t.Fatalf("text after shrinking = %q, want 4", got)
+	}
+	if !container.Call("querySelector", "p").Equal(first) {
+		t.Error("the first row was recreated")
+	}
+}
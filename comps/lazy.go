@@ -0,0 +1,86 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"sync"
+
+	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// LazyAsyncProps configures one place a LazyAsync component is rendered.
+type LazyAsyncProps struct {
+	// Fallback is shown while the component loads.
+	Fallback g.Node
+	// ErrorFallback replaces the component when its loader failed; nothing
+	// is shown when it is nil.
+	ErrorFallback func(err error) g.Node
+}
+
+// Load states of a LazyAsync component.
+const (
+	lazyLoading = iota
+	lazyLoaded
+	lazyFailed
+)
+
+// LazyAsync returns a component whose render function is fetched by
+// loader, e.g. after downloading its data or code. loader runs in a
+// goroutine the first time the component is rendered and at most once,
+// however many places render it; each place shows its Fallback meanwhile.
+// A place unmounted before loader returns never mounts the component.
+//
+//	var Chart = comps.LazyAsync(loadChart)
+//
+//	Chart(comps.LazyAsyncProps{Fallback: h.P(g.Text("Loading chart..."))})
+func LazyAsync(loader func() (func() g.Node, error)) func(LazyAsyncProps) g.Node {
+	var (
+		once   sync.Once
+		state  = reactivity.CreateSignal(lazyLoading)
+		render func() g.Node
+		err    error
+	)
+	load := func() {
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					err = panicError(r)
+					logutil.Logf("LazyAsync: loader panicked: %v", err)
+					state.Set(lazyFailed)
+				}
+			}()
+			render, err = loader()
+			if err != nil {
+				logutil.Logf("LazyAsync: loader failed: %v", err)
+				state.Set(lazyFailed)
+				return
+			}
+			state.Set(lazyLoaded)
+		}()
+	}
+
+	return func(p LazyAsyncProps) g.Node {
+		once.Do(load)
+		// Only the load state re-renders the place; the signals the loaded
+		// component reads are followed by its own binders
+		return BindHTMLDeps(func() g.Node {
+			switch state.Peek() {
+			case lazyLoaded:
+				if render != nil {
+					return render()
+				}
+			case lazyFailed:
+				if p.ErrorFallback != nil {
+					return p.ErrorFallback(err)
+				}
+			default:
+				if p.Fallback != nil {
+					return p.Fallback
+				}
+			}
+			return g.Group(nil)
+		}, func() any { return state.Get() })
+	}
+}
@@ -0,0 +1,99 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	g "maragu.dev/gomponents"
+)
+
+func TestLazyAsyncLoadsOnceForEveryPlace(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	release := make(chan error)
+	loads := 0
+	chart := LazyAsync(func() (func() g.Node, error) {
+		loads++
+		if err := <-release; err != nil {
+			return nil, err
+		}
+		return func() g.Node { return g.El("canvas", g.Attr("class", "chart")) }, nil
+	})
+	props := LazyAsyncProps{Fallback: g.El("p", g.Attr("class", "loading"), g.Text("Loading"))}
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("main", chart(props), chart(props))
+	})
+	defer disposer()
+
+	count := func(selector string) int {
+		return container.Call("querySelectorAll", selector).Length()
+	}
+	if count(".loading") != 2 {
+		t.Fatalf("%d fallbacks shown while loading, want 2", count(".loading"))
+	}
+
+	release <- nil
+	waitFor(t, "the loaded component", func() bool { return count(".chart") == 2 })
+	if count(".loading") != 0 {
+		t.Error("fallbacks were kept after loading")
+	}
+	if loads != 1 {
+		t.Errorf("loader ran %d times, want once", loads)
+	}
+}
+
+func TestLazyAsyncShowsErrorFallback(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	release := make(chan error)
+	widget := LazyAsync(func() (func() g.Node, error) {
+		return nil, <-release
+	})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return widget(LazyAsyncProps{
+			ErrorFallback: func(err error) g.Node {
+				return g.El("p", g.Attr("class", "error"), g.Text(err.Error()))
+			},
+		})
+	})
+	defer disposer()
+
+	release <- errors.New("chunk not found")
+	waitFor(t, "the error fallback", func() bool {
+		return container.Call("querySelector", ".error").Truthy()
+	})
+	if got := container.Call("querySelector", ".error").Get("textContent").String(); got != "chunk not found" {
+		t.Errorf("error fallback = %q, want the loader's error", got)
+	}
+}
+
+func TestLazyAsyncDoesNotMountAfterUnmount(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	release := make(chan error)
+	rendered := false
+	widget := LazyAsync(func() (func() g.Node, error) {
+		<-release
+		return func() g.Node {
+			rendered = true
+			return g.El("div", g.Attr("class", "widget"))
+		}, nil
+	})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return widget(LazyAsyncProps{})
+	})
+
+	disposer()
+	release <- nil
+	// Let the loader's goroutine finish
+	time.Sleep(20 * time.Millisecond)
+	if rendered || container.Call("querySelector", ".widget").Truthy() {
+		t.Error("the component was mounted into an unmounted place")
+	}
+}
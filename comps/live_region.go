@@ -0,0 +1,135 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"time"
+
+	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+const (
+	// PolitenessPolite waits for the user to be idle before announcing.
+	PolitenessPolite = "polite"
+	// PolitenessAssertive interrupts the user to announce immediately.
+	PolitenessAssertive = "assertive"
+)
+
+var (
+	// announceDuration is how long a message stays in the region before it is cleared.
+	announceDuration = time.Second
+	// announceGap is the pause between clearing a message and showing the next one.
+	announceGap = 50 * time.Millisecond
+)
+
+// visuallyHiddenStyle hides content visually while keeping it in the accessibility tree.
+const visuallyHiddenStyle = "position:absolute;width:1px;height:1px;margin:-1px;padding:0;overflow:hidden;clip:rect(0,0,0,0);white-space:nowrap;border:0"
+
+// liveRegion is a mounted aria-live container and its pending messages.
+type liveRegion struct {
+	politeness string
+	el         js.Value
+	queue      []string
+	busy       bool
+	disposed   bool
+}
+
+// liveRegions holds mounted regions in mount order; the last one wins.
+var liveRegions []*liveRegion
+
+// LiveRegion renders a visually hidden aria-live container that receives
+// messages from Announce. politeness is "polite" (default) or "assertive".
+// When several regions are mounted, the last-mounted one is used.
+func LiveRegion(politeness string) g.Node {
+	if politeness != PolitenessAssertive {
+		politeness = PolitenessPolite
+	}
+	id := nextID("live")
+	role := "status"
+	if politeness == PolitenessAssertive {
+		role = "alert"
+	}
+	return g.El("div",
+		g.Attr("id", id),
+		g.Attr("role", role),
+		g.Attr("aria-live", politeness),
+		g.Attr("aria-atomic", "true"),
+		g.Attr("style", visuallyHiddenStyle),
+		OnMount(func() {
+			el := js.Global().Get("document").Call("getElementById", id)
+			if !el.Truthy() {
+				return
+			}
+			region := &liveRegion{politeness: politeness, el: el}
+			liveRegions = append(liveRegions, region)
+			reactivity.RegisterCleanup(func() {
+				region.disposed = true
+				for i, r := range liveRegions {
+					if r == region {
+						liveRegions = append(liveRegions[:i], liveRegions[i+1:]...)
+						break
+					}
+				}
+			})
+		}),
+	)
+}
+
+// Announce queues message for the last-mounted live region. An optional
+// politeness selects the last-mounted region with that politeness. Each
+// message is cleared after a short delay so repeating the same message is
+// announced again.
+func Announce(message string, politeness ...string) {
+	region := findLiveRegion(politeness...)
+	if region == nil {
+		logutil.Logf("Announce: no live region mounted for %q", message)
+		return
+	}
+	region.queue = append(region.queue, message)
+	if !region.busy {
+		region.next()
+	}
+}
+
+// findLiveRegion returns the last-mounted region, optionally filtered by politeness.
+func findLiveRegion(politeness ...string) *liveRegion {
+	for i := len(liveRegions) - 1; i >= 0; i-- {
+		if len(politeness) == 0 || liveRegions[i].politeness == politeness[0] {
+			return liveRegions[i]
+		}
+	}
+	return nil
+}
+
+// next shows the next queued message and schedules its removal.
+func (r *liveRegion) next() {
+	if r.disposed || len(r.queue) == 0 {
+		r.busy = false
+		return
+	}
+	r.busy = true
+	message := r.queue[0]
+	r.queue = r.queue[1:]
+	r.el.Set("textContent", message)
+	afterDelay(announceDuration, func() {
+		if r.disposed {
+			return
+		}
+		r.el.Set("textContent", "")
+		afterDelay(announceGap, r.next)
+	})
+}
+
+// afterDelay runs fn once after d using a JS timer.
+func afterDelay(d time.Duration, fn func()) {
+	var cb js.Func
+	cb = js.FuncOf(func(this js.Value, args []js.Value) any {
+		cb.Release()
+		fn()
+		return nil
+	})
+	js.Global().Call("setTimeout", cb, d.Milliseconds())
+}
@@ -0,0 +1,68 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+	"time"
+
+	g "maragu.dev/gomponents"
+)
+
+func TestAnnounceCyclesThroughQueuedMessages(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	prevDuration, prevGap := announceDuration, announceGap
+	announceDuration, announceGap = 30*time.Millisecond, 10*time.Millisecond
+	defer func() { announceDuration, announceGap = prevDuration, prevGap }()
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-live-region")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	disposer := Mount("test-live-region", func() Node {
+		return g.El("div", LiveRegion("polite"), LiveRegion("assertive"))
+	})
+	defer disposer()
+
+	regions := container.Call("querySelectorAll", "[aria-live]")
+	polite, assertive := regions.Index(0), regions.Index(1)
+
+	Announce("Added to cart")
+	Announce("Added to cart")
+
+	// Record every distinct text the last-mounted region shows
+	var seen []string
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		text := assertive.Get("textContent").String()
+		if len(seen) == 0 || seen[len(seen)-1] != text {
+			seen = append(seen, text)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	want := []string{"Added to cart", "", "Added to cart", ""}
+	if len(seen) != len(want) {
+		t.Fatalf("Expected region to cycle %q, got %q", want, seen)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("Expected region to cycle %q, got %q", want, seen)
+		}
+	}
+	if polite.Get("textContent").String() != "" {
+		t.Error("Expected earlier region to stay empty when the last-mounted region wins")
+	}
+
+	Announce("Saved", PolitenessPolite)
+	if got := polite.Get("textContent").String(); got != "Saved" {
+		t.Errorf("Expected polite region to receive targeted message, got %q", got)
+	}
+}
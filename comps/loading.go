@@ -0,0 +1,39 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// LoadState is the part of a resource LoadingOf and ErrorOf read. Every
+// reactivity.Resource, including those from CreateAsyncMemo, implements it.
+type LoadState = reactivity.AnyResource
+
+// LoadingOf returns a signal that is true while any of resources is
+// loading, e.g. to show a skeleton:
+//
+//	comps.Show(comps.ShowProps{When: comps.LoadingOf(products, reviews), Children: skeleton()})
+func LoadingOf(resources ...LoadState) reactivity.Signal[bool] {
+	return reactivity.CreateMemo(func() bool {
+		for _, r := range resources {
+			if r.Loading() {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ErrorOf returns a signal holding the error of the first of resources that
+// has one, or nil.
+func ErrorOf(resources ...LoadState) reactivity.Signal[error] {
+	return reactivity.CreateMemo(func() error {
+		for _, r := range resources {
+			if err := r.Error(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
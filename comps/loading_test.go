@@ -0,0 +1,75 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// waitFor polls cond until it holds or a second has passed.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestLoadingOfAndErrorOfTrackResources(t *testing.T) {
+	userID := reactivity.CreateSignal(1)
+	releaseUser := make(chan error)
+	user := reactivity.CreateResource(userID, func(id int) (string, error) {
+		return "user", <-releaseUser
+	})
+	releasePosts := make(chan error)
+	posts := reactivity.CreateAsyncMemo(func() func() ([]string, error) {
+		userID.Get()
+		return func() ([]string, error) { return nil, <-releasePosts }
+	})
+
+	loading := LoadingOf(user, posts)
+	failed := ErrorOf(user, posts)
+	var seen []bool
+	reactivity.CreateEffect(func() { seen = append(seen, loading.Get()) })
+
+	if !loading.Get() {
+		t.Fatal("LoadingOf is false while both resources load")
+	}
+
+	releaseUser <- nil
+	waitFor(t, "the user resource", func() bool { return !user.Loading() })
+	if !loading.Get() {
+		t.Fatal("LoadingOf is false while the posts are still loading")
+	}
+
+	errPosts := errors.New("posts unavailable")
+	releasePosts <- errPosts
+	waitFor(t, "the posts resource", func() bool { return !posts.Loading() })
+	if loading.Get() {
+		t.Error("LoadingOf is true once both resources finished")
+	}
+	if err := failed.Get(); err != errPosts {
+		t.Errorf("ErrorOf = %v, want the posts error", err)
+	}
+
+	// A refetch starts loading again and clears the error
+	userID.Set(2)
+	if !loading.Get() || failed.Get() != nil {
+		t.Errorf("after refetch: loading %v, error %v; want loading without error", loading.Get(), failed.Get())
+	}
+	releaseUser <- nil
+	releasePosts <- nil
+	waitFor(t, "the refetch", func() bool { return !loading.Get() })
+
+	if want := []bool{true, false, true, false}; fmt.Sprint(seen) != fmt.Sprint(want) {
+		t.Errorf("LoadingOf went through %v, want %v", seen, want)
+	}
+}
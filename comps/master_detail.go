@@ -0,0 +1,216 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// RemovalPolicy decides what MasterDetail selects when the selected item
+// disappears from Items.
+type RemovalPolicy int
+
+const (
+	// ClearSelection sets Selected to "" so the Empty node is shown.
+	ClearSelection RemovalPolicy = iota
+	// SelectNeighbor selects the item that followed the removed one, or the
+	// one before it if it was last.
+	SelectNeighbor
+)
+
+// MasterDetailProps configures the MasterDetail component.
+type MasterDetailProps[T any] struct {
+	Items    reactivity.Signal[[]T]
+	Key      func(T) string
+	Selected reactivity.Signal[string] // key of the selected item, "" for none
+	ListItem func(item T, selected bool) g.Node
+	Detail   func(item T) g.Node
+	Empty    g.Node // detail pane content when nothing is selected
+	OnRemove RemovalPolicy
+}
+
+// MasterDetail renders a role=listbox of Items next to a detail pane for the
+// selected item. Clicking or focusing a row selects it; Up/Down, Home and
+// End move the selection via dom.RovingFocus. Selection is tracked with
+// reactivity.CreateSelector, so changing it re-renders only the previously
+// and newly selected rows. When the selected item is removed from Items the
+// selection is cleared or moved to a neighbor according to OnRemove.
+func MasterDetail[T any](p MasterDetailProps[T]) g.Node {
+	id := nextID("md")
+
+	// The selector outlives individual rows, so it gets its own scope that
+	// is disposed with the enclosing scope or, at the top level, on unmount.
+	selectorScope := reactivity.NewCleanupScope(reactivity.GetCurrentCleanupScope())
+	prevScope := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(selectorScope)
+	isSelected := reactivity.CreateSelector(p.Selected)
+	reactivity.SetCurrentCleanupScope(prevScope)
+
+	row := func(item T, index int) g.Node {
+		key := p.Key(item)
+		rowID := masterDetailRowID(id, key)
+		// Rows render inside For's reconcile effect; keep their reads out of it
+		return reactivity.Untrack(func() g.Node {
+			selected := isSelected(key).Get()
+			reactivity.CreateEffect(func() {
+				on := isSelected(key).Get()
+				el := js.Global().Get("document").Call("getElementById", rowID)
+				if !el.Truthy() {
+					return
+				}
+				if on {
+					el.Call("setAttribute", "aria-selected", "true")
+					el.Call("setAttribute", "tabindex", "0")
+				} else {
+					el.Call("setAttribute", "aria-selected", "false")
+					el.Call("setAttribute", "tabindex", "-1")
+				}
+			})
+			ariaSelected, tabIndex := "false", "-1"
+			if selected {
+				ariaSelected, tabIndex = "true", "0"
+			}
+			return g.El("div",
+				g.Attr("role", "option"),
+				g.Attr("id", rowID),
+				g.Attr("data-md-key", key),
+				g.Attr("aria-selected", ariaSelected),
+				g.Attr("tabindex", tabIndex),
+				dom.OnClickInline(func(el dom.Element) {
+					p.Selected.Set(key)
+				}),
+				BindHTML(func() g.Node {
+					return p.ListItem(item, isSelected(key).Get())
+				}),
+			)
+		})
+	}
+
+	return g.El("div",
+		g.Attr("data-uiwgo-master-detail", id),
+		g.El("div",
+			g.Attr("role", "listbox"),
+			g.Attr("id", id+"-list"),
+			g.Attr("aria-orientation", "vertical"),
+			For(ForProps[T]{Items: p.Items, Key: p.Key, Children: row}),
+		),
+		g.El("div",
+			g.Attr("id", id+"-detail"),
+			g.Attr("data-md-detail", ""),
+			BindHTML(func() g.Node {
+				if item, ok := masterDetailFind(p.Items.Get(), p.Key, p.Selected.Get()); ok {
+					return p.Detail(item)
+				}
+				if p.Empty == nil {
+					return g.Group(nil)
+				}
+				return p.Empty
+			}),
+		),
+		OnMount(func() {
+			reactivity.RegisterCleanup(selectorScope.Dispose)
+			attachMasterDetail(id, p)
+		}),
+	)
+}
+
+// attachMasterDetail wires keyboard navigation and the removal policy.
+func attachMasterDetail[T any](id string, p MasterDetailProps[T]) {
+	list := js.Global().Get("document").Call("getElementById", id+"-list")
+	if !list.Truthy() {
+		return
+	}
+
+	// Up/Down, Home and End move focus between rows; selection follows focus
+	disposeRoving := dom.RovingFocus(domv2.WrapElement(list), dom.RovingOptions{
+		ItemSelector: "[role=option]",
+		Orientation:  "vertical",
+	})
+	focusin := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		if key := args[0].Get("target").Call("getAttribute", "data-md-key"); key.Truthy() {
+			p.Selected.Set(key.String())
+		}
+		return nil
+	})
+	list.Call("addEventListener", "focusin", focusin)
+	reactivity.RegisterCleanup(func() {
+		disposeRoving()
+		list.Call("removeEventListener", "focusin", focusin)
+		focusin.Release()
+	})
+
+	prevKeys := masterDetailKeys(p.Items.Get(), p.Key)
+	reactivity.CreateEffect(func() {
+		keys := masterDetailKeys(p.Items.Get(), p.Key)
+		removed := prevKeys
+		prevKeys = keys
+		selected := reactivity.Untrack(p.Selected.Get)
+		if selected == "" || indexOfKey(keys, selected) >= 0 || indexOfKey(removed, selected) < 0 {
+			return
+		}
+		next := ""
+		if p.OnRemove == SelectNeighbor {
+			next = masterDetailNeighbor(removed, keys, selected)
+		}
+		p.Selected.Set(next)
+	})
+}
+
+// masterDetailNeighbor returns the key closest to removed in prev that is
+// still present in next, preferring the items that followed it.
+func masterDetailNeighbor(prev, next []string, removed string) string {
+	at := indexOfKey(prev, removed)
+	if at < 0 {
+		return ""
+	}
+	for i := at + 1; i < len(prev); i++ {
+		if indexOfKey(next, prev[i]) >= 0 {
+			return prev[i]
+		}
+	}
+	for i := at - 1; i >= 0; i-- {
+		if indexOfKey(next, prev[i]) >= 0 {
+			return prev[i]
+		}
+	}
+	return ""
+}
+
+func masterDetailFind[T any](items []T, key func(T) string, selected string) (T, bool) {
+	if selected != "" {
+		for _, item := range items {
+			if key(item) == selected {
+				return item, true
+			}
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func masterDetailKeys[T any](items []T, key func(T) string) []string {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = key(item)
+	}
+	return keys
+}
+
+func indexOfKey(keys []string, key string) int {
+	for i, k := range keys {
+		if k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func masterDetailRowID(id, key string) string { return id + "-row-" + key }
@@ -0,0 +1,131 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type mdUser struct {
+	ID   string
+	Name string
+}
+
+func mountMasterDetail(t *testing.T, items reactivity.Signal[[]mdUser], selected reactivity.Signal[string], policy RemovalPolicy, listRenders map[string]int) js.Value {
+	t.Helper()
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return MasterDetail(MasterDetailProps[mdUser]{
+			Items:    items,
+			Key:      func(u mdUser) string { return u.ID },
+			Selected: selected,
+			ListItem: func(u mdUser, isSelected bool) g.Node {
+				listRenders[u.ID]++
+				if isSelected {
+					return g.El("strong", g.Text(u.Name))
+				}
+				return g.Text(u.Name)
+			},
+			Detail:   func(u mdUser) g.Node { return g.El("p", g.Text("detail "+u.Name)) },
+			Empty:    g.El("p", g.Text("nothing selected")),
+			OnRemove: policy,
+		})
+	})
+	t.Cleanup(disposer)
+	return container
+}
+
+func mdDetail(container js.Value) string {
+	return container.Call("querySelector", "[data-md-detail]").Get("textContent").String()
+}
+
+func TestMasterDetailSelectionChange(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	items := reactivity.CreateSignal([]mdUser{{"a", "Ann"}, {"b", "Bob"}, {"c", "Cid"}})
+	selected := reactivity.CreateSignal("")
+	renders := map[string]int{}
+	container := mountMasterDetail(t, items, selected, ClearSelection, renders)
+
+	if got := mdDetail(container); got != "nothing selected" {
+		t.Fatalf("expected the empty detail, got %q", got)
+	}
+
+	row := func(key string) js.Value {
+		return container.Call("querySelector", "[role=option][data-md-key='"+key+"']")
+	}
+	row("b").Call("click")
+	if selected.Get() != "b" || mdDetail(container) != "detail Bob" {
+		t.Fatalf("click should select b, got %q / %q", selected.Get(), mdDetail(container))
+	}
+	if got := row("b").Call("getAttribute", "aria-selected").String(); got != "true" {
+		t.Errorf("selected row aria-selected = %q", got)
+	}
+
+	before := renders["a"]
+	row("b").Call("dispatchEvent", js.Global().Get("KeyboardEvent").New("keydown", map[string]any{"key": "ArrowDown", "bubbles": true}))
+	if selected.Get() != "c" {
+		t.Fatalf("ArrowDown should select c, got %q", selected.Get())
+	}
+	if row("b").Call("getAttribute", "aria-selected").String() != "false" || row("c").Call("getAttribute", "aria-selected").String() != "true" {
+		t.Error("aria-selected should follow the selection")
+	}
+	if renders["a"] != before {
+		t.Errorf("unaffected row re-rendered %d times", renders["a"]-before)
+	}
+}
+
+func TestMasterDetailRemovalPolicies(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	users := []mdUser{{"a", "Ann"}, {"b", "Bob"}, {"c", "Cid"}}
+
+	items := reactivity.CreateSignal(users)
+	selected := reactivity.CreateSignal("b")
+	container := mountMasterDetail(t, items, selected, ClearSelection, map[string]int{})
+	items.Set([]mdUser{users[0], users[2]})
+	if selected.Get() != "" || mdDetail(container) != "nothing selected" {
+		t.Errorf("ClearSelection: selected %q, detail %q", selected.Get(), mdDetail(container))
+	}
+
+	items = reactivity.CreateSignal(users)
+	selected = reactivity.CreateSignal("b")
+	container = mountMasterDetail(t, items, selected, SelectNeighbor, map[string]int{})
+	items.Set([]mdUser{users[0], users[2]})
+	if selected.Get() != "c" || mdDetail(container) != "detail Cid" {
+		t.Errorf("SelectNeighbor: selected %q, detail %q", selected.Get(), mdDetail(container))
+	}
+	items.Set([]mdUser{users[0]})
+	if selected.Get() != "a" {
+		t.Errorf("SelectNeighbor should fall back to the previous item, got %q", selected.Get())
+	}
+}
+
+func TestMasterDetailNeighbor(t *testing.T) {
+	prev := []string{"a", "b", "c", "d"}
+	cases := []struct {
+		next    []string
+		removed string
+		want    string
+	}{
+		{[]string{"a", "c", "d"}, "b", "c"},
+		{[]string{"a", "d"}, "b", "d"},
+		{[]string{"a", "b", "c"}, "d", "c"},
+		{[]string{}, "a", ""},
+	}
+	for _, tc := range cases {
+		if got := masterDetailNeighbor(prev, tc.next, tc.removed); got != tc.want {
+			t.Errorf("neighbor of %q in %v = %q, want %q", tc.removed, tc.next, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"errors"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	g "maragu.dev/gomponents"
+)
+
+// clickCounter returns a root rendering a button that counts clicks under
+// name in clicks.
+func clickCounter(clicks map[string]int, name string) func() g.Node {
+	return func() g.Node {
+		return g.El("button",
+			g.Attr("data-testid", "counter"),
+			dom.OnClickInline(func(dom.Element) { clicks[name]++ }),
+			g.Text(name),
+		)
+	}
+}
+
+func clickCounterButton(t *testing.T, container js.Value) {
+	t.Helper()
+	buttons := container.Call("querySelectorAll", `[data-testid="counter"]`)
+	if n := buttons.Length(); n != 1 {
+		t.Fatalf("container holds %d buttons, want 1", n)
+	}
+	buttons.Index(0).Call("click")
+}
+
+func TestMountTwiceReplacesPreviousTree(t *testing.T) {
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+	id := container.Get("id").String()
+
+	clicks := map[string]int{}
+	first := Mount(id, clickCounter(clicks, "first"))
+	second := Mount(id, clickCounter(clicks, "second"))
+	t.Cleanup(second)
+
+	clickCounterButton(t, container)
+	if clicks["first"] != 0 || clicks["second"] != 1 {
+		t.Fatalf("clicks = %v, want only the second tree to respond once", clicks)
+	}
+
+	// The first disposer was spent by the second Mount
+	first()
+	if got := container.Get("innerHTML").String(); got == "" {
+		t.Fatal("the stale disposer tore down the second tree")
+	}
+	clickCounterButton(t, container)
+	if clicks["second"] != 2 {
+		t.Errorf("second tree stopped responding after the stale disposer ran: %v", clicks)
+	}
+}
+
+func TestMountRejectPolicy(t *testing.T) {
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+	id := container.Get("id").String()
+
+	SetMountPolicy(MountReject)
+	t.Cleanup(func() { SetMountPolicy(MountReplace) })
+
+	clicks := map[string]int{}
+	dispose := Mount(id, clickCounter(clicks, "first"))
+	t.Cleanup(dispose)
+
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, ErrAlreadyMounted) {
+				t.Errorf("second Mount panicked with %v, want ErrAlreadyMounted", err)
+			}
+		}()
+		Mount(id, clickCounter(clicks, "second"))
+	}()
+
+	clickCounterButton(t, container)
+	if clicks["first"] != 1 || clicks["second"] != 0 {
+		t.Errorf("clicks = %v, want the first tree left in place", clicks)
+	}
+}
+
+func TestRemountSwapsTree(t *testing.T) {
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+	id := container.Get("id").String()
+
+	SetMountPolicy(MountReject)
+	t.Cleanup(func() { SetMountPolicy(MountReplace) })
+
+	clicks := map[string]int{}
+	first := Mount(id, clickCounter(clicks, "first"))
+	second := Remount(id, clickCounter(clicks, "second"))
+	t.Cleanup(second)
+	first()
+
+	if got := js.Global().Get("document").Call("getElementById", id); !got.Equal(container) {
+		t.Fatal("Remount should reuse the container element")
+	}
+	clickCounterButton(t, container)
+	if clicks["first"] != 0 || clicks["second"] != 1 {
+		t.Errorf("clicks = %v, want only the remounted tree to respond", clicks)
+	}
+}
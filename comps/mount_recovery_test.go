@@ -0,0 +1,95 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestMountWithRecoveryRetriesAfterPanic(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-recovery")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	var items []string // nil until "loaded"; indexing it panics
+	var gotErr error
+	var retry func()
+	disposer := MountWithRecovery("test-recovery", func() Node {
+		return g.El("p", g.Attr("id", "recovered-root"), g.Text("first: "+items[0]))
+	}, func(err error, r func()) Node {
+		gotErr, retry = err, r
+		return g.El("div", g.Attr("id", "recovery-fallback"), g.Text("failed"))
+	})
+	defer disposer()
+
+	if gotErr == nil {
+		t.Fatal("Expected fallback to receive the render error")
+	}
+	if !container.Call("querySelector", "#recovery-fallback").Truthy() {
+		t.Fatalf("Expected fallback to be rendered, got %q", container.Get("innerHTML").String())
+	}
+
+	// Fix the condition and retry
+	items = []string{"ready"}
+	retry()
+
+	if container.Call("querySelector", "#recovery-fallback").Truthy() {
+		t.Error("Expected fallback to be removed after retry")
+	}
+	root := container.Call("querySelector", "#recovered-root")
+	if !root.Truthy() || root.Get("textContent").String() != "first: ready" {
+		t.Fatalf("Expected root to render after retry, got %q", container.Get("innerHTML").String())
+	}
+}
+
+func TestMountWithRecoveryCatchesBinderErrors(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-recovery-binder")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	broken := reactivity.CreateSignal(false)
+	disposer := MountWithRecovery("test-recovery-binder", func() Node {
+		return BindHTML(func() g.Node {
+			if broken.Get() {
+				panic("binder exploded")
+			}
+			return g.El("span", g.Attr("id", "binder-ok"), g.Text("ok"))
+		})
+	}, nil)
+	defer disposer()
+
+	if !container.Call("querySelector", "#binder-ok").Truthy() {
+		t.Fatal("Expected root to render")
+	}
+
+	broken.Set(true)
+	box := container.Call("querySelector", "[data-uiwgo-mount-error]")
+	if !box.Truthy() {
+		t.Fatalf("Expected default error box, got %q", container.Get("innerHTML").String())
+	}
+
+	// The default fallback's retry button re-mounts the root
+	broken.Set(false)
+	box.Call("querySelector", "button").Call("click")
+	if !container.Call("querySelector", "#binder-ok").Truthy() {
+		t.Errorf("Expected root to render after retry, got %q", container.Get("innerHTML").String())
+	}
+}
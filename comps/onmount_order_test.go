@@ -0,0 +1,99 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"reflect"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestOnMountInForRowBindsClickImmediately(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+
+	items := reactivity.CreateSignal([]string{"a"})
+	mounted := map[string]int{}
+	listenerClicks := map[string]int{}
+	inlineClicks := map[string]int{}
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[string]{
+			Items: items,
+			Key:   func(id string) string { return id },
+			Children: func(id string, _ int) g.Node {
+				return g.El("div",
+					OnMount(func() {
+						mounted[id]++
+						btn := js.Global().Get("document").Call("getElementById", "onmount-row-"+id)
+						if !btn.Truthy() {
+							t.Errorf("row %s is not in the document when its OnMount runs", id)
+							return
+						}
+						btn.Call("addEventListener", "click", js.FuncOf(func(js.Value, []js.Value) any {
+							listenerClicks[id]++
+							return nil
+						}))
+					}),
+					g.El("button",
+						g.Attr("id", "onmount-row-"+id),
+						dom.OnClickInline(func(dom.Element) { inlineClicks[id]++ }),
+						g.Text(id),
+					),
+				)
+			},
+		})
+	})
+	t.Cleanup(disposer)
+
+	// The row added after mount is clickable as soon as Set returns, without
+	// waiting for the mutation observer
+	items.Set([]string{"a", "b"})
+	if mounted["b"] != 1 {
+		t.Fatalf("OnMount of the new row ran %d times, want 1", mounted["b"])
+	}
+	container.Call("querySelector", "#onmount-row-b").Call("click")
+	if listenerClicks["b"] != 1 {
+		t.Errorf("listener bound in OnMount saw %d clicks, want 1", listenerClicks["b"])
+	}
+	if inlineClicks["b"] != 1 {
+		t.Errorf("inline click handler ran %d times, want 1", inlineClicks["b"])
+	}
+}
+
+func TestOnMountRunsChildrenBeforeParent(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	container := createTestContainer(t)
+	t.Cleanup(func() { cleanupContainer(container) })
+
+	var order []string
+	items := reactivity.CreateSignal([]string{"a", "b"})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div",
+			OnMount(func() { order = append(order, "parent") }),
+			For(ForProps[string]{
+				Items: items,
+				Key:   func(id string) string { return id },
+				Children: func(id string, _ int) g.Node {
+					return g.El("div", OnMount(func() { order = append(order, id) }))
+				},
+			}),
+		)
+	})
+	t.Cleanup(disposer)
+
+	if want := []string{"a", "b", "parent"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("OnMount order = %v, want %v", order, want)
+	}
+}
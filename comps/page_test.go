@@ -57,13 +57,9 @@ func TestPortal(t *testing.T) {
 	// Create a portal with a target and child
 	portal := Portal("#modal-target", child)
 
-	// Verify that portal returns the child node (basic implementation)
 	if portal == nil {
 		t.Error("Portal should return a non-nil node")
 	}
-
-	// In the current basic implementation, Portal just returns the children
-	// A full implementation would require DOM manipulation during mount
 }
 
 // TestMemo tests the Memo helper
@@ -115,7 +111,7 @@ func TestLazy(t *testing.T) {
 // TestErrorBoundary tests the ErrorBoundary helper
 func TestErrorBoundary(t *testing.T) {
 	// Create a fallback function
-	fallback := func(err error) g.Node {
+	fallback := func(err error, reset func()) g.Node {
 		return h.Div(g.Text(fmt.Sprintf("Error: %v", err)))
 	}
 
@@ -0,0 +1,168 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type portalBinder struct {
+	// target returns the element to host the portal, or a falsy value
+	// when there is none; signals it reads move the portal
+	target    func() js.Value
+	container string // elementID of the mounted container
+
+	// The mounted portal element, the anchor marking its place in the
+	// tree it was rendered in and the effect moving it
+	el     js.Value
+	anchor js.Value
+	effect reactivity.Effect
+}
+
+var portalRegistry = map[string]*portalBinder{}
+
+// Portal renders children into another part of the document, such as the
+// body for modals and overlays. target is one of:
+//
+//   - a CSS selector string, resolved once when the portal mounts
+//   - a dom.Element or js.Value
+//   - a reactivity.ReadonlySignal[string] holding a selector, e.g. to move
+//     a modal between a fullscreen and an inline container
+//
+// Children are wrapped in a layout-neutral element that is appended to the
+// target. When a signal target changes the element is moved, not rendered
+// again, so inputs keep their values. It is removed from its current target
+// when the portal is unmounted, e.g. when an enclosing Show hides. Without
+// a matching target the children stay in place.
+func Portal(target any, children g.Node) g.Node {
+	id := nextID("portal")
+	var resolve func() js.Value
+	switch t := target.(type) {
+	case string:
+		resolve = func() js.Value { return querySelector(t) }
+	case js.Value:
+		resolve = func() js.Value { return t }
+	case dom.Element:
+		resolve = func() js.Value { return t.Underlying() }
+	case reactivity.ReadonlySignal[string]:
+		resolve = func() js.Value { return querySelector(t.Get()) }
+	default:
+		logutil.Logf("Portal: unsupported target type %T, rendering in place", target)
+		resolve = js.Undefined
+	}
+	portalRegistry[id] = &portalBinder{target: resolve, container: getCurrentMountContainer()}
+	return g.El("div",
+		g.Attr("data-uiwgo-portal", id),
+		g.Attr("style", "display: contents"),
+		children,
+	)
+}
+
+// querySelector returns the first element of the document matching
+// selector, or undefined.
+func querySelector(selector string) js.Value {
+	el := js.Global().Get("document").Call("querySelector", selector)
+	if el.IsNull() {
+		return js.Undefined()
+	}
+	return el
+}
+
+// attachPortalsIn moves the portals under root, and root if it is one, to
+// their targets and attaches their content there in the current scope.
+// Inline event delegates are installed on the portal element itself so
+// that they travel with it.
+func attachPortalsIn(root js.Value) {
+	if root.Get("matches").Truthy() && root.Call("matches", "[data-uiwgo-portal]").Bool() {
+		attachPortal(root)
+	}
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-portal]")
+	for i := 0; i < nodes.Length(); i++ {
+		attachPortal(nodes.Index(i))
+	}
+}
+
+func attachPortal(el js.Value) {
+	if el.Call("hasAttribute", "data-uiwgo-bound-portal").Bool() {
+		return
+	}
+	id := el.Call("getAttribute", "data-uiwgo-portal").String()
+	b, ok := portalRegistry[id]
+	if !ok {
+		return
+	}
+	el.Call("setAttribute", "data-uiwgo-bound-portal", "1")
+	// A re-rendered portal, as a Show renders its children again when
+	// shown, replaces the one mounted before
+	b.unmount()
+
+	anchor := js.Global().Get("document").Call("createElement", "template")
+	anchor.Call("setAttribute", "data-uiwgo-portal-anchor", id)
+	el.Call("before", anchor)
+	b.el, b.anchor = el, anchor
+	b.effect = reactivity.CreateRenderEffect(func() {
+		target := b.target()
+		if !target.Truthy() {
+			logutil.Logf("Portal: target not found, keeping %s in place", id)
+			return
+		}
+		if !el.Get("parentNode").Equal(target) {
+			target.Call("appendChild", el)
+		}
+	})
+	mountSubtree(el, reactivity.GetCurrentCleanupScope(), nil)
+	reactivity.RegisterCleanup(func() {
+		if b.el.Equal(el) {
+			b.unmount()
+		}
+	})
+}
+
+// unmount removes the mounted portal element from its target, disposing
+// its binders, and its anchor.
+func (b *portalBinder) unmount() {
+	if b.effect != nil {
+		b.effect.Dispose()
+		b.effect = nil
+	}
+	if b.el.Truthy() {
+		b.el.Call("remove")
+		cleanupBinders(b.el)
+		b.anchor.Call("remove")
+	}
+	b.el, b.anchor = js.Undefined(), js.Undefined()
+}
+
+// cleanupPortalsIn unmounts the portals whose anchors were removed with
+// node, wherever their content was moved.
+func cleanupPortalsIn(node js.Value) {
+	var anchors js.Value
+	if node.Call("matches", "[data-uiwgo-portal-anchor]").Bool() {
+		anchors = js.Global().Get("Array").New(node)
+	} else {
+		anchors = node.Call("querySelectorAll", "[data-uiwgo-portal-anchor]")
+	}
+	for i := 0; i < anchors.Length(); i++ {
+		anchor := anchors.Index(i)
+		id := anchor.Call("getAttribute", "data-uiwgo-portal-anchor").String()
+		if b, ok := portalRegistry[id]; ok && b.anchor.Equal(anchor) {
+			b.unmount()
+		}
+	}
+}
+
+// cleanupPortalsForContainer unmounts and forgets the portals of a
+// container being unmounted.
+func cleanupPortalsForContainer(containerID string) {
+	for id, b := range portalRegistry {
+		if b.container == containerID {
+			b.unmount()
+			delete(portalRegistry, id)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// createPortalTarget appends an element with id to the body.
+func createPortalTarget(t *testing.T, id string) js.Value {
+	t.Helper()
+	doc := js.Global().Get("document")
+	el := doc.Call("createElement", "div")
+	el.Set("id", id)
+	doc.Get("body").Call("appendChild", el)
+	return el
+}
+
+func TestPortalMovesChildrenToTarget(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+	target := createPortalTarget(t, "portal-target")
+	defer target.Call("remove")
+
+	open := reactivity.CreateSignal(true)
+	clicks := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Show(ShowProps{
+			When: open,
+			Children: Portal("#portal-target", g.El("button",
+				g.Attr("class", "portal-button"),
+				g.Text("Close"),
+				dom.OnClickInline(func(dom.Element) { clicks++ }),
+			)),
+		})
+	})
+	defer disposer()
+	// Show renders its children again on attach; let the observer bind them
+	time.Sleep(10 * time.Millisecond)
+
+	if n := target.Call("querySelectorAll", ".portal-button").Length(); n != 1 {
+		t.Fatalf("target holds %d copies of the portal content, want 1", n)
+	}
+	button := target.Call("querySelector", ".portal-button")
+	if !button.Truthy() {
+		t.Fatal("portal content was not moved to the target")
+	}
+	if container.Call("querySelector", ".portal-button").Truthy() {
+		t.Error("portal content is still in the container")
+	}
+	button.Call("click")
+	if clicks != 1 {
+		t.Errorf("click handler ran %d times in the target, want 1", clicks)
+	}
+
+	open.Set(false)
+	time.Sleep(10 * time.Millisecond)
+	if target.Call("querySelector", ".portal-button").Truthy() {
+		t.Error("portal content was not removed from the target when hidden")
+	}
+
+	open.Set(true)
+	time.Sleep(10 * time.Millisecond)
+	if n := target.Call("querySelectorAll", ".portal-button").Length(); n != 1 {
+		t.Errorf("target holds %d copies of the portal content after showing again, want 1", n)
+	}
+}
+
+func TestPortalFollowsSignalTarget(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+	fullscreen := createPortalTarget(t, "portal-fullscreen")
+	defer fullscreen.Call("remove")
+	inline := createPortalTarget(t, "portal-inline")
+	defer inline.Call("remove")
+
+	where := reactivity.CreateSignal("#portal-fullscreen")
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Portal(where, g.El("input", g.Attr("class", "portal-input")))
+	})
+
+	input := fullscreen.Call("querySelector", ".portal-input")
+	if !input.Truthy() {
+		disposer()
+		t.Fatal("portal content was not moved to the signal's target")
+	}
+	input.Set("value", "typed")
+
+	where.Set("#portal-inline")
+	moved := inline.Call("querySelector", ".portal-input")
+	if !moved.Equal(input) {
+		disposer()
+		t.Fatal("portal content was re-created instead of moved")
+	}
+	if got := moved.Get("value").String(); got != "typed" {
+		t.Errorf("input value after the move = %q, want typed", got)
+	}
+
+	// A missing target leaves the content where it is
+	where.Set("#portal-missing")
+	if !inline.Call("querySelector", ".portal-input").Truthy() {
+		t.Error("portal content left its target for a missing one")
+	}
+
+	disposer()
+	if inline.Call("querySelector", ".portal-input").Truthy() {
+		t.Error("portal content was not removed from its current target on cleanup")
+	}
+}
+
+func TestPortalElementTarget(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+	target := createPortalTarget(t, "portal-element")
+	defer target.Call("remove")
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div",
+			Portal(target, g.El("p", g.Attr("class", "from-value"))),
+			Portal(domv2.WrapElement(target), g.El("p", g.Attr("class", "from-element"))),
+		)
+	})
+	defer disposer()
+
+	for _, class := range []string{"from-value", "from-element"} {
+		if !target.Call("querySelector", "."+class).Truthy() {
+			t.Errorf("%s content was not moved to the target", class)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// TestControlFlowAcceptsReadonlySignals mounts the control-flow components
+// with read-only views and checks that writes to the underlying signals
+// reach the DOM through them.
+func TestControlFlowAcceptsReadonlySignals(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	visible := reactivity.CreateSignal(true)
+	items := reactivity.CreateSignal([]string{"a", "b"})
+	mode := reactivity.CreateSignal[any]("list")
+	label := reactivity.CreateSignal("hello")
+
+	readonlyVisible := reactivity.ReadOnly(visible)
+	readonlyItems := reactivity.ReadOnly(items)
+	readonlyMode := reactivity.ReadOnly(mode)
+	readonlyLabel := reactivity.ReadOnly(label)
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div",
+			g.El("div", g.Attr("class", "show"),
+				Show(ShowProps{When: readonlyVisible, Children: g.El("p", g.Text("shown"))}),
+			),
+			g.El("ul", g.Attr("class", "for"),
+				For(ForProps[string]{
+					Items:    readonlyItems,
+					Key:      func(s string) string { return s },
+					Children: func(item string, index int) g.Node { return g.El("li", g.Text(item)) },
+				}),
+			),
+			g.El("div", g.Attr("class", "switch"),
+				Switch(SwitchProps{
+					When:     readonlyMode,
+					Fallback: g.El("p", g.Text("fallback")),
+					Children: []g.Node{
+						Match(MatchProps{When: "list", Children: g.El("p", g.Text("list"))}),
+						Match(MatchProps{When: "grid", Children: g.El("p", g.Text("grid"))}),
+					},
+				}),
+			),
+			g.El("div", g.Attr("class", "text"), BindText(readonlyLabel.Get)),
+		)
+	})
+	defer disposer()
+	time.Sleep(10 * time.Millisecond)
+
+	text := func(class string) string {
+		return container.Call("querySelector", "."+class).Get("textContent").String()
+	}
+	expect := func(stage string, want map[string]string) {
+		t.Helper()
+		for class, w := range want {
+			if got := text(class); got != w {
+				t.Errorf("%s: %s content = %q, want %q", stage, class, got, w)
+			}
+		}
+	}
+	expect("initial", map[string]string{"show": "shown", "for": "ab", "switch": "list", "text": "hello"})
+
+	visible.Set(false)
+	items.Set([]string{"c", "a", "d"})
+	mode.Set("grid")
+	label.Set("world")
+	time.Sleep(10 * time.Millisecond)
+	expect("after writes", map[string]string{"show": "", "for": "cad", "switch": "grid", "text": "world"})
+
+	visible.Set(true)
+	mode.Set("other")
+	time.Sleep(10 * time.Millisecond)
+	expect("after second writes", map[string]string{"show": "shown", "switch": "fallback"})
+}
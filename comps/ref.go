@@ -0,0 +1,43 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// Ref calls fn with the enclosing element once it is attached to the
+// document, and with nil when it is unmounted. Unlike looking an element up
+// by ID, it works for components rendered any number of times. fn runs
+// while the content is attached, before the OnMount callbacks of the
+// content around it:
+//
+//	var input dom.Element
+//	h.Input(comps.Ref(func(el dom.Element) { input = el }))
+func Ref(fn func(el dom.Element)) g.Node {
+	return bindAttrs(func(el js.Value) {
+		reactivity.OnCleanup(func() { fn(nil) })
+		reactivity.UntrackVoid(func() { fn(domv2.WrapElement(el)) })
+	})
+}
+
+// ElementRef holds the element a Ref is attached to.
+type ElementRef struct {
+	// Current is the element, or nil while it is not mounted.
+	Current reactivity.Signal[dom.Element]
+}
+
+// CreateRef creates an empty ElementRef. Attach it with Ref(ref.Current.Set)
+// and read ref.Current in OnMount callbacks and effects:
+//
+//	ref := comps.CreateRef()
+//	h.Input(comps.Ref(ref.Current.Set))
+//	comps.OnMount(func() { ref.Current.Get().(*domv2.HTMLInputElement).Focus() })
+func CreateRef() *ElementRef {
+	return &ElementRef{Current: reactivity.CreateSignal[dom.Element](nil)}
+}
@@ -0,0 +1,82 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestRefCapturesEachInstance(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	field := func(name string, ref *ElementRef) g.Node {
+		return g.El("label", g.Text(name), g.El("input", g.Attr("name", name), Ref(ref.Current.Set)))
+	}
+	first, second := CreateRef(), CreateRef()
+	var connected []bool
+	var seenOnMount []string
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("form",
+			field("first", first),
+			field("second", second),
+			Ref(func(el dom.Element) {
+				if el != nil {
+					connected = append(connected, el.Underlying().Get("isConnected").Bool())
+				}
+			}),
+			OnMount(func() {
+				for _, ref := range []*ElementRef{first, second} {
+					if el := ref.Current.Get(); el != nil {
+						seenOnMount = append(seenOnMount, el.GetAttribute("name"))
+					}
+				}
+			}),
+		)
+	})
+
+	if len(seenOnMount) != 2 || seenOnMount[0] != "first" || seenOnMount[1] != "second" {
+		t.Fatalf("OnMount saw refs %v, want [first second]", seenOnMount)
+	}
+	if len(connected) != 1 || !connected[0] {
+		t.Errorf("ref callback saw connected = %v, want the element in the document", connected)
+	}
+	if el := first.Current.Get(); el == nil || el.TagName() != "INPUT" {
+		t.Errorf("first.Current = %v, want the first input", el)
+	}
+
+	disposer()
+	if first.Current.Get() != nil || second.Current.Get() != nil {
+		t.Error("refs were not cleared on unmount")
+	}
+}
+
+func TestRefInForRows(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	items := reactivity.CreateSignal([]string{"a"})
+	refs := map[string]dom.Element{}
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[string]{
+			Items: items,
+			Key:   func(s string) string { return s },
+			Children: func(s string, _ int) g.Node {
+				return g.El("li", g.Text(s), Ref(func(el dom.Element) { refs[s] = el }))
+			},
+		})
+	})
+	defer disposer()
+
+	items.Set([]string{"a", "b"})
+	if el := refs["b"]; el == nil || el.TextContent() != "b" {
+		t.Fatalf("ref of the added row = %v, want its li", el)
+	}
+	if el := refs["a"]; el == nil || el.TextContent() != "a" {
+		t.Errorf("ref of the first row = %v, want its li", el)
+	}
+}
@@ -0,0 +1,54 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// RelativeTimeResolution is how often RelativeTime text is refreshed.
+var RelativeTimeResolution = 30 * time.Second
+
+// relativeClock supplies the shared clock behind RelativeTime.
+var relativeClock = reactivity.Now
+
+// RelativeTime renders t relative to the current time ("just now", "5m ago",
+// "3h ago", "2d ago") and keeps the text up to date as time passes. All
+// RelativeTime nodes share one reactivity.Now clock, which stops once every
+// one of them has been unmounted.
+func RelativeTime(t time.Time) g.Node {
+	var clock reactivity.Signal[time.Time]
+	rendered := false
+	return BindText(func() string {
+		if !rendered {
+			// Initial server-side style render: no scope to tie the clock to yet.
+			rendered = true
+			return FormatRelativeTime(t, time.Now())
+		}
+		if clock == nil {
+			// First run of the bound effect, inside the mount or row scope.
+			clock = relativeClock(RelativeTimeResolution)
+		}
+		return FormatRelativeTime(t, clock.Get())
+	})
+}
+
+// FormatRelativeTime describes t relative to now in the compact form used by
+// RelativeTime. Times in the future are reported as "just now".
+func FormatRelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
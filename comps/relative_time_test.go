@@ -0,0 +1,73 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestFormatRelativeTime(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{-time.Minute, "just now"},
+		{30 * time.Second, "just now"},
+		{5 * time.Minute, "5m ago"},
+		{59 * time.Minute, "59m ago"},
+		{3 * time.Hour, "3h ago"},
+		{49 * time.Hour, "2d ago"},
+	}
+	for _, tt := range tests {
+		if got := FormatRelativeTime(now.Add(-tt.ago), now); got != tt.want {
+			t.Errorf("FormatRelativeTime(-%v) = %q, want %q", tt.ago, got, tt.want)
+		}
+	}
+}
+
+func TestRelativeTimeUpdatesAndReleasesClock(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	start := time.Now()
+	fake := reactivity.CreateSignal(start)
+	acquired, released := 0, 0
+	prev := relativeClock
+	relativeClock = func(time.Duration) reactivity.Signal[time.Time] {
+		acquired++
+		reactivity.RegisterCleanup(func() { released++ })
+		return fake
+	}
+	defer func() { relativeClock = prev }()
+
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return g.El("div", RelativeTime(start), RelativeTime(start.Add(-time.Hour)))
+	})
+
+	texts := func() (string, string) {
+		spans := container.Call("querySelectorAll", "[data-uiwgo-txt]")
+		return spans.Index(0).Get("textContent").String(), spans.Index(1).Get("textContent").String()
+	}
+	if a, b := texts(); a != "just now" || b != "1h ago" {
+		t.Fatalf("initial texts %q, %q", a, b)
+	}
+
+	fake.Set(start.Add(5 * time.Minute))
+	if a, b := texts(); a != "5m ago" || b != "1h ago" {
+		t.Errorf("after 5m: %q, %q", a, b)
+	}
+	fake.Set(start.Add(2 * time.Hour))
+	if a, b := texts(); a != "2h ago" || b != "3h ago" {
+		t.Errorf("after 2h: %q, %q", a, b)
+	}
+
+	disposer()
+	if acquired != 2 || released != 2 {
+		t.Errorf("expected both clock subscriptions released on unmount, acquired=%d released=%d", acquired, released)
+	}
+}
@@ -0,0 +1,36 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// ScopedStyle injects css scoped to a generated class and returns that class
+// for the component root, plus a node to render alongside it. Every "&" in
+// css is replaced by the class selector, so "&:hover" or "& > li" target the
+// root and its children; css without any rule blocks is applied to the root
+// directly. The class is derived from the css, so identical styles share one
+// stylesheet. The stylesheet is released when the returned node's mount
+// scope is disposed.
+func ScopedStyle(css string) (class string, node g.Node) {
+	class = fmt.Sprintf("uiwgo-s%x", hash(css))
+	remove := dom.InjectCSS("style-"+class, scopeCSS(css, class))
+	return class, OnMount(func() {
+		reactivity.RegisterCleanup(remove)
+	})
+}
+
+// scopeCSS rewrites css to apply to elements with the given class.
+func scopeCSS(css, class string) string {
+	selector := "." + class
+	if !strings.Contains(css, "{") {
+		return selector + " { " + strings.TrimSpace(css) + " }"
+	}
+	return strings.ReplaceAll(css, "&", selector)
+}
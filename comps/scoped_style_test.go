@@ -0,0 +1,63 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	g "maragu.dev/gomponents"
+)
+
+func TestScopeCSS(t *testing.T) {
+	tests := []struct {
+		name, css, want string
+	}{
+		{"declarations only", "color: red;", ".c { color: red; }"},
+		{"root selector", "& { color: red; }", ".c { color: red; }"},
+		{"pseudo and child", "&:hover { color: blue; } & > li { margin: 0; }", ".c:hover { color: blue; } .c > li { margin: 0; }"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scopeCSS(tt.css, "c"); got != tt.want {
+				t.Errorf("scopeCSS(%q) = %q, want %q", tt.css, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopedStyleInjectsAndCleansUp(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+	doc := js.Global().Get("document")
+
+	css := "& { padding: 4px; } & .title { font-weight: bold; }"
+	var class string
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		c, style := ScopedStyle(css)
+		class = c
+		again, style2 := ScopedStyle(css)
+		if again != c {
+			t.Errorf("identical css should reuse the class, got %q and %q", c, again)
+		}
+		return g.El("div", g.Attr("class", c), style, style2, g.El("span", g.Attr("class", "title")))
+	})
+
+	styles := doc.Call("querySelectorAll", "style#style-"+class)
+	if styles.Length() != 1 {
+		t.Fatalf("expected one deduplicated style tag, got %d", styles.Length())
+	}
+	want := "." + class + " { padding: 4px; } ." + class + " .title { font-weight: bold; }"
+	if got := styles.Index(0).Get("textContent").String(); got != want {
+		t.Errorf("style content = %q, want %q", got, want)
+	}
+	root := container.Call("querySelector", "."+class)
+	if got := js.Global().Call("getComputedStyle", root).Get("paddingTop").String(); got != "4px" {
+		t.Errorf("expected scoped padding to apply, got %q", got)
+	}
+
+	disposer()
+	if doc.Call("querySelectorAll", "style#style-"+class).Length() != 0 {
+		t.Error("style tag should be removed when the mount is disposed")
+	}
+}
@@ -0,0 +1,100 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestShowKeepAliveKeepsInputValue(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	visible := reactivity.CreateSignal(true)
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Show(ShowProps{
+			When:      visible,
+			KeepAlive: true,
+			Children:  g.El("input", g.Attr("type", "text"), g.Attr("class", "name")),
+		})
+	})
+	defer disposer()
+
+	input := container.Call("querySelector", "input.name")
+	if !input.Truthy() {
+		t.Fatal("children were not rendered")
+	}
+	input.Set("value", "Ada")
+
+	visible.Set(false)
+	if !container.Call("querySelector", "input.name").Equal(input) {
+		t.Fatal("hiding removed the input")
+	}
+	if input.Get("offsetParent").Truthy() {
+		t.Error("input is still displayed while hidden")
+	}
+
+	visible.Set(true)
+	if !container.Call("querySelector", "input.name").Equal(input) {
+		t.Fatal("showing again recreated the input")
+	}
+	if got := input.Get("value").String(); got != "Ada" {
+		t.Errorf("input value after the toggle = %q, want %q", got, "Ada")
+	}
+	if !input.Get("offsetParent").Truthy() {
+		t.Error("input is not displayed after showing it again")
+	}
+}
+
+func TestShowKeepAliveSuspendsEffects(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	visible := reactivity.CreateSignal(false)
+	count := reactivity.CreateSignal(0)
+	runs := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Show(ShowProps{
+			When:      visible,
+			KeepAlive: true,
+			Children: g.El("p", BindText(func() string {
+				runs++
+				return strconv.Itoa(count.Get())
+			})),
+		})
+	})
+	defer disposer()
+
+	// Children render on first show
+	if container.Call("querySelector", "p").Truthy() {
+		t.Fatal("children rendered before being shown")
+	}
+	visible.Set(true)
+	p := container.Call("querySelector", "p")
+	if !p.Truthy() || p.Get("textContent").String() != "0" {
+		t.Fatal("children did not render when first shown")
+	}
+	base := runs
+
+	visible.Set(false)
+	count.Set(1)
+	count.Set(2)
+	if runs != base {
+		t.Errorf("bound text ran %d times while hidden", runs-base)
+	}
+	if p.Get("textContent").String() != "0" {
+		t.Error("text updated while hidden")
+	}
+
+	visible.Set(true)
+	if runs != base+1 {
+		t.Errorf("bound text ran %d times on show, want 1", runs-base)
+	}
+	if got := p.Get("textContent").String(); got != "2" {
+		t.Errorf("text after showing = %q, want 2", got)
+	}
+}
@@ -0,0 +1,41 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// ShowWhenProps configures ShowWhen.
+type ShowWhenProps[T any] struct {
+	When reactivity.ReadonlySignal[*T]
+	// Children renders the value When points to.
+	Children func(value T) g.Node
+	// Fallback is shown while When is nil; nil shows nothing.
+	Fallback g.Node
+}
+
+// ShowWhen renders Children with the value When points to, or Fallback
+// while it is nil:
+//
+//	comps.ShowWhen(comps.ShowWhenProps[User]{
+//		When:     currentUser,
+//		Children: func(u User) g.Node { return g.Text("Hello " + u.Name) },
+//		Fallback: g.Text("Signed out"),
+//	})
+//
+// The content is rendered again, with the previous content unmounted, when
+// When turns nil or non-nil or points to a different value; signals read
+// by Children do not cause a render of their own.
+func ShowWhen[T any](p ShowWhenProps[T]) g.Node {
+	return BindHTMLDeps(func() g.Node {
+		if v := reactivity.Untrack(p.When.Get); v != nil {
+			return p.Children(*v)
+		}
+		if p.Fallback != nil {
+			return p.Fallback
+		}
+		return g.Group(nil)
+	}, func() any { return p.When.Get() })
+}
@@ -0,0 +1,58 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestShowWhenPassesValueAndFallsBack(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	type user struct{ Name string }
+	current := reactivity.CreateSignal[*user](nil)
+	mounts, cleanups := 0, 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ShowWhen(ShowWhenProps[user]{
+			When: current,
+			Children: func(u user) g.Node {
+				return g.El("p", g.Attr("class", "greeting"), g.Text("Hello "+u.Name), OnMount(func() {
+					mounts++
+					reactivity.OnCleanup(func() { cleanups++ })
+				}))
+			},
+			Fallback: g.El("p", g.Attr("class", "signed-out"), g.Text("Signed out")),
+		})
+	})
+	defer disposer()
+
+	text := func() string { return container.Get("textContent").String() }
+	if text() != "Signed out" {
+		t.Fatalf("content while nil = %q, want the fallback", text())
+	}
+
+	current.Set(&user{Name: "Ada"})
+	if text() != "Hello Ada" || mounts != 1 {
+		t.Fatalf("content = %q with %d mounts, want the greeting mounted once", text(), mounts)
+	}
+
+	current.Set(&user{Name: "Grace"})
+	if text() != "Hello Grace" {
+		t.Errorf("content after switching user = %q", text())
+	}
+	if cleanups != 1 {
+		t.Errorf("previous content cleaned up %d times, want 1", cleanups)
+	}
+
+	current.Set(nil)
+	if text() != "Signed out" {
+		t.Errorf("content after clearing = %q, want the fallback", text())
+	}
+	if cleanups != 2 || container.Call("querySelector", ".greeting").Truthy() {
+		t.Errorf("children not unmounted when the value turned nil: %d cleanups", cleanups)
+	}
+}
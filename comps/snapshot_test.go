@@ -0,0 +1,52 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"fmt"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// TestSnapshotDiffSingleBindTextUpdate verifies that a single BindText update is
+// reported as exactly one change by dom.DiffSnapshots.
+func TestSnapshotDiffSingleBindTextUpdate(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "snapshot-container")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	price := reactivity.CreateSignal(10)
+	disposer := Mount("snapshot-container", func() Node {
+		return g.El("table",
+			g.El("tr",
+				g.El("td", g.Text("Widget")),
+				g.El("td", BindText(func() string { return fmt.Sprintf("$%d", price.Get()) })),
+			),
+		)
+	})
+	defer disposer()
+
+	root := domv2.WrapElement(container)
+	before := dom.Snapshot(root, dom.SnapshotStripMarkers())
+	price.Set(12)
+	after := dom.Snapshot(root, dom.SnapshotStripMarkers())
+
+	changes := dom.DiffSnapshots(before, after)
+	if len(changes) != 1 {
+		t.Fatalf("expected exactly one change, got %v\nbefore:\n%s\nafter:\n%s", changes, before, after)
+	}
+	if changes[0].Kind != dom.DOMChangeText || changes[0].Before != "$10" || changes[0].After != "$12" {
+		t.Fatalf("unexpected change: %v", changes[0])
+	}
+}
@@ -3,6 +3,8 @@
 package comps
 
 import (
+	"fmt"
+
 	"github.com/ozanturksever/uiwgo/reactivity"
 	g "maragu.dev/gomponents"
 )
@@ -66,6 +68,35 @@ func Mount(elementID string, root func() g.Node) func() {
 	}
 }
 
+// MountWithRecovery is a stub implementation for testing: it renders root
+// and, if that panics, renders fallback instead.
+func MountWithRecovery(elementID string, root func() g.Node, fallback func(err error, retry func()) g.Node) func() {
+	var attempt func()
+	attempt = func() {
+		defer func() {
+			if r := recover(); r != nil {
+				mountQueue = nil
+				setCurrentMountContainer("")
+				err, ok := r.(error)
+				if !ok {
+					err = fmt.Errorf("%v", r)
+				}
+				if fallback != nil {
+					_ = fallback(err, attempt)
+				}
+			}
+		}()
+		Mount(elementID, root)
+	}
+	attempt()
+	return func() {
+		cleanupRegistriesForContainer(elementID)
+	}
+}
+
+// SetDevMode is a stub implementation for testing
+func SetDevMode(enabled bool) {}
+
 // OnMount schedules a function to run after Mount has attached the DOM.
 func OnMount(fn func()) g.Node {
 	// We return a no-op node so it can be used in gomponents trees.
@@ -0,0 +1,65 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// SuspenseProps configures Suspense.
+type SuspenseProps struct {
+	// Fallback is shown while any of the resources loads.
+	Fallback g.Node
+	// Children are mounted once the resources first settle, then hidden
+	// while they load again. Use Resources to name what they wait for.
+	Children g.Node
+	// Render, when set, is used instead of Children and waits for the
+	// resources it reads, in addition to Resources. It runs once while the
+	// tree is built to find them, then each time the resources settle, so
+	// its content is built with their data. Create the resources outside
+	// of it.
+	Render func() g.Node
+	// Resources are waited for in addition to those Render reads.
+	Resources []reactivity.AnyResource
+}
+
+// Suspense shows Fallback while any of its resources is loading and its
+// children once they have all settled, successfully or with an error:
+//
+//	comps.Suspense(comps.SuspenseProps{
+//		Fallback: h.P(g.Text("Loading...")),
+//		Render: func() g.Node {
+//			return h.H2(g.Text(user.Data().Name))
+//		},
+//	})
+func Suspense(p SuspenseProps) g.Node {
+	resources := p.Resources
+	if p.Render != nil {
+		collectOnMount(func() {
+			read := reactivity.CollectResources(func() { _ = p.Render() })
+			resources = append(append([]reactivity.AnyResource(nil), resources...), read...)
+		})
+	}
+	loading := LoadingOf(resources...)
+
+	fallback := func() g.Node {
+		if p.Fallback == nil {
+			return g.Group(nil)
+		}
+		return p.Fallback
+	}
+	if p.Render != nil {
+		return BindHTMLDeps(func() g.Node {
+			if reactivity.Untrack(loading.Get) {
+				return fallback()
+			}
+			return p.Render()
+		}, func() any { return loading.Get() })
+	}
+	settled := reactivity.CreateMemo(func() bool { return !loading.Get() })
+	return g.Group([]g.Node{
+		Show(ShowProps{When: loading, Children: fallback()}),
+		Show(ShowProps{When: settled, Children: p.Children, KeepAlive: true}),
+	})
+}
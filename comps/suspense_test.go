@@ -0,0 +1,93 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestSuspenseRendersWithDataOnceResourcesSettle(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	userID := reactivity.CreateSignal(1)
+	release := make(chan error)
+	user := reactivity.CreateResource(userID, func(id int) (string, error) {
+		return fmt.Sprintf("User-%d", id), <-release
+	})
+	renders := 0
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Suspense(SuspenseProps{
+			Fallback: g.El("p", g.Text("Loading...")),
+			Render: func() g.Node {
+				renders++
+				if err := user.Error(); err != nil {
+					return g.El("p", g.Text("Error: "+err.Error()))
+				}
+				return g.El("h2", g.Text(user.Data()))
+			},
+		})
+	})
+	defer disposer()
+
+	text := func() string { return container.Get("textContent").String() }
+	if text() != "Loading..." {
+		t.Fatalf("content while loading = %q, want the fallback", text())
+	}
+	before := renders
+
+	release <- nil
+	waitFor(t, "the user to render", func() bool { return text() == "User-1" })
+	if renders != before+1 {
+		t.Errorf("Render ran %d times after the resource settled, want 1", renders-before)
+	}
+
+	userID.Set(2)
+	if text() != "Loading..." {
+		t.Errorf("content while refetching = %q, want the fallback", text())
+	}
+	release <- fmt.Errorf("not found")
+	waitFor(t, "the error to render", func() bool { return text() == "Error: not found" })
+}
+
+func TestSuspenseMountsChildrenAfterResources(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	userID := reactivity.CreateSignal(1)
+	release := make(chan error)
+	user := reactivity.CreateResource(userID, func(id int) (string, error) {
+		return "Ada", <-release
+	})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Suspense(SuspenseProps{
+			Fallback:  g.El("p", g.Attr("class", "fallback"), g.Text("Loading...")),
+			Resources: []reactivity.AnyResource{user},
+			Children: g.El("p", g.Attr("class", "name"),
+				BindText(func() string { return user.Data() }),
+			),
+		})
+	})
+	defer disposer()
+
+	visible := func(selector string) bool {
+		el := container.Call("querySelector", selector)
+		return el.Truthy() && el.Get("offsetParent").Truthy()
+	}
+	if !visible(".fallback") || visible(".name") {
+		t.Fatal("expected only the fallback while loading")
+	}
+
+	release <- nil
+	waitFor(t, "the children to show", func() bool { return visible(".name") })
+	if visible(".fallback") {
+		t.Error("fallback still shown after the resource settled")
+	}
+	if got := container.Call("querySelector", ".name").Get("textContent").String(); got != "Ada" {
+		t.Errorf("children content = %q, want the loaded data", got)
+	}
+}
@@ -0,0 +1,56 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// Case is a branch of SwitchOn, rendered while the switch value equals When.
+type Case[T comparable] struct {
+	When   T
+	Render func() g.Node
+}
+
+// SwitchOnProps configures SwitchOn.
+type SwitchOnProps[T comparable] struct {
+	When  reactivity.ReadonlySignal[T]
+	Cases []Case[T]
+	// Fallback renders when no case matches; nil renders nothing.
+	Fallback func() g.Node
+}
+
+// SwitchOn renders the first case whose When equals the current value of
+// p.When, compared with ==. Only that case's Render runs, so the other
+// branches build no nodes and run no OnMount hooks. The branch is rendered
+// again, with the previous one unmounted, only when a different case
+// starts to match; signals its Render reads do not cause a render of their
+// own.
+//
+//	comps.SwitchOn(comps.SwitchOnProps[ViewMode]{
+//		When: viewMode,
+//		Cases: []comps.Case[ViewMode]{
+//			{When: ViewModeGrid, Render: renderGrid},
+//			{When: ViewModeList, Render: renderList},
+//		},
+//	})
+func SwitchOn[T comparable](p SwitchOnProps[T]) g.Node {
+	match := func(v T) int {
+		for i, c := range p.Cases {
+			if c.When == v {
+				return i
+			}
+		}
+		return -1
+	}
+	return BindHTMLDeps(func() g.Node {
+		if i := match(reactivity.Untrack(p.When.Get)); i >= 0 {
+			return p.Cases[i].Render()
+		}
+		if p.Fallback != nil {
+			return p.Fallback()
+		}
+		return g.Group(nil)
+	}, func() any { return match(p.When.Get()) })
+}
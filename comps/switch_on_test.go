@@ -0,0 +1,68 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+type testViewMode string
+
+const (
+	testViewGrid  testViewMode = "grid"
+	testViewList  testViewMode = "list"
+	testViewTable testViewMode = "table"
+)
+
+func TestSwitchOnRendersOnlyTheActiveBranch(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	mode := reactivity.CreateSignal(testViewGrid)
+	renders := map[string]int{}
+	mounts := map[string]int{}
+	branch := func(name string) func() g.Node {
+		return func() g.Node {
+			renders[name]++
+			return g.El("p", g.Text(name), OnMount(func() { mounts[name]++ }))
+		}
+	}
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return SwitchOn(SwitchOnProps[testViewMode]{
+			When: mode,
+			Cases: []Case[testViewMode]{
+				{When: testViewGrid, Render: branch("grid")},
+				{When: testViewList, Render: branch("list")},
+			},
+			Fallback: branch("fallback"),
+		})
+	})
+	defer disposer()
+
+	text := func() string { return container.Get("textContent").String() }
+	if text() != "grid" {
+		t.Fatalf("initial content = %q, want grid", text())
+	}
+	if renders["list"] != 0 || mounts["list"] != 0 || renders["fallback"] != 0 {
+		t.Fatalf("inactive branches ran: renders %v, mounts %v", renders, mounts)
+	}
+	if mounts["grid"] != 1 {
+		t.Errorf("grid OnMount ran %d times, want 1", mounts["grid"])
+	}
+
+	mode.Set(testViewList)
+	if text() != "list" || renders["list"] != 1 || mounts["list"] != 1 {
+		t.Errorf("after switching to list: content %q, renders %v, mounts %v", text(), renders, mounts)
+	}
+
+	mode.Set(testViewTable)
+	if text() != "fallback" {
+		t.Errorf("content without a matching case = %q, want the fallback", text())
+	}
+	if renders["grid"] != 1 {
+		t.Errorf("grid rendered %d times, want 1", renders["grid"])
+	}
+}
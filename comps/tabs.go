@@ -0,0 +1,221 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// Tab describes a single tab and its panel.
+type Tab struct {
+	ID    string
+	Label g.Node
+	Panel func() g.Node
+}
+
+// TabsProps configures the Tabs component.
+type TabsProps struct {
+	Selected    reactivity.Signal[string] // ID of the active tab
+	Tabs        []Tab
+	Orientation string // "horizontal" (default) or "vertical"
+	KeepAlive   bool   // keep visited panels mounted and hide inactive ones
+}
+
+// tabPanel is a rendered panel and its cleanup scope.
+type tabPanel struct {
+	element js.Value
+	scope   *reactivity.CleanupScope
+}
+
+// Tabs renders an accessible tab interface: a role=tablist of role=tab
+// buttons with aria-selected and a roving tabindex, and a role=tabpanel for
+// the active tab. Keyboard focus is managed by dom.RovingFocus: arrow keys
+// (Left/Right, or Up/Down when vertical) move between tabs, Home/End jump to
+// the first and last tab, and selection follows focus. Only the active
+// panel is rendered; with KeepAlive visited panels stay mounted but hidden.
+func Tabs(p TabsProps) g.Node {
+	id := nextID("tabs")
+	orientation := "horizontal"
+	if p.Orientation == "vertical" {
+		orientation = "vertical"
+	}
+	active := activeTabID(p.Tabs, p.Selected.Get())
+
+	buttons := make([]g.Node, 0, len(p.Tabs))
+	for _, tab := range p.Tabs {
+		tab := tab
+		tabIndex := "-1"
+		if tab.ID == active {
+			tabIndex = "0"
+		}
+		selected := reactivity.CreateMemo(func() bool {
+			return activeTabID(p.Tabs, p.Selected.Get()) == tab.ID
+		})
+		buttons = append(buttons, g.El("button",
+			g.Attr("type", "button"),
+			g.Attr("role", "tab"),
+			g.Attr("id", tabElementID(id, tab.ID)),
+			g.Attr("aria-controls", tabPanelID(id, tab.ID)),
+			dom.BindAria("selected", selected),
+			g.Attr("tabindex", tabIndex),
+			g.Attr("data-tab-id", tab.ID),
+			dom.OnClickInline(func(el dom.Element) {
+				p.Selected.Set(tab.ID)
+			}),
+			tab.Label,
+		))
+	}
+
+	return g.El("div",
+		g.Attr("data-uiwgo-tabs", id),
+		g.El("div",
+			append([]g.Node{
+				g.Attr("role", "tablist"),
+				g.Attr("id", id+"-list"),
+				g.Attr("aria-orientation", orientation),
+			}, buttons...)...,
+		),
+		g.El("div", g.Attr("id", id+"-panels")),
+		OnMount(func() {
+			attachTabs(id, p, orientation == "vertical")
+		}),
+	)
+}
+
+// attachTabs wires keyboard navigation and the reactive selection effect.
+func attachTabs(id string, p TabsProps, vertical bool) {
+	doc := js.Global().Get("document")
+	list := doc.Call("getElementById", id+"-list")
+	panelsEl := doc.Call("getElementById", id+"-panels")
+	if !list.Truthy() || !panelsEl.Truthy() {
+		return
+	}
+
+	// Arrow keys, Home and End move focus between tabs; selection follows focus
+	orientation := "horizontal"
+	if vertical {
+		orientation = "vertical"
+	}
+	disposeRoving := dom.RovingFocus(domv2.WrapElement(list), dom.RovingOptions{
+		ItemSelector: "[role=tab]",
+		Wrap:         true,
+		Orientation:  orientation,
+	})
+	focusin := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		if tabID := args[0].Get("target").Call("getAttribute", "data-tab-id"); tabID.Truthy() {
+			p.Selected.Set(tabID.String())
+		}
+		return nil
+	})
+	list.Call("addEventListener", "focusin", focusin)
+
+	panels := make(map[string]*tabPanel)
+	reactivity.RegisterCleanup(func() {
+		disposeRoving()
+		list.Call("removeEventListener", "focusin", focusin)
+		focusin.Release()
+		for _, panel := range panels {
+			panel.scope.Dispose()
+		}
+	})
+
+	reactivity.CreateEffectWithOptions(func() {
+		active := activeTabID(p.Tabs, p.Selected.Get())
+
+		// Update the roving tabindex; aria-selected is bound on the tabs
+		for _, tab := range p.Tabs {
+			el := doc.Call("getElementById", tabElementID(id, tab.ID))
+			if !el.Truthy() {
+				continue
+			}
+			if tab.ID == active {
+				el.Call("setAttribute", "tabindex", "0")
+			} else {
+				el.Call("setAttribute", "tabindex", "-1")
+			}
+		}
+
+		// Drop inactive panels unless they are kept alive
+		for tabID, panel := range panels {
+			if tabID == active {
+				continue
+			}
+			if p.KeepAlive {
+				panel.element.Call("setAttribute", "hidden", "")
+				continue
+			}
+			panel.element.Call("remove")
+			panel.scope.Dispose()
+			delete(panels, tabID)
+		}
+
+		if panel, ok := panels[active]; ok {
+			panel.element.Call("removeAttribute", "hidden")
+			return
+		}
+		for _, tab := range p.Tabs {
+			if tab.ID == active {
+				panel, callbacks := renderTabPanel(id, tab)
+				panels[active] = panel
+				panelsEl.Call("appendChild", panel.element)
+				mountSubtree(panel.element, panel.scope, callbacks)
+				break
+			}
+		}
+	}, reactivity.EffectOptions{Priority: reactivity.PriorityHigh})
+}
+
+// renderTabPanel renders a tab's panel inside its own cleanup scope and
+// returns it with the OnMount callbacks queued while rendering, to be run by
+// mountSubtree once the panel is attached.
+func renderTabPanel(id string, tab Tab) (*tabPanel, []func()) {
+	scope := reactivity.NewCleanupScope(reactivity.GetCurrentCleanupScope())
+
+	var html string
+	callbacks := collectOnMount(func() {
+		if tab.Panel != nil {
+			html = renderToString(renderInScope(scope, tab.Panel))
+		}
+	})
+
+	el := js.Global().Get("document").Call("createElement", "div")
+	el.Call("setAttribute", "role", "tabpanel")
+	el.Call("setAttribute", "id", tabPanelID(id, tab.ID))
+	el.Call("setAttribute", "aria-labelledby", tabElementID(id, tab.ID))
+	el.Call("setAttribute", "tabindex", "0")
+	el.Set("innerHTML", html)
+
+	return &tabPanel{element: el, scope: scope}, callbacks
+}
+
+// activeTabID returns selected if it names a tab, otherwise the first tab's ID.
+func activeTabID(tabs []Tab, selected string) string {
+	if tabIndexOf(tabs, selected) >= 0 {
+		return selected
+	}
+	if len(tabs) > 0 {
+		return tabs[0].ID
+	}
+	return ""
+}
+
+func tabIndexOf(tabs []Tab, tabID string) int {
+	for i, tab := range tabs {
+		if tab.ID == tabID {
+			return i
+		}
+	}
+	return -1
+}
+
+func tabElementID(id, tabID string) string { return id + "-tab-" + tabID }
+
+func tabPanelID(id, tabID string) string { return id + "-panel-" + tabID }
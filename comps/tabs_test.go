@@ -0,0 +1,133 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestTabsKeyboardNavigationUpdatesAria(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-tabs")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	selected := reactivity.CreateSignal("one")
+	disposer := Mount("test-tabs", func() Node {
+		return Tabs(TabsProps{
+			Selected: selected,
+			Tabs: []Tab{
+				{ID: "one", Label: g.Text("One"), Panel: func() g.Node { return g.El("p", g.Text("panel one")) }},
+				{ID: "two", Label: g.Text("Two"), Panel: func() g.Node { return g.El("p", g.Text("panel two")) }},
+				{ID: "three", Label: g.Text("Three"), Panel: func() g.Node { return g.El("p", g.Text("panel three")) }},
+			},
+		})
+	})
+	defer disposer()
+
+	tab := func(tabID string) js.Value {
+		return container.Call("querySelector", "[role=tab][data-tab-id='"+tabID+"']")
+	}
+	press := func(key string) {
+		evt := js.Global().Get("KeyboardEvent").New("keydown", map[string]any{"key": key, "bubbles": true})
+		tab(selected.Get()).Call("dispatchEvent", evt)
+	}
+	assertActive := func(tabID string) {
+		t.Helper()
+		if selected.Get() != tabID {
+			t.Fatalf("Expected selected %q, got %q", tabID, selected.Get())
+		}
+		tabs := container.Call("querySelectorAll", "[role=tab]")
+		for i := 0; i < tabs.Get("length").Int(); i++ {
+			el := tabs.Index(i)
+			isActive := el.Call("getAttribute", "data-tab-id").String() == tabID
+			wantSelected, wantIndex := "false", "-1"
+			if isActive {
+				wantSelected, wantIndex = "true", "0"
+			}
+			if got := el.Call("getAttribute", "aria-selected").String(); got != wantSelected {
+				t.Errorf("tab %d aria-selected = %q, want %q", i, got, wantSelected)
+			}
+			if got := el.Call("getAttribute", "tabindex").String(); got != wantIndex {
+				t.Errorf("tab %d tabindex = %q, want %q", i, got, wantIndex)
+			}
+		}
+		panels := container.Call("querySelectorAll", "[role=tabpanel]")
+		if panels.Get("length").Int() != 1 {
+			t.Fatalf("Expected only the active panel to be rendered, got %d", panels.Get("length").Int())
+		}
+		if got := panels.Index(0).Get("textContent").String(); got != "panel "+tabID {
+			t.Errorf("Expected panel for %q, got %q", tabID, got)
+		}
+	}
+
+	assertActive("one")
+	press("ArrowRight")
+	assertActive("two")
+	press("End")
+	assertActive("three")
+	press("ArrowRight")
+	assertActive("one")
+	press("Home")
+	assertActive("one")
+	press("ArrowLeft")
+	assertActive("three")
+}
+
+func TestTabsRunPanelOnMountOnEachShow(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-tabs-mount")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	selected := reactivity.CreateSignal("one")
+	mounted := 0
+	var attached bool
+	disposer := Mount("test-tabs-mount", func() Node {
+		return Tabs(TabsProps{
+			Selected: selected,
+			Tabs: []Tab{
+				{ID: "one", Label: g.Text("One"), Panel: func() g.Node { return g.El("p", g.Text("panel one")) }},
+				{ID: "two", Label: g.Text("Two"), Panel: func() g.Node {
+					return g.El("p",
+						g.Attr("id", "tabs-mount-panel"),
+						OnMount(func() {
+							mounted++
+							// The panel is attached and wired when OnMount runs
+							attached = document.Call("getElementById", "tabs-mount-panel").Truthy()
+						}),
+					)
+				}},
+			},
+		})
+	})
+	defer disposer()
+
+	if mounted != 0 {
+		t.Fatalf("OnMount ran %d times before the tab was shown, want 0", mounted)
+	}
+	selected.Set("two")
+	if mounted != 1 || !attached {
+		t.Fatalf("after first show: mounted=%d attached=%v, want 1 and true", mounted, attached)
+	}
+	selected.Set("one")
+	selected.Set("two")
+	if mounted != 2 {
+		t.Fatalf("after re-show: mounted=%d, want 2", mounted)
+	}
+}
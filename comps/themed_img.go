@@ -0,0 +1,122 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// prefersDarkQuery is the media query ThemedImg follows when it has no
+// Theme signal.
+const prefersDarkQuery = "(prefers-color-scheme: dark)"
+
+// ThemedImgProps configures ThemedImg.
+type ThemedImgProps struct {
+	// Srcs maps theme names, e.g. "light" and "dark", to image URLs.
+	Srcs map[string]string
+	Alt  string
+	// Theme selects the entry of Srcs to show. When nil the theme follows
+	// the prefers-color-scheme media query: "dark" or "light".
+	Theme reactivity.Signal[string]
+	// Lazy sets loading="lazy".
+	Lazy bool
+	// Fallback is shown when the image fails to load or Srcs has no entry
+	// for the current theme.
+	Fallback string
+	// Attrs are added to the img element.
+	Attrs []g.Node
+}
+
+// ThemedImg renders an <img> whose src follows the current theme. A theme
+// change only updates the src attribute of the mounted element; the element
+// itself is kept.
+func ThemedImg(props ThemedImgProps) g.Node {
+	id := nextID("themedimg")
+	theme := props.Theme
+	initial := ""
+	if theme != nil {
+		initial = theme.Peek()
+	} else {
+		initial = systemColorScheme()
+	}
+
+	return g.El("img",
+		g.Attr("src", themedSrc(props, initial)),
+		g.Attr("alt", props.Alt),
+		g.If(props.Lazy, g.Attr("loading", "lazy")),
+		g.If(props.Fallback != "", g.Attr("onerror", fallbackOnError(props.Fallback))),
+		g.Attr("data-uiwgo-prop", id),
+		g.Group(props.Attrs),
+		OnMount(func() {
+			el := js.Global().Get("document").Call("querySelector", "[data-uiwgo-prop='"+id+"']")
+			if !el.Truthy() {
+				return
+			}
+			if theme == nil {
+				theme = watchColorScheme()
+			}
+			reactivity.CreateEffect(func() {
+				src := themedSrc(props, theme.Get())
+				if el.Call("getAttribute", "src").String() != src {
+					el.Call("setAttribute", "src", src)
+				}
+			})
+		}),
+	)
+}
+
+// themedSrc returns the image URL for theme.
+func themedSrc(props ThemedImgProps, theme string) string {
+	if src, ok := props.Srcs[theme]; ok && src != "" {
+		return src
+	}
+	return props.Fallback
+}
+
+// fallbackOnError is the inline error handler switching an image to
+// fallback. It does nothing once fallback itself is shown, so a broken
+// fallback does not loop.
+func fallbackOnError(fallback string) string {
+	quoted, _ := json.Marshal(fallback)
+	return "if(this.getAttribute('src')!==" + string(quoted) + ")this.setAttribute('src'," + string(quoted) + ")"
+}
+
+// systemColorScheme returns "dark" or "light" from prefers-color-scheme.
+func systemColorScheme() string {
+	if !dom.HasCapability(dom.CapMatchMedia) {
+		return "light"
+	}
+	if js.Global().Call("matchMedia", prefersDarkQuery).Get("matches").Bool() {
+		return "dark"
+	}
+	return "light"
+}
+
+// watchColorScheme returns a signal following prefers-color-scheme. The
+// listener is removed when the current cleanup scope is disposed.
+func watchColorScheme() reactivity.Signal[string] {
+	scheme := reactivity.CreateSignal(systemColorScheme())
+	if !dom.HasCapability(dom.CapMatchMedia) {
+		return scheme
+	}
+	mql := js.Global().Call("matchMedia", prefersDarkQuery)
+	listener := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if args[0].Get("matches").Bool() {
+			scheme.Set("dark")
+		} else {
+			scheme.Set("light")
+		}
+		return nil
+	})
+	mql.Call("addEventListener", "change", listener)
+	reactivity.RegisterCleanup(func() {
+		mql.Call("removeEventListener", "change", listener)
+		listener.Release()
+	})
+	return scheme
+}
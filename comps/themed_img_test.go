@@ -0,0 +1,98 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// pixelGIF is a valid 1x1 image, so the test images load without errors.
+const pixelGIF = "data:image/gif;base64,R0lGODlhAQABAIAAAAAAAP///yH5BAEAAAAALAAAAAABAAEAAAIBRAA7"
+
+// imgAttrs returns the attributes of el by name.
+func imgAttrs(el js.Value) map[string]string {
+	attrs := map[string]string{}
+	list := el.Get("attributes")
+	for i := 0; i < list.Get("length").Int(); i++ {
+		attr := list.Index(i)
+		attrs[attr.Get("name").String()] = attr.Get("value").String()
+	}
+	return attrs
+}
+
+func TestThemedImgSwitchesOnlySrc(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	theme := reactivity.CreateSignal("light")
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ThemedImg(ThemedImgProps{
+			Srcs:     map[string]string{"light": pixelGIF + "#light", "dark": pixelGIF + "#dark"},
+			Alt:      "Logo",
+			Theme:    theme,
+			Lazy:     true,
+			Fallback: pixelGIF + "#fallback",
+		})
+	})
+	defer disposer()
+
+	img := container.Call("querySelector", "img")
+	before := imgAttrs(img)
+	if before["src"] != pixelGIF+"#light" || before["alt"] != "Logo" || before["loading"] != "lazy" {
+		t.Fatalf("initial attributes = %v", before)
+	}
+
+	theme.Set("dark")
+	if !container.Call("querySelector", "img").Equal(img) {
+		t.Fatal("theme change replaced the img element")
+	}
+	after := imgAttrs(img)
+	if after["src"] != pixelGIF+"#dark" {
+		t.Errorf("src after switching to dark = %q", after["src"])
+	}
+	for name, value := range before {
+		if name != "src" && after[name] != value {
+			t.Errorf("attribute %s changed from %q to %q", name, value, after[name])
+		}
+	}
+	if len(after) != len(before) {
+		t.Errorf("attributes changed from %v to %v", before, after)
+	}
+
+	// Themes without an entry show the fallback
+	theme.Set("sepia")
+	if src := img.Call("getAttribute", "src").String(); src != pixelGIF+"#fallback" {
+		t.Errorf("src for an unknown theme = %q, want the fallback", src)
+	}
+}
+
+func TestThemedImgFallsBackOnError(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	theme := reactivity.CreateSignal("light")
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return ThemedImg(ThemedImgProps{
+			Srcs:     map[string]string{"light": pixelGIF + "#light", "dark": pixelGIF + "#dark"},
+			Theme:    theme,
+			Fallback: pixelGIF + "#fallback",
+		})
+	})
+	defer disposer()
+
+	img := container.Call("querySelector", "img")
+	img.Call("dispatchEvent", js.Global().Get("Event").New("error"))
+	if src := img.Call("getAttribute", "src").String(); src != pixelGIF+"#fallback" {
+		t.Fatalf("src after a load error = %q, want the fallback", src)
+	}
+
+	// A later theme change tries the themed image again
+	theme.Set("dark")
+	if src := img.Call("getAttribute", "src").String(); src != pixelGIF+"#dark" {
+		t.Errorf("src after switching theme = %q", src)
+	}
+}
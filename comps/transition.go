@@ -0,0 +1,118 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"time"
+)
+
+// transition holds the enter and exit classes of a Show's content or a
+// For's rows.
+type transition struct {
+	enterClass   string
+	exitClass    string
+	exitDuration time.Duration
+}
+
+// exits reports whether removals wait for an exit transition.
+func (t transition) exits() bool {
+	return t.exitClass != "" && t.exitDuration > 0
+}
+
+// enter applies the enter class to els for one rendered frame, so that
+// removing it starts the CSS transition to their normal style.
+func (t transition) enter(els ...js.Value) {
+	if t.enterClass == "" || len(els) == 0 {
+		return
+	}
+	for _, el := range els {
+		el.Get("classList").Call("add", t.enterClass)
+	}
+	// The first frame renders the class; the one after removes it
+	afterFrames(2, func() {
+		for _, el := range els {
+			el.Get("classList").Call("remove", t.enterClass)
+		}
+	})
+}
+
+// exit applies the exit class to els and calls done once the exit
+// duration has elapsed or a transitionend or animationend event of one of
+// them fires, whichever comes first. Without an exit transition done is
+// called right away and cancel is nil. Otherwise cancel stops the running
+// exit, removing the class, and done is then not called.
+func (t transition) exit(done func(), els ...js.Value) (cancel func()) {
+	if !t.exits() || len(els) == 0 {
+		done()
+		return nil
+	}
+	for _, el := range els {
+		el.Get("classList").Call("add", t.exitClass)
+	}
+
+	var timer js.Value
+	var onTimeout, onEnd js.Func
+	finished := false
+	finish := func(completed bool) {
+		if finished {
+			return
+		}
+		finished = true
+		js.Global().Call("clearTimeout", timer)
+		for _, el := range els {
+			el.Call("removeEventListener", "transitionend", onEnd)
+			el.Call("removeEventListener", "animationend", onEnd)
+		}
+		onTimeout.Release()
+		onEnd.Release()
+		if completed {
+			done()
+			return
+		}
+		for _, el := range els {
+			el.Get("classList").Call("remove", t.exitClass)
+		}
+	}
+	onTimeout = js.FuncOf(func(this js.Value, args []js.Value) any {
+		finish(true)
+		return nil
+	})
+	onEnd = js.FuncOf(func(this js.Value, args []js.Value) any {
+		// Transitions of descendants bubble up; only the element's own count
+		if len(args) > 0 && args[0].Get("target").Equal(this) {
+			finish(true)
+		}
+		return nil
+	})
+	for _, el := range els {
+		el.Call("addEventListener", "transitionend", onEnd)
+		el.Call("addEventListener", "animationend", onEnd)
+	}
+	timer = js.Global().Call("setTimeout", onTimeout, t.exitDuration.Milliseconds())
+	return func() { finish(false) }
+}
+
+// afterFrames runs fn in the animation frame n frames from now.
+func afterFrames(n int, fn func()) {
+	var cb js.Func
+	cb = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if n--; n > 0 {
+			js.Global().Call("requestAnimationFrame", cb)
+			return nil
+		}
+		cb.Release()
+		fn()
+		return nil
+	})
+	js.Global().Call("requestAnimationFrame", cb)
+}
+
+// elementChildren returns the element children of el.
+func elementChildren(el js.Value) []js.Value {
+	var els []js.Value
+	for child := el.Get("firstElementChild"); child.Truthy(); child = child.Get("nextElementSibling") {
+		els = append(els, child)
+	}
+	return els
+}
@@ -0,0 +1,145 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func hasClass(el js.Value, class string) bool {
+	return el.Truthy() && el.Get("classList").Call("contains", class).Bool()
+}
+
+func TestShowTransitions(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	open := reactivity.CreateSignal(false)
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return Show(ShowProps{
+			When:         open,
+			Children:     g.El("div", g.Attr("class", "panel")),
+			EnterClass:   "fade-enter",
+			ExitClass:    "fade-exit",
+			ExitDuration: 50 * time.Millisecond,
+		})
+	})
+	defer disposer()
+
+	open.Set(true)
+	panel := container.Call("querySelector", ".panel")
+	if !hasClass(panel, "fade-enter") {
+		t.Fatal("enter class was not applied on show")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if hasClass(panel, "fade-enter") {
+		t.Error("enter class was not removed after a frame")
+	}
+
+	open.Set(false)
+	if !hasClass(container.Call("querySelector", ".panel"), "fade-exit") {
+		t.Fatal("children were removed before their exit transition")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if container.Call("querySelector", ".panel").Truthy() {
+		t.Fatal("children were not removed after the exit duration")
+	}
+
+	// Showing again while exiting keeps the children
+	open.Set(true)
+	panel = container.Call("querySelector", ".panel")
+	open.Set(false)
+	open.Set(true)
+	time.Sleep(100 * time.Millisecond)
+	if !container.Call("querySelector", ".panel").Equal(panel) {
+		t.Fatal("a quick toggle removed or replaced the children")
+	}
+	if hasClass(panel, "fade-exit") {
+		t.Error("exit class was kept after the exit was cancelled")
+	}
+}
+
+func TestForTransitions(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	items := reactivity.CreateSignal([]string{"a", "b", "c"})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[string]{
+			Items: items,
+			Key:   func(s string) string { return s },
+			ChildrenWithIndex: func(s string, _ reactivity.Signal[int]) g.Node {
+				return g.El("li", g.Attr("class", "row-"+s), g.Text(s))
+			},
+			EnterClass:   "row-enter",
+			ExitClass:    "row-exit",
+			ExitDuration: 50 * time.Millisecond,
+		})
+	})
+	defer disposer()
+
+	list := container.Call("querySelector", "[data-uiwgo-for]")
+	if hasClass(list.Get("firstElementChild"), "row-enter") {
+		t.Error("rows of the first render were animated")
+	}
+
+	items.Set([]string{"a", "c", "d"})
+	if !hasClass(container.Call("querySelector", ".row-d"), "row-enter") {
+		t.Error("enter class was not applied to the added row")
+	}
+	b := container.Call("querySelector", ".row-b")
+	if !hasClass(b, "row-exit") {
+		t.Fatal("removed row was dropped before its exit transition")
+	}
+	if got := list.Get("textContent").String(); got != "abcd" {
+		t.Errorf("rows while b exits = %q, want abcd", got)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if container.Call("querySelector", ".row-b").Truthy() {
+		t.Fatal("removed row was not dropped after the exit duration")
+	}
+
+	// A key coming back while its row exits keeps the row
+	items.Set([]string{"a", "d"})
+	c := container.Call("querySelector", ".row-c")
+	items.Set([]string{"a", "c", "d"})
+	time.Sleep(100 * time.Millisecond)
+	if !container.Call("querySelector", ".row-c").Equal(c) || hasClass(c, "row-exit") {
+		t.Error("re-adding an exiting row did not cancel its exit")
+	}
+	if got := list.Get("textContent").String(); got != "acd" {
+		t.Errorf("rows after the quick toggle = %q, want acd", got)
+	}
+}
+
+func TestForTransitionsKeepExitingRowInPlace(t *testing.T) {
+	container := createTestContainer(t)
+	defer cleanupContainer(container)
+
+	items := reactivity.CreateSignal([]string{"a", "b", "c"})
+	disposer := Mount(container.Get("id").String(), func() g.Node {
+		return For(ForProps[string]{
+			Items:        items,
+			Key:          func(s string) string { return s },
+			Children:     func(s string, _ int) g.Node { return g.El("li", g.Text(s)) },
+			ExitClass:    "row-exit",
+			ExitDuration: 50 * time.Millisecond,
+		})
+	})
+	defer disposer()
+
+	list := container.Call("querySelector", "[data-uiwgo-for]")
+	items.Set([]string{"a", "c"})
+	if got := list.Get("textContent").String(); got != "abc" {
+		t.Errorf("rows while b exits = %q, want abc", got)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got := list.Get("textContent").String(); got != "ac" {
+		t.Errorf("rows after the exit = %q, want ac", got)
+	}
+}
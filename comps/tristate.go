@@ -0,0 +1,87 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// TriState is the state of a checkbox that can be partially checked.
+type TriState int
+
+const (
+	Unchecked TriState = iota
+	Checked
+	Indeterminate
+)
+
+// BindIndeterminate keeps the indeterminate property of the enclosing
+// element in sync with sig. Indeterminate has no HTML attribute, so it is
+// set on the DOM element after mount. Use it as a child of an <input>:
+//
+//	g.El("input", g.Attr("type", "checkbox"), comps.BindIndeterminate(partial))
+func BindIndeterminate(sig reactivity.Signal[bool]) g.Node {
+	return bindElementProps(func(el js.Value) {
+		el.Set("indeterminate", sig.Get())
+	})
+}
+
+// TriStateCheckbox renders a checkbox whose checked and indeterminate
+// properties follow state. Clicking it moves to Checked, or to Unchecked
+// when it was Checked; Indeterminate is only entered through state, which
+// suits "select all" boxes derived from their children. The new state is
+// written to state and then passed to onChange, if non-nil.
+func TriStateCheckbox(state reactivity.Signal[TriState], onChange func(TriState)) g.Node {
+	return g.El("input",
+		g.Attr("type", "checkbox"),
+		g.If(state.Peek() == Checked, g.Attr("checked", "")),
+		bindElementProps(func(el js.Value) {
+			syncTriState(el, state.Get())
+		}),
+		dom.OnChangeInline(func(el dom.Element) {
+			next := nextTriState(state.Peek())
+			state.Set(next)
+			if onChange != nil {
+				onChange(next)
+			}
+			// The browser already flipped checked; put it back in line with
+			// state in case the handlers above left state unchanged.
+			syncTriState(el.Underlying(), state.Peek())
+		}),
+	)
+}
+
+// bindElementProps runs apply in an effect against the enclosing element
+// once it is mounted, for DOM properties that have no attribute form.
+func bindElementProps(apply func(el js.Value)) g.Node {
+	id := nextID("prop")
+	return g.Group([]g.Node{
+		g.Attr("data-uiwgo-prop", id),
+		OnMount(func() {
+			el := js.Global().Get("document").Call("querySelector", "[data-uiwgo-prop='"+id+"']")
+			if !el.Truthy() {
+				return
+			}
+			reactivity.CreateEffect(func() {
+				apply(el)
+			})
+		}),
+	})
+}
+
+func syncTriState(el js.Value, s TriState) {
+	el.Set("checked", s == Checked)
+	el.Set("indeterminate", s == Indeterminate)
+}
+
+// nextTriState returns the state a click moves to.
+func nextTriState(s TriState) TriState {
+	if s == Checked {
+		return Unchecked
+	}
+	return Checked
+}
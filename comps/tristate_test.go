@@ -0,0 +1,101 @@
+//go:build js && wasm
+
+package comps
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestBindIndeterminateTogglesProperty(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-indeterminate")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	partial := reactivity.CreateSignal(true)
+	disposer := Mount("test-indeterminate", func() Node {
+		return g.El("input", g.Attr("type", "checkbox"), BindIndeterminate(partial))
+	})
+	defer disposer()
+
+	input := container.Call("querySelector", "input")
+	if !input.Get("indeterminate").Bool() {
+		t.Fatal("Expected indeterminate property to be set after mount")
+	}
+	if input.Call("hasAttribute", "indeterminate").Bool() {
+		t.Error("Expected no indeterminate attribute")
+	}
+
+	partial.Set(false)
+	if input.Get("indeterminate").Bool() {
+		t.Error("Expected indeterminate property to clear when the signal is false")
+	}
+
+	partial.Set(true)
+	if !input.Get("indeterminate").Bool() {
+		t.Error("Expected indeterminate property to be set again")
+	}
+}
+
+func TestTriStateCheckboxCycles(t *testing.T) {
+	// Skip if not in browser environment
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-tristate")
+	document.Get("body").Call("appendChild", container)
+	defer document.Get("body").Call("removeChild", container)
+
+	state := reactivity.CreateSignal(Indeterminate)
+	var changes []TriState
+	disposer := Mount("test-tristate", func() Node {
+		return TriStateCheckbox(state, func(s TriState) { changes = append(changes, s) })
+	})
+	defer disposer()
+
+	input := container.Call("querySelector", "input[type=checkbox]")
+	assertProps := func(wantChecked, wantIndeterminate bool) {
+		t.Helper()
+		if got := input.Get("checked").Bool(); got != wantChecked {
+			t.Errorf("checked = %v, want %v", got, wantChecked)
+		}
+		if got := input.Get("indeterminate").Bool(); got != wantIndeterminate {
+			t.Errorf("indeterminate = %v, want %v", got, wantIndeterminate)
+		}
+	}
+	assertProps(false, true)
+
+	// Clicking an indeterminate box checks it
+	input.Call("click")
+	if state.Get() != Checked {
+		t.Fatalf("state after first click = %v, want Checked", state.Get())
+	}
+	assertProps(true, false)
+
+	input.Call("click")
+	if state.Get() != Unchecked {
+		t.Fatalf("state after second click = %v, want Unchecked", state.Get())
+	}
+	assertProps(false, false)
+
+	// Indeterminate is driven by the signal only
+	state.Set(Indeterminate)
+	assertProps(false, true)
+
+	if len(changes) != 2 || changes[0] != Checked || changes[1] != Unchecked {
+		t.Errorf("onChange calls = %v, want [Checked Unchecked]", changes)
+	}
+}
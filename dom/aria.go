@@ -0,0 +1,107 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"strconv"
+	"strings"
+	"syscall/js"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// ariaBindingPrefix starts the name of the attribute marking each ARIA
+// binding of an element, as one element often has several.
+const ariaBindingPrefix = "data-uiwgo-aria-"
+
+type ariaBinding struct {
+	name  string        // full attribute name, e.g. aria-expanded
+	value func() string // empty removes the attribute
+}
+
+var ariaBindings = map[string]*ariaBinding{}
+
+// BindAria keeps the ARIA state attribute name of the element it is added
+// to set to "true" or "false" following state. name may omit the aria-
+// prefix:
+//
+//	h.Button(dom.BindAria("expanded", menuOpen), g.Text("Menu"))
+func BindAria(name string, state reactivity.ReadonlySignal[bool]) g.Node {
+	return bindAria(name, func() string { return strconv.FormatBool(state.Get()) })
+}
+
+// BindAriaValue keeps the ARIA attribute name of the element it is added to
+// set to fn's result, for attributes with other values such as
+// aria-checked="mixed" or aria-activedescendant. An empty result removes
+// the attribute.
+func BindAriaValue(name string, fn func() string) g.Node {
+	return bindAria(name, fn)
+}
+
+func bindAria(name string, value func() string) g.Node {
+	if !strings.HasPrefix(name, "aria-") {
+		name = "aria-" + name
+	}
+	id := nextInlineID("aria")
+	inlineHandlersMu.Lock()
+	ariaBindings[id] = &ariaBinding{name: name, value: value}
+	inlineHandlersMu.Unlock()
+
+	// Render the current value so the element is correct before mount
+	initial := reactivity.Untrack(value)
+	return g.Group([]g.Node{
+		g.Attr("data-uiwgo-aria", ""),
+		g.Attr(ariaBindingPrefix+id, ""),
+		g.If(initial != "", g.Attr(name, initial)),
+	})
+}
+
+// attachAriaIn binds the BindAria elements marked in root, root included.
+func attachAriaIn(root js.Value) {
+	if root.Get("matches").Truthy() && root.Call("matches", "[data-uiwgo-aria]").Bool() {
+		attachAriaElement(root)
+	}
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-aria]")
+	for i := 0; i < nodes.Get("length").Int(); i++ {
+		attachAriaElement(nodes.Index(i))
+	}
+}
+
+func attachAriaElement(el js.Value) {
+	if el.Call("hasAttribute", "data-uiwgo-bound-aria").Bool() {
+		return
+	}
+	el.Call("setAttribute", "data-uiwgo-bound-aria", "1")
+	attrs := el.Get("attributes")
+	var ids []string
+	for i := 0; i < attrs.Length(); i++ {
+		if name := attrs.Index(i).Get("name").String(); strings.HasPrefix(name, ariaBindingPrefix) {
+			ids = append(ids, strings.TrimPrefix(name, ariaBindingPrefix))
+		}
+	}
+	for _, id := range ids {
+		inlineHandlersMu.RLock()
+		binding := ariaBindings[id]
+		inlineHandlersMu.RUnlock()
+		if binding != nil {
+			bindAriaElement(id, el, binding)
+		}
+	}
+}
+
+func bindAriaElement(id string, el js.Value, binding *ariaBinding) {
+	effect := reactivity.CreateRenderEffect(func() {
+		if value := binding.value(); value != "" {
+			el.Call("setAttribute", binding.name, value)
+		} else {
+			el.Call("removeAttribute", binding.name)
+		}
+	})
+	reactivity.RegisterCleanup(func() {
+		effect.Dispose()
+		inlineHandlersMu.Lock()
+		delete(ariaBindings, id)
+		inlineHandlersMu.Unlock()
+	})
+}
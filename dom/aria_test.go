@@ -0,0 +1,73 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"syscall/js"
+	"testing"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func TestBindAriaSerializesState(t *testing.T) {
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+	defer container.Call("remove")
+
+	open := reactivity.CreateSignal(false)
+	checked := reactivity.CreateSignal("mixed")
+	var buf bytes.Buffer
+	_ = h.Button(
+		h.Class("menu-toggle"),
+		BindAria("expanded", open),
+		BindAria("aria-pressed", open),
+		BindAriaValue("checked", checked.Get),
+		g.Text("Menu"),
+	).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	el := container.Call("querySelector", ".menu-toggle")
+	attr := func(name string) string {
+		if v := el.Call("getAttribute", name); v.Type() == js.TypeString {
+			return v.String()
+		}
+		return "<none>"
+	}
+	if got := attr("aria-expanded"); got != "false" {
+		t.Fatalf("aria-expanded before mount = %q, want false", got)
+	}
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+
+	open.Set(true)
+	if attr("aria-expanded") != "true" || attr("aria-pressed") != "true" {
+		t.Errorf("aria-expanded = %q, aria-pressed = %q; want both true", attr("aria-expanded"), attr("aria-pressed"))
+	}
+	if got := attr("aria-checked"); got != "mixed" {
+		t.Errorf("aria-checked = %q, want mixed", got)
+	}
+
+	checked.Set("")
+	if got := attr("aria-checked"); got != "<none>" {
+		t.Errorf("aria-checked = %q after an empty value, want it removed", got)
+	}
+	checked.Set("true")
+	if got := attr("aria-checked"); got != "true" {
+		t.Errorf("aria-checked = %q, want true", got)
+	}
+
+	// Disposed bindings no longer follow their signals
+	scope.Dispose()
+	open.Set(false)
+	if got := attr("aria-expanded"); got != "true" {
+		t.Errorf("aria-expanded = %q after dispose, want it left at true", got)
+	}
+}
@@ -0,0 +1,115 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"syscall/js"
+	"testing"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// mountInline renders node into a container attached to body, attaches the
+// inline delegates and returns the container and a disposer.
+func mountInline(t *testing.T, node g.Node) (js.Value, func()) {
+	t.Helper()
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+
+	var buf bytes.Buffer
+	_ = node.Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+
+	return container, func() {
+		scope.Dispose()
+		container.Call("remove")
+	}
+}
+
+func dispatchKey(el js.Value, eventType, key string) {
+	el.Call("dispatchEvent", js.Global().Get("KeyboardEvent").New(eventType, map[string]any{"key": key, "bubbles": true}))
+}
+
+func dispatchBeforeInput(el js.Value, inputType string, data any) {
+	el.Call("dispatchEvent", js.Global().Get("InputEvent").New("beforeinput", map[string]any{
+		"inputType":  inputType,
+		"data":       data,
+		"bubbles":    true,
+		"cancelable": true,
+	}))
+}
+
+func TestOnEnterInlineBeforeInputFallback(t *testing.T) {
+	if js.Global().Get("InputEvent").IsUndefined() || !SupportsBeforeInput() {
+		t.Skip("beforeinput is not supported")
+	}
+	count := 0
+	container, dispose := mountInline(t, h.Textarea(OnEnterInline(func(Element) { count++ }, EnterOptions{BeforeInput: true})))
+	defer dispose()
+	area := container.Call("querySelector", "textarea")
+
+	// A mobile keyboard: the key is not reported, the line break is
+	dispatchKey(area, "keydown", "Unidentified")
+	dispatchBeforeInput(area, "insertLineBreak", nil)
+	dispatchKey(area, "keyup", "Unidentified")
+	if count != 1 {
+		t.Fatalf("handler ran %d times for a beforeinput Enter, want 1", count)
+	}
+
+	// Typing text is not Enter
+	dispatchBeforeInput(area, "insertText", "a")
+	if count != 1 {
+		t.Fatalf("insertText ran the Enter handler: count = %d", count)
+	}
+
+	// A desktop keyboard reports Enter; its beforeinput must not run the
+	// handler again
+	count = 0
+	dispatchKey(area, "keydown", "Enter")
+	dispatchBeforeInput(area, "insertParagraph", nil)
+	if count != 1 {
+		t.Errorf("handler ran %d times for keydown Enter followed by beforeinput, want 1", count)
+	}
+}
+
+func TestOnEnterInlineIgnoresBeforeInputWithoutOptIn(t *testing.T) {
+	count := 0
+	container, dispose := mountInline(t, h.Textarea(OnEnterInline(func(Element) { count++ })))
+	defer dispose()
+	area := container.Call("querySelector", "textarea")
+
+	dispatchKey(area, "keydown", "Unidentified")
+	dispatchBeforeInput(area, "insertLineBreak", nil)
+	if count != 0 {
+		t.Errorf("beforeinput ran the handler without BeforeInput: count = %d", count)
+	}
+}
+
+func TestOnBeforeInputInline(t *testing.T) {
+	type call struct{ inputType, data string }
+	var calls []call
+	container, dispose := mountInline(t, h.Input(OnBeforeInputInline(func(el Element, inputType, data string) {
+		calls = append(calls, call{inputType, data})
+	})))
+	defer dispose()
+	input := container.Call("querySelector", "input")
+
+	dispatchBeforeInput(input, "insertText", "x")
+	dispatchBeforeInput(input, "deleteContentBackward", nil)
+	if len(calls) != 2 || calls[0] != (call{"insertText", "x"}) || calls[1] != (call{"deleteContentBackward", ""}) {
+		t.Errorf("calls = %v", calls)
+	}
+}
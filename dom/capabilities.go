@@ -0,0 +1,235 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"fmt"
+	"math"
+	"syscall/js"
+
+	"github.com/ozanturksever/logutil"
+)
+
+// BrowserCapabilities reports which of the optional browser APIs used by
+// the framework are available. Helpers that need a missing one fall back
+// to a slower or simpler implementation instead of failing.
+type BrowserCapabilities struct {
+	// IntersectionObserver backs OnVisibleInline and VisibilityRatio; they
+	// poll element positions without it.
+	IntersectionObserver bool
+	// ResizeObserver backs OnResizeInline, BoundingRect and ScrollProgress;
+	// element sizes are polled without it.
+	ResizeObserver bool
+	// MutationObserver lets components attach content added after Mount.
+	MutationObserver bool
+	// Dialog is the <dialog> element.
+	Dialog bool
+	// MatchMedia backs media queries such as the theme of ThemedImg.
+	MatchMedia bool
+}
+
+// Capability names, as passed to OnMissingCapability handlers.
+const (
+	CapIntersectionObserver = "IntersectionObserver"
+	CapResizeObserver       = "ResizeObserver"
+	CapMutationObserver     = "MutationObserver"
+	CapDialog               = "Dialog"
+	CapMatchMedia           = "MatchMedia"
+)
+
+// detectCapabilities reads the capabilities of the browser; tests replace
+// it to disable some.
+var detectCapabilities = func() BrowserCapabilities {
+	global := js.Global()
+	return BrowserCapabilities{
+		IntersectionObserver: global.Get("IntersectionObserver").Truthy(),
+		ResizeObserver:       global.Get("ResizeObserver").Truthy(),
+		MutationObserver:     global.Get("MutationObserver").Truthy(),
+		Dialog:               global.Get("HTMLDialogElement").Truthy(),
+		MatchMedia:           global.Get("matchMedia").Type() == js.TypeFunction,
+	}
+}
+
+// Capabilities detects the optional browser APIs the framework uses.
+func Capabilities() BrowserCapabilities {
+	return detectCapabilities()
+}
+
+var (
+	missingCapabilityHandlers []func(name string)
+	// missingReported holds the capabilities reported already
+	missingReported = map[string]bool{}
+)
+
+// OnMissingCapability registers handler to be called with the name of each
+// capability (CapIntersectionObserver, ...) that a helper needed but the
+// browser lacks, e.g. to report degraded features. Each name is reported
+// once, when the first helper falls back; register handlers before Mount.
+func OnMissingCapability(handler func(name string)) {
+	missingCapabilityHandlers = append(missingCapabilityHandlers, handler)
+}
+
+// HasCapability reports whether the named capability is available. When it
+// is not, the OnMissingCapability handlers are told, once per name.
+func HasCapability(name string) bool {
+	c := Capabilities()
+	var ok bool
+	switch name {
+	case CapIntersectionObserver:
+		ok = c.IntersectionObserver
+	case CapResizeObserver:
+		ok = c.ResizeObserver
+	case CapMutationObserver:
+		ok = c.MutationObserver
+	case CapDialog:
+		ok = c.Dialog
+	case CapMatchMedia:
+		ok = c.MatchMedia
+	}
+	if !ok && !missingReported[name] {
+		missingReported[name] = true
+		logutil.Logf("dom: %s is not supported, falling back", name)
+		for _, handler := range missingCapabilityHandlers {
+			handler(name)
+		}
+	}
+	return ok
+}
+
+// capabilityPollInterval is how often, in milliseconds, the stand-ins for
+// missing observers check the elements they observe.
+const capabilityPollInterval = 250
+
+// newIntersectionObserver returns an IntersectionObserver calling callback,
+// or a stand-in polling the position of the observed elements when the
+// browser has none. The stand-in reports the intersection ratio in steps
+// of 0.05 and ignores options.
+func newIntersectionObserver(callback js.Func, options ...any) js.Value {
+	if HasCapability(CapIntersectionObserver) {
+		return js.Global().Get("IntersectionObserver").New(append([]any{callback}, options...)...)
+	}
+	return newPollingObserver(callback, func(el js.Value) (string, map[string]any) {
+		ratio := viewportRatio(measureRect(el))
+		return fmt.Sprint(ratio), map[string]any{
+			"target":            el,
+			"isIntersecting":    ratio > 0,
+			"intersectionRatio": ratio,
+		}
+	})
+}
+
+// newResizeObserver returns a ResizeObserver calling callback, or a
+// stand-in polling the size of the observed elements when the browser has
+// none.
+func newResizeObserver(callback js.Func) js.Value {
+	if HasCapability(CapResizeObserver) {
+		return js.Global().Get("ResizeObserver").New(callback)
+	}
+	return newPollingObserver(callback, func(el js.Value) (string, map[string]any) {
+		r := measureRect(el)
+		return fmt.Sprint(r.Width, r.Height), map[string]any{
+			"target":      el,
+			"contentRect": map[string]any{"width": r.Width, "height": r.Height},
+		}
+	})
+}
+
+// viewportRatio returns the fraction of r inside the viewport, rounded to
+// a multiple of 0.05.
+func viewportRatio(r Rect) float64 {
+	area := r.Width * r.Height
+	if area <= 0 {
+		return 0
+	}
+	win := js.Global()
+	w := math.Min(r.X+r.Width, win.Get("innerWidth").Float()) - math.Max(r.X, 0)
+	h := math.Min(r.Y+r.Height, win.Get("innerHeight").Float()) - math.Max(r.Y, 0)
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+	return math.Round(w*h/area*20) / 20
+}
+
+// polledTarget is an element observed by a polling observer and the state
+// last reported for it.
+type polledTarget struct {
+	el       js.Value
+	state    string
+	reported bool
+}
+
+// newPollingObserver returns an object with the observe, unobserve and
+// disconnect methods of the browser observers. Every capabilityPollInterval
+// it measures the observed elements and passes callback the entries of
+// those whose state changed, and of those observed since the last check.
+func newPollingObserver(callback js.Func, measure func(el js.Value) (state string, entry map[string]any)) js.Value {
+	observer := js.Global().Get("Object").New()
+	var targets []*polledTarget
+	var timer js.Value
+
+	tick := js.FuncOf(func(this js.Value, args []js.Value) any {
+		var entries []any
+		for _, t := range targets {
+			state, entry := measure(t.el)
+			if t.reported && state == t.state {
+				continue
+			}
+			t.state, t.reported = state, true
+			entries = append(entries, entry)
+		}
+		if len(entries) > 0 {
+			callback.Invoke(entries, observer)
+		}
+		return nil
+	})
+	stop := func() {
+		if timer.Truthy() {
+			js.Global().Call("clearInterval", timer)
+			timer = js.Undefined()
+		}
+	}
+	var observe, unobserve, disconnect js.Func
+	observe = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		for _, t := range targets {
+			if t.el.Equal(args[0]) {
+				return nil
+			}
+		}
+		targets = append(targets, &polledTarget{el: args[0]})
+		if !timer.Truthy() {
+			timer = js.Global().Call("setInterval", tick, capabilityPollInterval)
+		}
+		return nil
+	})
+	unobserve = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		for i, t := range targets {
+			if t.el.Equal(args[0]) {
+				targets = append(targets[:i], targets[i+1:]...)
+				break
+			}
+		}
+		if len(targets) == 0 {
+			stop()
+		}
+		return nil
+	})
+	disconnect = js.FuncOf(func(this js.Value, args []js.Value) any {
+		stop()
+		targets = nil
+		tick.Release()
+		observe.Release()
+		unobserve.Release()
+		disconnect.Release()
+		return nil
+	})
+	observer.Set("observe", observe)
+	observer.Set("unobserve", unobserve)
+	observer.Set("disconnect", disconnect)
+	return observer
+}
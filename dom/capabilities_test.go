@@ -0,0 +1,115 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// withoutCapabilities makes the helpers see a browser lacking the
+// observers, records what OnMissingCapability reports and returns the
+// record.
+func withoutCapabilities(t *testing.T) *[]string {
+	prevDetect, prevHandlers, prevReported := detectCapabilities, missingCapabilityHandlers, missingReported
+	t.Cleanup(func() {
+		detectCapabilities, missingCapabilityHandlers, missingReported = prevDetect, prevHandlers, prevReported
+	})
+	detectCapabilities = func() BrowserCapabilities {
+		c := prevDetect()
+		c.IntersectionObserver = false
+		c.ResizeObserver = false
+		return c
+	}
+	missingCapabilityHandlers, missingReported = nil, map[string]bool{}
+	var missing []string
+	OnMissingCapability(func(name string) { missing = append(missing, name) })
+	return &missing
+}
+
+// eventually polls cond for a few poll intervals of the stand-in observers.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(8 * capabilityPollInterval * time.Millisecond)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestInlineObserversFallBackToPolling(t *testing.T) {
+	missing := withoutCapabilities(t)
+	if Capabilities().IntersectionObserver || !Capabilities().MutationObserver {
+		t.Fatal("capabilities were not overridden")
+	}
+
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+	defer container.Call("remove")
+
+	var visible []string
+	resized := 0
+	var buf bytes.Buffer
+	_ = g.Group([]g.Node{
+		h.Div(h.ID("cap-near"), h.Style("height: 20px"), OnVisibleInline(func(Element) { visible = append(visible, "near") })),
+		h.Div(h.ID("cap-far"), h.Style("position: absolute; top: 10000px; height: 20px"), OnVisibleInline(func(Element) { visible = append(visible, "far") })),
+		h.Div(h.ID("cap-box"), h.Style("width: 50px; height: 20px"), OnResizeInline(func(Element) { resized++ })),
+	}).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+	defer scope.Dispose()
+
+	eventually(t, "the visible element to be reported", func() bool { return len(visible) > 0 })
+	eventually(t, "the initial size to be reported", func() bool { return resized > 0 })
+	if len(visible) != 1 || visible[0] != "near" {
+		t.Errorf("visibility handlers ran for %v, want only the element in the viewport", visible)
+	}
+
+	before := resized
+	doc.Call("getElementById", "cap-box").Get("style").Set("width", "80px")
+	eventually(t, "the size change to be reported", func() bool { return resized > before })
+
+	if len(*missing) != 2 || (*missing)[0] != CapIntersectionObserver || (*missing)[1] != CapResizeObserver {
+		t.Errorf("missing capabilities reported = %v, want each observer once", *missing)
+	}
+}
+
+func TestVisibilityRatioPollsWithoutIntersectionObserver(t *testing.T) {
+	withoutCapabilities(t)
+
+	doc := js.Global().Get("document")
+	el := doc.Call("createElement", "div")
+	el.Get("style").Set("height", "40px")
+	el.Get("style").Set("width", "100px")
+	doc.Get("body").Call("appendChild", el)
+	defer el.Call("remove")
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	ratio := VisibilityRatio(domv2.WrapElement(el))
+	reactivity.SetCurrentCleanupScope(prev)
+	defer scope.Dispose()
+
+	eventually(t, "the ratio to be polled", func() bool { return ratio.Get() == 1 })
+
+	el.Get("style").Set("transform", "translateY(-20px)")
+	el.Get("style").Set("position", "fixed")
+	el.Get("style").Set("top", "0")
+	eventually(t, "the ratio of a half hidden element", func() bool { return ratio.Get() == 0.5 })
+}
@@ -0,0 +1,264 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"strings"
+	"syscall/js"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// SanitizePolicy cleans HTML written back from a contentEditable region
+// before it is stored in the bound signal.
+type SanitizePolicy func(html string) string
+
+// CEOptions configures BindContentEditable.
+type CEOptions struct {
+	// PlainText binds innerText instead of innerHTML and normalizes pasted
+	// content to plain text. Unless the element's inline style sets one, it
+	// gets white-space: pre-wrap so line breaks survive the round trip.
+	PlainText bool
+	// SanitizePolicy cleans HTML on write-back. Defaults to
+	// DefaultSanitizePolicy; ignored when PlainText is set.
+	SanitizePolicy SanitizePolicy
+	// DebounceMs delays write-back after input. Defaults to 150ms.
+	DebounceMs int
+}
+
+type contentEditableBinding struct {
+	sig  reactivity.Signal[string]
+	opts CEOptions
+}
+
+var contentEditableBindings = map[string]*contentEditableBinding{}
+
+// BindContentEditable makes the element a contentEditable region two-way
+// bound to sig. The element content is set from the signal after mount and
+// user edits are written back (debounced and sanitized). Programmatic
+// updates keep the caret position when the element has focus.
+func BindContentEditable(sig reactivity.Signal[string], opts CEOptions) g.Node {
+	id := nextInlineID("ce")
+	inlineHandlersMu.Lock()
+	contentEditableBindings[id] = &contentEditableBinding{sig: sig, opts: opts}
+	inlineHandlersMu.Unlock()
+
+	return g.Group([]g.Node{
+		g.Attr("contenteditable", "true"),
+		g.Attr("data-uiwgo-contenteditable", id),
+	})
+}
+
+// DefaultSanitizePolicy removes scripts, embedded content, inline event
+// handlers and javascript: URLs from html.
+func DefaultSanitizePolicy(html string) string {
+	tpl := js.Global().Get("document").Call("createElement", "template")
+	tpl.Set("innerHTML", html)
+	content := tpl.Get("content")
+
+	blocked := content.Call("querySelectorAll", "script,style,iframe,object,embed,link,meta")
+	for i := blocked.Get("length").Int() - 1; i >= 0; i-- {
+		blocked.Index(i).Call("remove")
+	}
+
+	all := content.Call("querySelectorAll", "*")
+	for i := 0; i < all.Get("length").Int(); i++ {
+		el := all.Index(i)
+		attrs := el.Get("attributes")
+		for j := attrs.Get("length").Int() - 1; j >= 0; j-- {
+			name := strings.ToLower(attrs.Index(j).Get("name").String())
+			value := strings.ToLower(strings.TrimSpace(attrs.Index(j).Get("value").String()))
+			if strings.HasPrefix(name, "on") || strings.HasPrefix(value, "javascript:") {
+				el.Call("removeAttribute", name)
+			}
+		}
+	}
+	return tpl.Get("innerHTML").String()
+}
+
+// normalizePlainText converts pasted text to the form stored for plain-text
+// regions: unified line endings, regular spaces and no zero-width spaces.
+func normalizePlainText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+	return strings.ReplaceAll(s, "\u200b", "")
+}
+
+// attachContentEditablesIn binds contentEditable regions marked under root.
+func attachContentEditablesIn(root js.Value) {
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-contenteditable]")
+	for i := 0; i < nodes.Get("length").Int(); i++ {
+		el := nodes.Index(i)
+		if el.Call("hasAttribute", "data-uiwgo-bound-contenteditable").Bool() {
+			continue
+		}
+		id := el.Call("getAttribute", "data-uiwgo-contenteditable").String()
+		inlineHandlersMu.RLock()
+		binding := contentEditableBindings[id]
+		inlineHandlersMu.RUnlock()
+		if binding == nil {
+			continue
+		}
+		el.Call("setAttribute", "data-uiwgo-bound-contenteditable", "1")
+		bindContentEditableElement(id, el, binding)
+	}
+}
+
+func bindContentEditableElement(id string, el js.Value, binding *contentEditableBinding) {
+	opts := binding.opts
+	delay := opts.DebounceMs
+	if delay <= 0 {
+		delay = 150
+	}
+	sanitize := opts.SanitizePolicy
+	if sanitize == nil {
+		sanitize = DefaultSanitizePolicy
+	}
+
+	if opts.PlainText && el.Get("style").Get("whiteSpace").String() == "" {
+		el.Get("style").Set("whiteSpace", "pre-wrap")
+	}
+
+	read := func() string {
+		if opts.PlainText {
+			return normalizePlainText(el.Get("innerText").String())
+		}
+		return sanitize(el.Get("innerHTML").String())
+	}
+	write := func(v string) {
+		if opts.PlainText {
+			el.Set("innerText", v)
+		} else {
+			el.Set("innerHTML", v)
+		}
+	}
+
+	// Reflect the signal into the element, keeping the caret where possible
+	effect := reactivity.CreateRenderEffect(func() {
+		v := binding.sig.Get()
+		if read() == v {
+			return
+		}
+		focused := js.Global().Get("document").Get("activeElement").Equal(el)
+		caret := -1
+		if focused {
+			caret = caretOffset(el)
+		}
+		write(v)
+		if caret >= 0 {
+			setCaretOffset(el, caret)
+		}
+	})
+
+	var timer js.Value
+	var flushFn js.Func
+	flushFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+		timer = js.Undefined()
+		binding.sig.Set(read())
+		return nil
+	})
+	schedule := func() {
+		if timer.Truthy() {
+			js.Global().Call("clearTimeout", timer)
+		}
+		timer = js.Global().Call("setTimeout", flushFn, delay)
+	}
+
+	inputFn := js.FuncOf(func(this js.Value, args []js.Value) any {
+		schedule()
+		return nil
+	})
+	pasteFn := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if !opts.PlainText || len(args) == 0 {
+			return nil
+		}
+		evt := args[0]
+		data := evt.Get("clipboardData")
+		if !data.Truthy() {
+			return nil
+		}
+		evt.Call("preventDefault")
+		insertPlainText(el, normalizePlainText(data.Call("getData", "text/plain").String()))
+		schedule()
+		return nil
+	})
+	el.Call("addEventListener", "input", inputFn)
+	el.Call("addEventListener", "paste", pasteFn)
+
+	reactivity.RegisterCleanup(func() {
+		effect.Dispose()
+		if timer.Truthy() {
+			js.Global().Call("clearTimeout", timer)
+		}
+		el.Call("removeEventListener", "input", inputFn)
+		el.Call("removeEventListener", "paste", pasteFn)
+		inputFn.Release()
+		pasteFn.Release()
+		flushFn.Release()
+		inlineHandlersMu.Lock()
+		delete(contentEditableBindings, id)
+		inlineHandlersMu.Unlock()
+	})
+}
+
+// insertPlainText replaces the current selection inside el with text.
+func insertPlainText(el js.Value, text string) {
+	doc := js.Global().Get("document")
+	sel := js.Global().Call("getSelection")
+	if !sel.Truthy() || sel.Get("rangeCount").Int() == 0 || !el.Call("contains", sel.Call("getRangeAt", 0).Get("startContainer")).Bool() {
+		el.Call("append", doc.Call("createTextNode", text))
+		return
+	}
+	rng := sel.Call("getRangeAt", 0)
+	rng.Call("deleteContents")
+	node := doc.Call("createTextNode", text)
+	rng.Call("insertNode", node)
+	rng.Call("setStartAfter", node)
+	rng.Call("collapse", true)
+	sel.Call("removeAllRanges")
+	sel.Call("addRange", rng)
+}
+
+// caretOffset returns the caret position inside el as a text offset, or -1.
+func caretOffset(el js.Value) int {
+	sel := js.Global().Call("getSelection")
+	if !sel.Truthy() || sel.Get("rangeCount").Int() == 0 {
+		return -1
+	}
+	rng := sel.Call("getRangeAt", 0)
+	if !el.Call("contains", rng.Get("endContainer")).Bool() {
+		return -1
+	}
+	pre := rng.Call("cloneRange")
+	pre.Call("selectNodeContents", el)
+	pre.Call("setEnd", rng.Get("endContainer"), rng.Get("endOffset"))
+	return pre.Call("toString").Get("length").Int()
+}
+
+// setCaretOffset places a collapsed caret at the given text offset in el,
+// clamping to the end of the content.
+func setCaretOffset(el js.Value, offset int) {
+	doc := js.Global().Get("document")
+	const showText = 4 // NodeFilter.SHOW_TEXT
+	walker := doc.Call("createTreeWalker", el, showText)
+	rng := doc.Call("createRange")
+	placed := false
+	for node := walker.Call("nextNode"); node.Truthy(); node = walker.Call("nextNode") {
+		length := node.Get("length").Int()
+		if offset <= length {
+			rng.Call("setStart", node, offset)
+			placed = true
+			break
+		}
+		offset -= length
+	}
+	if !placed {
+		rng.Call("selectNodeContents", el)
+	}
+	rng.Call("collapse", placed)
+	sel := js.Global().Call("getSelection")
+	sel.Call("removeAllRanges")
+	sel.Call("addRange", rng)
+}
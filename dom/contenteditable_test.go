@@ -0,0 +1,100 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"syscall/js"
+	"testing"
+	"time"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// mountContentEditable renders a bound div into a container attached to body
+// and returns the editable element and a disposer.
+func mountContentEditable(t *testing.T, sig reactivity.Signal[string], opts CEOptions) (js.Value, func()) {
+	t.Helper()
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+
+	var buf bytes.Buffer
+	_ = g.El("div", BindContentEditable(sig, opts)).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+
+	el := container.Call("querySelector", "[data-uiwgo-contenteditable]")
+	return el, func() {
+		scope.Dispose()
+		container.Call("remove")
+	}
+}
+
+func TestBindContentEditableTwoWaySync(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	sig := reactivity.CreateSignal("<b>hello</b>")
+	el, dispose := mountContentEditable(t, sig, CEOptions{DebounceMs: 1})
+	defer dispose()
+
+	if got := el.Get("innerHTML").String(); got != "<b>hello</b>" {
+		t.Fatalf("Expected element to be initialized from signal, got %q", got)
+	}
+
+	sig.Set("<i>updated</i>")
+	if got := el.Get("innerHTML").String(); got != "<i>updated</i>" {
+		t.Fatalf("Expected element to follow signal, got %q", got)
+	}
+
+	// Simulate a user edit containing unsafe markup
+	el.Set("innerHTML", `typed <b onclick="alert(1)">bold</b>`)
+	el.Call("dispatchEvent", js.Global().Get("Event").New("input", map[string]any{"bubbles": true}))
+	time.Sleep(20 * time.Millisecond)
+
+	if got := sig.Get(); got != `typed <b>bold</b>` {
+		t.Errorf("Expected sanitized write-back, got %q", got)
+	}
+}
+
+func TestBindContentEditablePasteNormalizesPlainText(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	if got := normalizePlainText("a\r\nb\u00a0c\u200b"); got != "a\nb c" {
+		t.Errorf("normalizePlainText = %q, want %q", got, "a\nb c")
+	}
+	if js.Global().Get("DataTransfer").IsUndefined() || js.Global().Get("ClipboardEvent").IsUndefined() {
+		t.Skip("Skipping paste simulation: DataTransfer not available")
+	}
+
+	sig := reactivity.CreateSignal("")
+	el, dispose := mountContentEditable(t, sig, CEOptions{PlainText: true, DebounceMs: 1})
+	defer dispose()
+
+	data := js.Global().Get("DataTransfer").New()
+	data.Call("setData", "text/html", "<b>bold</b>")
+	data.Call("setData", "text/plain", "bold\r\ntext")
+	paste := js.Global().Get("ClipboardEvent").New("paste", map[string]any{
+		"clipboardData": data,
+		"bubbles":       true,
+		"cancelable":    true,
+	})
+	el.Call("dispatchEvent", paste)
+	time.Sleep(20 * time.Millisecond)
+
+	if el.Call("querySelector", "b").Truthy() {
+		t.Error("Expected pasted markup to be dropped in plain-text mode")
+	}
+	if got := sig.Get(); got != "bold\ntext" {
+		t.Errorf("Expected plain pasted text in signal, got %q", got)
+	}
+}
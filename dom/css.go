@@ -0,0 +1,47 @@
+//go:build js && wasm
+
+package dom
+
+import "syscall/js"
+
+// injectedStyles counts the live InjectCSS handles per style id.
+var injectedStyles = map[string]int{}
+
+// InjectCSS inserts a <style> tag with the given id into <head>. Calling it
+// again with the same id replaces the stylesheet's content instead of adding
+// a second tag. The returned function releases this call's handle; the tag
+// is removed once every handle for the id has been released.
+func InjectCSS(id string, css string) (remove func()) {
+	doc := js.Global().Get("document")
+	style := doc.Call("getElementById", id)
+	if !style.Truthy() {
+		style = doc.Call("createElement", "style")
+		style.Set("id", id)
+		style.Call("setAttribute", "data-uiwgo-style", "")
+		parent := doc.Get("head")
+		if !parent.Truthy() {
+			parent = doc.Get("body")
+		}
+		parent.Call("appendChild", style)
+	}
+	if style.Get("textContent").String() != css {
+		style.Set("textContent", css)
+	}
+	injectedStyles[id]++
+
+	released := false
+	return func() {
+		if released {
+			return
+		}
+		released = true
+		injectedStyles[id]--
+		if injectedStyles[id] > 0 {
+			return
+		}
+		delete(injectedStyles, id)
+		if el := doc.Call("getElementById", id); el.Truthy() {
+			el.Call("remove")
+		}
+	}
+}
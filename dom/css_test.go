@@ -0,0 +1,35 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+	"testing"
+)
+
+func TestInjectCSSDedupesAndRemoves(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	doc := js.Global().Get("document")
+	count := func() int { return doc.Call("querySelectorAll", "style#test-css").Length() }
+
+	removeFirst := InjectCSS("test-css", ".a { color: red; }")
+	removeSecond := InjectCSS("test-css", ".a { color: blue; }")
+	if count() != 1 {
+		t.Fatalf("expected a single style tag, got %d", count())
+	}
+	if got := doc.Call("getElementById", "test-css").Get("textContent").String(); got != ".a { color: blue; }" {
+		t.Errorf("expected repeated call to replace the content, got %q", got)
+	}
+
+	removeFirst()
+	removeFirst()
+	if count() != 1 {
+		t.Error("style should stay while another handle is live")
+	}
+	removeSecond()
+	if count() != 0 {
+		t.Error("style should be removed after the last handle is released")
+	}
+}
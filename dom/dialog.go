@@ -0,0 +1,216 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"context"
+	"syscall/js"
+)
+
+// ConfirmOptions configures Confirm.
+type ConfirmOptions struct {
+	Title       string
+	Message     string
+	ConfirmText string // defaults to "OK"
+	CancelText  string // defaults to "Cancel"
+}
+
+// PromptOptions configures Prompt.
+type PromptOptions struct {
+	Title        string
+	Message      string
+	ConfirmText  string // defaults to "OK"
+	CancelText   string // defaults to "Cancel"
+	Placeholder  string
+	DefaultValue string
+}
+
+// dialogSlot serializes dialogs: only one is on screen at a time and later
+// calls wait for it to close.
+var dialogSlot = make(chan struct{}, 1)
+
+// dialogResult is what the user chose in a dialog.
+type dialogResult struct {
+	confirmed bool
+	value     string
+}
+
+// dialogSpec describes the dialog built by showDialog.
+type dialogSpec struct {
+	title, message          string
+	confirmText, cancelText string
+	prompt                  bool
+	placeholder, value      string
+}
+
+// Confirm shows an in-app confirmation dialog and blocks until the user
+// confirms (true) or cancels (false) it. Dialogs opened while another one is
+// showing are queued. If ctx is cancelled first, the dialog is closed (or
+// never shown) and ctx.Err() is returned.
+//
+// Confirm blocks the calling goroutine, so call it from a goroutine rather
+// than directly inside an event handler.
+func Confirm(ctx context.Context, opts ConfirmOptions) (bool, error) {
+	res, err := showDialog(ctx, dialogSpec{
+		title:       opts.Title,
+		message:     opts.Message,
+		confirmText: opts.ConfirmText,
+		cancelText:  opts.CancelText,
+	})
+	return res.confirmed, err
+}
+
+// Prompt is like Confirm but includes a text input. It returns the entered
+// text and whether the user confirmed; Enter in the input confirms.
+func Prompt(ctx context.Context, opts PromptOptions) (string, bool, error) {
+	res, err := showDialog(ctx, dialogSpec{
+		title:       opts.Title,
+		message:     opts.Message,
+		confirmText: opts.ConfirmText,
+		cancelText:  opts.CancelText,
+		prompt:      true,
+		placeholder: opts.Placeholder,
+		value:       opts.DefaultValue,
+	})
+	if err != nil || !res.confirmed {
+		return "", false, err
+	}
+	return res.value, true, nil
+}
+
+// dialogOutlet returns the shared element dialogs are rendered into,
+// creating it at the end of <body> on first use.
+func dialogOutlet() js.Value {
+	doc := js.Global().Get("document")
+	outlet := doc.Call("querySelector", "[data-uiwgo-dialog-outlet]")
+	if outlet.Truthy() {
+		return outlet
+	}
+	outlet = doc.Call("createElement", "div")
+	outlet.Call("setAttribute", "data-uiwgo-dialog-outlet", "")
+	doc.Get("body").Call("appendChild", outlet)
+	return outlet
+}
+
+// showDialog waits for the dialog slot, renders the dialog and waits for the
+// user's choice or ctx cancellation.
+func showDialog(ctx context.Context, spec dialogSpec) (dialogResult, error) {
+	select {
+	case dialogSlot <- struct{}{}:
+	case <-ctx.Done():
+		return dialogResult{}, ctx.Err()
+	}
+	defer func() { <-dialogSlot }()
+
+	if spec.confirmText == "" {
+		spec.confirmText = "OK"
+	}
+	if spec.cancelText == "" {
+		spec.cancelText = "Cancel"
+	}
+
+	doc := js.Global().Get("document")
+	create := func(tag, attr, text string) js.Value {
+		el := doc.Call("createElement", tag)
+		if attr != "" {
+			el.Call("setAttribute", attr, "")
+		}
+		if text != "" {
+			el.Set("textContent", text)
+		}
+		return el
+	}
+
+	panel := create("div", "data-uiwgo-dialog", "")
+	panel.Call("setAttribute", "role", "dialog")
+	panel.Call("setAttribute", "aria-modal", "true")
+	if spec.title != "" {
+		title := create("h2", "data-uiwgo-dialog-title", spec.title)
+		title.Set("id", "uiwgo-dialog-title")
+		panel.Call("setAttribute", "aria-labelledby", "uiwgo-dialog-title")
+		panel.Call("appendChild", title)
+	}
+	if spec.message != "" {
+		panel.Call("appendChild", create("p", "data-uiwgo-dialog-message", spec.message))
+	}
+	var input js.Value
+	if spec.prompt {
+		input = create("input", "data-uiwgo-dialog-input", "")
+		input.Set("type", "text")
+		input.Set("value", spec.value)
+		if spec.placeholder != "" {
+			input.Set("placeholder", spec.placeholder)
+		}
+		panel.Call("appendChild", input)
+	}
+	cancelBtn := create("button", "data-uiwgo-dialog-cancel", spec.cancelText)
+	cancelBtn.Set("type", "button")
+	confirmBtn := create("button", "data-uiwgo-dialog-confirm", spec.confirmText)
+	confirmBtn.Set("type", "button")
+	panel.Call("appendChild", cancelBtn)
+	panel.Call("appendChild", confirmBtn)
+
+	results := make(chan dialogResult, 1)
+	resolve := func(confirmed bool) {
+		res := dialogResult{confirmed: confirmed}
+		if confirmed && spec.prompt {
+			res.value = input.Get("value").String()
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	}
+	onConfirm := js.FuncOf(func(this js.Value, args []js.Value) any {
+		resolve(true)
+		return nil
+	})
+	onCancel := js.FuncOf(func(this js.Value, args []js.Value) any {
+		resolve(false)
+		return nil
+	})
+	onKey := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		switch args[0].Get("key").String() {
+		case "Escape":
+			args[0].Call("preventDefault")
+			resolve(false)
+		case "Enter":
+			if spec.prompt && args[0].Get("target").Equal(input) {
+				args[0].Call("preventDefault")
+				resolve(true)
+			}
+		}
+		return nil
+	})
+	confirmBtn.Call("addEventListener", "click", onConfirm)
+	cancelBtn.Call("addEventListener", "click", onCancel)
+	panel.Call("addEventListener", "keydown", onKey)
+
+	previousFocus := doc.Get("activeElement")
+	dialogOutlet().Call("appendChild", panel)
+	if spec.prompt {
+		input.Call("focus")
+	} else {
+		confirmBtn.Call("focus")
+	}
+
+	defer func() {
+		panel.Call("remove")
+		onConfirm.Release()
+		onCancel.Release()
+		onKey.Release()
+		if previousFocus.Truthy() && previousFocus.Get("isConnected").Truthy() {
+			previousFocus.Call("focus")
+		}
+	}()
+
+	select {
+	case res := <-results:
+		return res, nil
+	case <-ctx.Done():
+		return dialogResult{}, ctx.Err()
+	}
+}
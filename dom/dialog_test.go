@@ -0,0 +1,158 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"context"
+	"errors"
+	"syscall/js"
+	"testing"
+	"time"
+)
+
+type confirmOutcome struct {
+	ok  bool
+	err error
+}
+
+func startConfirm(ctx context.Context, opts ConfirmOptions) <-chan confirmOutcome {
+	out := make(chan confirmOutcome, 1)
+	go func() {
+		ok, err := Confirm(ctx, opts)
+		out <- confirmOutcome{ok, err}
+	}()
+	return out
+}
+
+// waitForDialog polls until n dialogs are on screen and returns the first.
+func waitForDialog(t *testing.T, n int) js.Value {
+	t.Helper()
+	doc := js.Global().Get("document")
+	for i := 0; i < 100; i++ {
+		dialogs := doc.Call("querySelectorAll", "[data-uiwgo-dialog]")
+		if dialogs.Length() == n {
+			if n == 0 {
+				return js.Null()
+			}
+			return dialogs.Index(0)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("expected %d open dialogs", n)
+	return js.Null()
+}
+
+func receive(t *testing.T, out <-chan confirmOutcome) confirmOutcome {
+	t.Helper()
+	select {
+	case res := <-out:
+		return res
+	case <-time.After(time.Second):
+		t.Fatal("dialog did not resolve")
+		return confirmOutcome{}
+	}
+}
+
+func TestConfirmResolvesOnUserChoice(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	out := startConfirm(context.Background(), ConfirmOptions{Title: "Delete?", Message: "This cannot be undone", ConfirmText: "Delete"})
+	dialog := waitForDialog(t, 1)
+	if got := dialog.Call("querySelector", "[data-uiwgo-dialog-title]").Get("textContent").String(); got != "Delete?" {
+		t.Errorf("title = %q", got)
+	}
+	confirmBtn := dialog.Call("querySelector", "[data-uiwgo-dialog-confirm]")
+	if got := confirmBtn.Get("textContent").String(); got != "Delete" {
+		t.Errorf("confirm text = %q", got)
+	}
+	confirmBtn.Call("click")
+	if res := receive(t, out); !res.ok || res.err != nil {
+		t.Errorf("expected confirmation, got %+v", res)
+	}
+	waitForDialog(t, 0)
+
+	out = startConfirm(context.Background(), ConfirmOptions{Message: "Sure?"})
+	waitForDialog(t, 1).Call("querySelector", "[data-uiwgo-dialog-cancel]").Call("click")
+	if res := receive(t, out); res.ok || res.err != nil {
+		t.Errorf("expected cancel, got %+v", res)
+	}
+	waitForDialog(t, 0)
+}
+
+func TestConfirmContextCancellationClosesDialog(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := startConfirm(ctx, ConfirmOptions{Message: "Leave page?"})
+	waitForDialog(t, 1)
+	cancel()
+	if res := receive(t, out); !errors.Is(res.err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %+v", res)
+	}
+	waitForDialog(t, 0)
+}
+
+func TestConfirmQueuesConcurrentDialogs(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	first := startConfirm(context.Background(), ConfirmOptions{Message: "first"})
+	waitForDialog(t, 1)
+	second := startConfirm(context.Background(), ConfirmOptions{Message: "second"})
+	time.Sleep(20 * time.Millisecond)
+
+	dialog := waitForDialog(t, 1)
+	if got := dialog.Call("querySelector", "[data-uiwgo-dialog-message]").Get("textContent").String(); got != "first" {
+		t.Fatalf("expected the first dialog to show, got %q", got)
+	}
+	dialog.Call("querySelector", "[data-uiwgo-dialog-confirm]").Call("click")
+	receive(t, first)
+
+	dialog = waitForDialog(t, 1)
+	if got := dialog.Call("querySelector", "[data-uiwgo-dialog-message]").Get("textContent").String(); got != "second" {
+		t.Fatalf("expected the queued dialog to show next, got %q", got)
+	}
+	dialog.Call("querySelector", "[data-uiwgo-dialog-cancel]").Call("click")
+	if res := receive(t, second); res.ok {
+		t.Error("expected the second dialog to be cancelled")
+	}
+}
+
+func TestPromptReturnsEnteredText(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	type promptOutcome struct {
+		value string
+		ok    bool
+		err   error
+	}
+	out := make(chan promptOutcome, 1)
+	go func() {
+		value, ok, err := Prompt(context.Background(), PromptOptions{Title: "Rename", DefaultValue: "draft"})
+		out <- promptOutcome{value, ok, err}
+	}()
+
+	dialog := waitForDialog(t, 1)
+	input := dialog.Call("querySelector", "[data-uiwgo-dialog-input]")
+	if got := input.Get("value").String(); got != "draft" {
+		t.Errorf("default value = %q", got)
+	}
+	input.Set("value", "final")
+	input.Call("dispatchEvent", js.Global().Get("KeyboardEvent").New("keydown", map[string]any{"key": "Enter", "bubbles": true}))
+
+	select {
+	case res := <-out:
+		if res.value != "final" || !res.ok || res.err != nil {
+			t.Errorf("unexpected prompt result %+v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("prompt did not resolve")
+	}
+}
@@ -202,7 +202,7 @@ func (eb *ElementBuilder) BindReactiveText(textFn func() string) *ElementBuilder
 	reactivity.SetCurrentCleanupScope(eb.scope)
 	
 	// Create reactive effect - it will automatically register with the current scope
-	reactivity.CreateEffect(func() {
+	reactivity.CreateRenderEffect(func() {
 		eb.element.SetTextContent(textFn())
 	})
 	
@@ -222,7 +222,7 @@ func (eb *ElementBuilder) BindReactiveHTML(htmlFn func() string) *ElementBuilder
 	reactivity.SetCurrentCleanupScope(eb.scope)
 	
 	// Create reactive effect - it will automatically register with the current scope
-	reactivity.CreateEffect(func() {
+	reactivity.CreateRenderEffect(func() {
 		eb.element.SetInnerHTML(htmlFn())
 	})
 	
@@ -242,7 +242,7 @@ func (eb *ElementBuilder) BindReactiveAttribute(attrName string, valueFn func()
 	reactivity.SetCurrentCleanupScope(eb.scope)
 	
 	// Create reactive effect - it will automatically register with the current scope
-	reactivity.CreateEffect(func() {
+	reactivity.CreateRenderEffect(func() {
 		eb.element.SetAttribute(attrName, valueFn())
 	})
 	
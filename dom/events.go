@@ -6,6 +6,7 @@ import (
 	"sync"
 	"syscall/js"
 
+	"github.com/ozanturksever/logutil"
 	reactivity "github.com/ozanturksever/uiwgo/reactivity"
 	"honnef.co/go/js/dom/v2"
 )
@@ -81,6 +82,32 @@ func (em *EventManager) DisposeAll() {
 	em.bindings = em.bindings[:0]
 }
 
+// DisposeWithin disposes the managed bindings attached to root or to one of
+// its descendants, leaving bindings under other roots untouched.
+func (em *EventManager) DisposeWithin(root dom.Element) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	remaining := em.bindings[:0]
+	for _, binding := range em.bindings {
+		if bindingWithin(binding, root) {
+			binding.Dispose()
+			continue
+		}
+		remaining = append(remaining, binding)
+	}
+	em.bindings = remaining
+}
+
+// bindingWithin reports whether binding is attached to root or a descendant of it.
+func bindingWithin(binding *EventBinding, root dom.Element) bool {
+	if binding.element == nil || root == nil {
+		return false
+	}
+	el, r := binding.element.Underlying(), root.Underlying()
+	return el.Equal(r) || r.Call("contains", el).Bool()
+}
+
 // RemoveDisposed removes disposed bindings from the manager
 func (em *EventManager) RemoveDisposed() {
 	em.mu.Lock()
@@ -430,8 +457,40 @@ func BindClickToCallback(element dom.Element, callback func()) *EventBinding {
 	})
 }
 
-// BindInputToSignal binds an input event that updates a string signal with the input value
-func BindInputToSignal(element dom.Element, signal reactivity.Signal[string]) *EventBinding {
+// InputBindOption configures BindInputToSignal and OnInputInline.
+type InputBindOption func(*inputBindConfig)
+
+type inputBindConfig struct {
+	syncInitial bool
+}
+
+func newInputBindConfig(opts []InputBindOption) inputBindConfig {
+	cfg := inputBindConfig{syncInitial: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// SyncInitial controls whether binding adopts a value the browser put into
+// the element before it was bound, such as autofill or restored form state.
+// It is on by default; pass SyncInitial(false) to keep the signal's
+// programmatic initial value instead.
+func SyncInitial(enabled bool) InputBindOption {
+	return func(cfg *inputBindConfig) {
+		cfg.syncInitial = enabled
+	}
+}
+
+// BindInputToSignal binds an input event that updates a string signal with the input value.
+// Unless SyncInitial(false) is given, the element's current value is written
+// to the signal at bind time if the two differ.
+func BindInputToSignal(element dom.Element, signal reactivity.Signal[string], opts ...InputBindOption) *EventBinding {
+	if cfg := newInputBindConfig(opts); cfg.syncInitial && element != nil {
+		if value := element.Underlying().Get("value"); value.Type() == js.TypeString && value.String() != signal.Peek() {
+			signal.Set(value.String())
+		}
+	}
 	return BindInput(element, func(event dom.Event) {
 		if target := event.Target(); target != nil {
 			// Use the underlying JavaScript value to get the input value
@@ -474,9 +533,17 @@ func BindEscapeKeyToCallback(element dom.Element, callback func()) *EventBinding
 
 // Cleanup functions
 
-// CleanupAllEvents disposes all globally managed event bindings
-func CleanupAllEvents() {
-	GlobalEventManager.DisposeAll()
+// CleanupAllEvents disposes globally managed event bindings. With no roots it
+// disposes every binding; otherwise only bindings attached within the given
+// roots are disposed, so one mounted app can clean up without affecting others.
+func CleanupAllEvents(roots ...dom.Element) {
+	if len(roots) == 0 {
+		GlobalEventManager.DisposeAll()
+		return
+	}
+	for _, root := range roots {
+		GlobalEventManager.DisposeWithin(root)
+	}
 }
 
 // CleanupDisposedEvents removes disposed event bindings from the global manager
@@ -486,10 +553,45 @@ func CleanupDisposedEvents() {
 
 // Event delegation helpers
 
+// delegationRoots is the stack of default delegation roots pushed by WithRoot.
+var delegationRoots []dom.Element
+
+// WithRoot runs fn with root as the default delegation root. DelegateEvent
+// with a nil parent and AttachInlineDelegates with an undefined root attach to
+// it. comps.Mount uses this to scope delegation to the mount container.
+func WithRoot(root dom.Element, fn func()) {
+	delegationRoots = append(delegationRoots, root)
+	defer func() {
+		delegationRoots = delegationRoots[:len(delegationRoots)-1]
+	}()
+	fn()
+}
+
+// DelegationRoot returns the current default delegation root: the innermost
+// WithRoot root, falling back to document.body outside any mount.
+func DelegationRoot() dom.Element {
+	if n := len(delegationRoots); n > 0 && delegationRoots[n-1] != nil {
+		return delegationRoots[n-1]
+	}
+	return Document.Body()
+}
+
+// isBodyElement reports whether el is document.body.
+func isBodyElement(el dom.Element) bool {
+	body := js.Global().Get("document").Get("body")
+	return el != nil && body.Truthy() && el.Underlying().Equal(body)
+}
+
 // DelegateEvent attaches a delegated handler on parent for events bubbling from descendants
 // matching the CSS selector. Uses Element.closest() so selectors like
 // [data-action='toggle'] and complex selectors are supported.
+// A nil parent delegates from DelegationRoot(), i.e. the active mount container.
 func DelegateEvent(parent dom.Element, eventType string, selector string, handler func(e dom.Event, target dom.Element)) *EventBinding {
+	if parent == nil {
+		parent = DelegationRoot()
+	} else if isBodyElement(parent) && len(delegationRoots) > 0 {
+		logutil.Logf("DelegateEvent: %q on %s is delegated from document.body and is shared by all mounted apps; pass nil to scope it to the mount container", selector, eventType)
+	}
 	jsFunc := js.FuncOf(func(this js.Value, args []js.Value) any {
 		if len(args) == 0 {
 			return nil
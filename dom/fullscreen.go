@@ -0,0 +1,230 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+)
+
+var (
+	// ErrFullscreenUnsupported is returned by RequestFullscreen when the
+	// browser has no Fullscreen API.
+	ErrFullscreenUnsupported = errors.New("dom: fullscreen is not supported")
+	// ErrPointerLockUnsupported is returned by RequestPointerLock when the
+	// browser has no Pointer Lock API.
+	ErrPointerLockUnsupported = errors.New("dom: pointer lock is not supported")
+)
+
+// documentElementWatch is a signal holding a document property that names
+// an element, e.g. fullscreenElement, shared by every caller and updated
+// from one listener.
+type documentElementWatch struct {
+	prop   string
+	events []string
+	sig    reactivity.Signal[Element]
+	refs   int
+	stop   func()
+}
+
+var (
+	fullscreenWatch  = &documentElementWatch{prop: "fullscreenElement", events: []string{"fullscreenchange"}}
+	pointerLockWatch = &documentElementWatch{prop: "pointerLockElement", events: []string{"pointerlockchange"}}
+)
+
+// RequestFullscreen shows el fullscreen. It blocks until the browser has
+// granted or refused the request and returns the refusal as an error, e.g.
+// when it was not made in response to a user gesture. Call it from a
+// goroutine started in the event handler; the gesture still counts there.
+func RequestFullscreen(el Element) error {
+	target := el.Underlying()
+	if target.Get("requestFullscreen").Type() != js.TypeFunction {
+		return ErrFullscreenUnsupported
+	}
+	result := target.Call("requestFullscreen")
+	if !isPromise(result) {
+		return nil
+	}
+	if err := awaitPromise(result); err != nil {
+		return fmt.Errorf("dom: fullscreen request refused: %w", err)
+	}
+	return nil
+}
+
+// ExitFullscreen leaves fullscreen mode. It does nothing when no element is
+// fullscreen.
+func ExitFullscreen() {
+	doc := js.Global().Get("document")
+	if doc.Get("fullscreenElement").Truthy() && doc.Get("exitFullscreen").Type() == js.TypeFunction {
+		doc.Call("exitFullscreen")
+	}
+}
+
+// FullscreenElement returns a signal holding the element shown fullscreen,
+// or nil. The signal is shared; its fullscreenchange listener is removed
+// once the cleanup scopes of all callers have been disposed.
+func FullscreenElement() reactivity.Signal[Element] {
+	return fullscreenWatch.subscribe()
+}
+
+// RequestPointerLock locks the pointer to el. Like RequestFullscreen it
+// blocks until the browser has granted or refused the lock and needs a
+// user gesture, so call it from a goroutine started in the event handler.
+func RequestPointerLock(el Element) error {
+	target := el.Underlying()
+	if target.Get("requestPointerLock").Type() != js.TypeFunction {
+		return ErrPointerLockUnsupported
+	}
+
+	// Browsers without the promise form report the outcome with events
+	doc := js.Global().Get("document")
+	outcome := make(chan error, 1)
+	onChange := js.FuncOf(func(this js.Value, args []js.Value) any {
+		select {
+		case outcome <- nil:
+		default:
+		}
+		return nil
+	})
+	onError := js.FuncOf(func(this js.Value, args []js.Value) any {
+		select {
+		case outcome <- errors.New("pointerlockerror"):
+		default:
+		}
+		return nil
+	})
+	doc.Call("addEventListener", "pointerlockchange", onChange)
+	doc.Call("addEventListener", "pointerlockerror", onError)
+	defer func() {
+		doc.Call("removeEventListener", "pointerlockchange", onChange)
+		doc.Call("removeEventListener", "pointerlockerror", onError)
+		onChange.Release()
+		onError.Release()
+	}()
+
+	var err error
+	if result := target.Call("requestPointerLock"); isPromise(result) {
+		err = awaitPromise(result)
+	} else {
+		err = <-outcome
+	}
+	if err != nil {
+		return fmt.Errorf("dom: pointer lock request refused: %w", err)
+	}
+	return nil
+}
+
+// ExitPointerLock releases the pointer lock.
+func ExitPointerLock() {
+	doc := js.Global().Get("document")
+	if doc.Get("exitPointerLock").Type() == js.TypeFunction {
+		doc.Call("exitPointerLock")
+	}
+}
+
+// PointerLockElement returns a signal holding the element the pointer is
+// locked to, or nil. It is shared like FullscreenElement.
+func PointerLockElement() reactivity.Signal[Element] {
+	return pointerLockWatch.subscribe()
+}
+
+// subscribe returns the shared signal, attaching the listener for the first
+// caller and removing it when the last caller's cleanup scope is disposed.
+// Callers outside a cleanup scope keep the listener attached.
+func (w *documentElementWatch) subscribe() reactivity.Signal[Element] {
+	if w.sig == nil {
+		w.sig = reactivity.CreateSignal[Element](nil)
+	}
+	if w.refs == 0 {
+		doc := js.Global().Get("document")
+		sync := func() {
+			var el Element
+			if v := doc.Get(w.prop); v.Truthy() {
+				el = domv2.WrapElement(v)
+			}
+			if !sameElement(w.sig.Peek(), el) {
+				w.sig.Set(el)
+			}
+		}
+		listener := js.FuncOf(func(this js.Value, args []js.Value) any {
+			sync()
+			return nil
+		})
+		for _, event := range w.events {
+			doc.Call("addEventListener", event, listener)
+		}
+		sync()
+		w.stop = func() {
+			for _, event := range w.events {
+				doc.Call("removeEventListener", event, listener)
+			}
+			listener.Release()
+		}
+	}
+	w.refs++
+
+	released := false
+	reactivity.RegisterCleanup(func() {
+		if released {
+			return
+		}
+		released = true
+		w.refs--
+		if w.refs == 0 && w.stop != nil {
+			w.stop()
+			w.stop = nil
+		}
+	})
+	return w.sig
+}
+
+// isPromise reports whether v is a thenable.
+func isPromise(v js.Value) bool {
+	return v.Type() == js.TypeObject && v.Get("then").Type() == js.TypeFunction
+}
+
+// awaitPromise blocks until p settles and returns its rejection reason as an
+// error.
+func awaitPromise(p js.Value) error {
+	settled := make(chan error, 1)
+	onResolve := js.FuncOf(func(this js.Value, args []js.Value) any {
+		settled <- nil
+		return nil
+	})
+	onReject := js.FuncOf(func(this js.Value, args []js.Value) any {
+		reason := js.Undefined()
+		if len(args) > 0 {
+			reason = args[0]
+		}
+		settled <- promiseError(reason)
+		return nil
+	})
+	defer onResolve.Release()
+	defer onReject.Release()
+	p.Call("then", onResolve, onReject)
+	return <-settled
+}
+
+// promiseError describes a rejection reason, usually a DOMException.
+func promiseError(reason js.Value) error {
+	if reason.Type() == js.TypeObject && reason.Get("message").Type() == js.TypeString {
+		if name := reason.Get("name"); name.Type() == js.TypeString && name.String() != "" {
+			return fmt.Errorf("%s: %s", name.String(), reason.Get("message").String())
+		}
+		return errors.New(reason.Get("message").String())
+	}
+	return errors.New(js.Global().Call("String", reason).String())
+}
+
+// sameElement reports whether a and b wrap the same DOM element; wrapping
+// the same element twice gives different values.
+func sameElement(a, b Element) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Underlying().Equal(b.Underlying())
+}
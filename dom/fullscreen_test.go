@@ -0,0 +1,152 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"fmt"
+	"strings"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+)
+
+// stubDocumentProperty makes document[prop] return whatever *value holds.
+func stubDocumentProperty(t *testing.T, prop string, value *js.Value) {
+	doc := js.Global().Get("document")
+	getter := js.FuncOf(func(this js.Value, args []js.Value) any { return *value })
+	js.Global().Get("Object").Call("defineProperty", doc, prop, map[string]any{
+		"configurable": true,
+		"get":          getter,
+	})
+	t.Cleanup(func() {
+		js.Global().Get("Reflect").Call("deleteProperty", doc, prop)
+		getter.Release()
+	})
+}
+
+func newTestElement(t *testing.T) js.Value {
+	doc := js.Global().Get("document")
+	el := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", el)
+	t.Cleanup(func() { el.Call("remove") })
+	return el
+}
+
+func TestFullscreenElementFollowsEvents(t *testing.T) {
+	current := js.Null()
+	stubDocumentProperty(t, "fullscreenElement", &current)
+	target := newTestElement(t)
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	first := FullscreenElement()
+	second := FullscreenElement()
+	reactivity.SetCurrentCleanupScope(prev)
+
+	if first != second {
+		t.Fatal("FullscreenElement callers got different signals")
+	}
+	if first.Get() != nil {
+		t.Fatalf("initial fullscreen element = %v, want nil", first.Get())
+	}
+
+	var seen []bool
+	reactivity.CreateEffect(func() { seen = append(seen, first.Get() != nil) })
+
+	doc := js.Global().Get("document")
+	current = target
+	doc.Call("dispatchEvent", js.Global().Get("Event").New("fullscreenchange"))
+	if el := first.Get(); el == nil || !el.Underlying().Equal(target) {
+		t.Fatalf("fullscreen element after entering = %v, want the target", el)
+	}
+	// Repeated events for the same element do not notify again
+	doc.Call("dispatchEvent", js.Global().Get("Event").New("fullscreenchange"))
+	current = js.Null()
+	doc.Call("dispatchEvent", js.Global().Get("Event").New("fullscreenchange"))
+	if first.Get() != nil {
+		t.Fatal("fullscreen element after leaving is not nil")
+	}
+	if want := []bool{false, true, false}; fmt.Sprint(seen) != fmt.Sprint(want) {
+		t.Errorf("effect saw %v, want %v", seen, want)
+	}
+
+	// The listener goes away with the last subscriber
+	scope.Dispose()
+	current = target
+	doc.Call("dispatchEvent", js.Global().Get("Event").New("fullscreenchange"))
+	if first.Peek() != nil {
+		t.Error("signal still updated after every subscriber was disposed")
+	}
+}
+
+func TestRequestFullscreenRejected(t *testing.T) {
+	target := newTestElement(t)
+	reject := js.FuncOf(func(this js.Value, args []js.Value) any {
+		reason := js.Global().Get("DOMException").New("Permissions check failed", "NotAllowedError")
+		return js.Global().Get("Promise").Call("reject", reason)
+	})
+	defer reject.Release()
+	target.Set("requestFullscreen", reject)
+
+	err := RequestFullscreen(domv2.WrapElement(target))
+	if err == nil || !strings.Contains(err.Error(), "NotAllowedError") {
+		t.Fatalf("RequestFullscreen error = %v, want the NotAllowedError rejection", err)
+	}
+
+	resolve := js.FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Global().Get("Promise").Call("resolve")
+	})
+	defer resolve.Release()
+	target.Set("requestFullscreen", resolve)
+	if err := RequestFullscreen(domv2.WrapElement(target)); err != nil {
+		t.Errorf("RequestFullscreen error = %v for a granted request", err)
+	}
+}
+
+func TestPointerLockWithEvents(t *testing.T) {
+	current := js.Null()
+	stubDocumentProperty(t, "pointerLockElement", &current)
+	target := newTestElement(t)
+	scope := reactivity.NewCleanupScope(nil)
+	defer scope.Dispose()
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	locked := PointerLockElement()
+	reactivity.SetCurrentCleanupScope(prev)
+
+	doc := js.Global().Get("document")
+	// An older API without a promise: the outcome arrives as an event
+	grant := js.FuncOf(func(this js.Value, args []js.Value) any {
+		js.Global().Call("setTimeout", js.FuncOf(func(js.Value, []js.Value) any {
+			current = target
+			doc.Call("dispatchEvent", js.Global().Get("Event").New("pointerlockchange"))
+			return nil
+		}), 0)
+		return js.Undefined()
+	})
+	defer grant.Release()
+	target.Set("requestPointerLock", grant)
+
+	if err := RequestPointerLock(domv2.WrapElement(target)); err != nil {
+		t.Fatalf("RequestPointerLock error = %v", err)
+	}
+	if el := locked.Get(); el == nil || !el.Underlying().Equal(target) {
+		t.Fatalf("pointer lock element = %v, want the target", el)
+	}
+
+	refuse := js.FuncOf(func(this js.Value, args []js.Value) any {
+		js.Global().Call("setTimeout", js.FuncOf(func(js.Value, []js.Value) any {
+			doc.Call("dispatchEvent", js.Global().Get("Event").New("pointerlockerror"))
+			return nil
+		}), 0)
+		return js.Undefined()
+	})
+	defer refuse.Release()
+	target.Set("requestPointerLock", refuse)
+	if err := RequestPointerLock(domv2.WrapElement(target)); err == nil {
+		t.Error("RequestPointerLock returned no error after pointerlockerror")
+	}
+}
@@ -0,0 +1,173 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall/js"
+	"time"
+
+	"github.com/ozanturksever/logutil"
+)
+
+// HandlerProfileOptions configures EnableHandlerProfiling.
+type HandlerProfileOptions struct {
+	// Budget is how long a handler may run before it is logged as slow;
+	// zero uses DefaultHandlerBudget.
+	Budget time.Duration
+	// OnSlow, when set, is called after the warning for each run over the
+	// budget, e.g. to report slow handlers.
+	OnSlow func(marker, id, selector string, took time.Duration)
+}
+
+// DefaultHandlerBudget is the handler budget used when none is set: about
+// one frame at 60 Hz.
+const DefaultHandlerBudget = 16 * time.Millisecond
+
+// HandlerStat reports the execution times of an inline handler.
+type HandlerStat struct {
+	Marker string        // Marker attribute the handler is bound with, e.g. data-uiwgo-onclick
+	ID     string        // ID of the handler in the marker attribute
+	Count  int           // Number of runs recorded
+	P95    time.Duration // 95th percentile of the recent runs
+}
+
+// handlerSamples is how many recent run times are kept per handler for P95.
+const handlerSamples = 128
+
+type handlerTimes struct {
+	count   int
+	samples []time.Duration // ring of the last handlerSamples runs
+}
+
+var (
+	handlerProfiling bool
+	handlerBudget    time.Duration
+	handlerOnSlow    func(marker, id, selector string, took time.Duration)
+	handlerStats     = map[[2]string]*handlerTimes{}
+	handlerStatsMu   sync.Mutex
+)
+
+// EnableHandlerProfiling starts timing the click, input, change and keydown
+// handlers bound with the inline helpers (OnClickInline, ...). Runs longer
+// than the budget are logged with the selector path of their element.
+// Disabled, the dispatcher does not read the clock.
+func EnableHandlerProfiling(opts ...HandlerProfileOptions) {
+	handlerStatsMu.Lock()
+	defer handlerStatsMu.Unlock()
+	handlerBudget, handlerOnSlow = DefaultHandlerBudget, nil
+	if len(opts) > 0 {
+		if opts[0].Budget > 0 {
+			handlerBudget = opts[0].Budget
+		}
+		handlerOnSlow = opts[0].OnSlow
+	}
+	handlerProfiling = true
+}
+
+// DisableHandlerProfiling stops timing inline handlers. The stats recorded
+// so far are kept until ResetHandlerStats.
+func DisableHandlerProfiling() {
+	handlerStatsMu.Lock()
+	defer handlerStatsMu.Unlock()
+	handlerProfiling = false
+}
+
+// ResetHandlerStats drops the recorded handler stats.
+func ResetHandlerStats() {
+	handlerStatsMu.Lock()
+	defer handlerStatsMu.Unlock()
+	handlerStats = map[[2]string]*handlerTimes{}
+}
+
+// HandlerStats returns the stats of the handlers run while profiling was
+// enabled, slowest P95 first.
+func HandlerStats() []HandlerStat {
+	handlerStatsMu.Lock()
+	defer handlerStatsMu.Unlock()
+	stats := make([]HandlerStat, 0, len(handlerStats))
+	for key, times := range handlerStats {
+		sorted := append([]time.Duration(nil), times.samples...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		stats = append(stats, HandlerStat{
+			Marker: key[0],
+			ID:     key[1],
+			Count:  times.count,
+			P95:    sorted[(len(sorted)*95-1)/100],
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].P95 > stats[j].P95 })
+	return stats
+}
+
+// runProfiled runs fn, the handler id bound with marker on el, recording
+// its execution time while profiling is enabled.
+func runProfiled(marker, id string, el js.Value, fn func()) {
+	if !handlerProfiling {
+		fn()
+		return
+	}
+	start := time.Now()
+	defer func() { recordHandlerTime(marker, id, el, time.Since(start)) }()
+	fn()
+}
+
+func recordHandlerTime(marker, id string, el js.Value, d time.Duration) {
+	marker = strings.Trim(marker, "[]")
+	handlerStatsMu.Lock()
+	key := [2]string{marker, id}
+	times := handlerStats[key]
+	if times == nil {
+		times = &handlerTimes{}
+		handlerStats[key] = times
+	}
+	if len(times.samples) < handlerSamples {
+		times.samples = append(times.samples, d)
+	} else {
+		times.samples[times.count%handlerSamples] = d
+	}
+	times.count++
+	budget, onSlow := handlerBudget, handlerOnSlow
+	handlerStatsMu.Unlock()
+
+	if d > budget {
+		selector := selectorPath(el)
+		logutil.Logf("dom: slow %s handler %s took %v (budget %v) on %s", marker, id, d, budget, selector)
+		if onSlow != nil {
+			onSlow(marker, id, selector, d)
+		}
+	}
+}
+
+// selectorPath describes el as a CSS selector path from its nearest
+// ancestor with an id, e.g. "div#app > ul.items > li:nth-child(2) > button".
+func selectorPath(el js.Value) string {
+	var parts []string
+	for node := el; node.Truthy() && node.Get("nodeType").Int() == 1; node = node.Get("parentElement") {
+		part := strings.ToLower(node.Get("tagName").String())
+		if id := node.Get("id").String(); id != "" {
+			parts = append(parts, part+"#"+id)
+			break
+		}
+		if class := node.Call("getAttribute", "class"); class.Type() == js.TypeString {
+			if fields := strings.Fields(class.String()); len(fields) > 0 {
+				part += "." + strings.Join(fields, ".")
+			}
+		}
+		if parent := node.Get("parentElement"); parent.Truthy() && parent.Get("childElementCount").Int() > 1 {
+			index := 1
+			for sib := node.Get("previousElementSibling"); sib.Truthy(); sib = sib.Get("previousElementSibling") {
+				index++
+			}
+			part += ":nth-child(" + strconv.Itoa(index) + ")"
+		}
+		parts = append(parts, part)
+	}
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(parts, " > ")
+}
@@ -0,0 +1,76 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"strings"
+	"syscall/js"
+	"testing"
+	"time"
+
+	h "maragu.dev/gomponents/html"
+)
+
+func TestHandlerProfilingReportsSlowHandlers(t *testing.T) {
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	container.Set("id", "profile-root")
+	doc.Get("body").Call("appendChild", container)
+	defer container.Call("remove")
+
+	var buf bytes.Buffer
+	_ = h.Ul(h.Class("actions"),
+		h.Li(h.Button(h.Class("fast"), OnClickInline(func(Element) {}))),
+		h.Li(h.Button(h.Class("slow primary"), OnClickInline(func(Element) {
+			time.Sleep(30 * time.Millisecond)
+		}))),
+	).Render(&buf)
+	container.Set("innerHTML", buf.String())
+	AttachInlineDelegates(container)
+
+	// Disabled, nothing is recorded
+	ResetHandlerStats()
+	container.Call("querySelector", ".slow").Call("click")
+	if stats := HandlerStats(); len(stats) != 0 {
+		t.Fatalf("stats recorded while disabled: %+v", stats)
+	}
+
+	var slow []string
+	EnableHandlerProfiling(HandlerProfileOptions{
+		Budget: 10 * time.Millisecond,
+		OnSlow: func(marker, id, selector string, took time.Duration) {
+			slow = append(slow, selector)
+		},
+	})
+	defer DisableHandlerProfiling()
+	defer ResetHandlerStats()
+
+	container.Call("querySelector", ".slow").Call("click")
+	container.Call("querySelector", ".slow").Call("click")
+	container.Call("querySelector", ".fast").Call("click")
+
+	stats := HandlerStats()
+	if len(stats) != 2 {
+		t.Fatalf("HandlerStats() = %+v, want the two handlers", stats)
+	}
+	slowest := stats[0]
+	slowID := container.Call("querySelector", ".slow").Call("getAttribute", "data-uiwgo-onclick").String()
+	if slowest.Marker != "data-uiwgo-onclick" || slowest.ID != slowID || slowest.Count != 2 {
+		t.Errorf("slowest stat = %+v, want the slow click handler run twice", slowest)
+	}
+	if slowest.P95 < 30*time.Millisecond {
+		t.Errorf("slow handler P95 = %v, want at least 30ms", slowest.P95)
+	}
+	if stats[1].Count != 1 || stats[1].P95 >= 10*time.Millisecond {
+		t.Errorf("fast handler stat = %+v", stats[1])
+	}
+
+	want := "div#profile-root > ul.actions > li:nth-child(2) > button.slow.primary"
+	if len(slow) != 2 || slow[0] != want {
+		t.Errorf("slow handler warnings = %q, want two on %q", slow, want)
+	}
+	if strings.Contains(strings.Join(slow, ""), "fast") {
+		t.Error("the fast handler was reported as slow")
+	}
+}
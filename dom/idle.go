@@ -0,0 +1,135 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// idleFallbackBudget is the idle period assumed when requestIdleCallback is
+// unavailable and ScheduleIdle falls back to setTimeout.
+const idleFallbackBudget = 50 * time.Millisecond
+
+// ScheduleIdle runs fn once the browser is idle, using requestIdleCallback
+// or, where that is missing, setTimeout with a 50ms budget. deadline
+// returns the time left in the idle period; fn should stop and schedule the
+// rest of its work once it reaches zero. cancel prevents fn from running if
+// it has not started yet; it is also called when the current cleanup scope
+// is disposed.
+func ScheduleIdle(fn func(deadline func() time.Duration)) (cancel func()) {
+	global := js.Global()
+	ric := global.Get("requestIdleCallback")
+	var (
+		cb   js.Func
+		id   js.Value
+		done bool
+	)
+	release := func() {
+		if !done {
+			done = true
+			cb.Release()
+		}
+	}
+
+	if ric.Truthy() {
+		cb = js.FuncOf(func(this js.Value, args []js.Value) any {
+			release()
+			d := args[0]
+			fn(func() time.Duration {
+				return time.Duration(d.Call("timeRemaining").Float() * float64(time.Millisecond))
+			})
+			return nil
+		})
+		id = global.Call("requestIdleCallback", cb)
+	} else {
+		cb = js.FuncOf(func(this js.Value, args []js.Value) any {
+			release()
+			start := time.Now()
+			fn(func() time.Duration {
+				if left := idleFallbackBudget - time.Since(start); left > 0 {
+					return left
+				}
+				return 0
+			})
+			return nil
+		})
+		id = global.Call("setTimeout", cb, 1)
+	}
+
+	cancel = func() {
+		if done {
+			return
+		}
+		if ric.Truthy() {
+			global.Call("cancelIdleCallback", id)
+		} else {
+			global.Call("clearTimeout", id)
+		}
+		release()
+	}
+	reactivity.RegisterCleanup(cancel)
+	return cancel
+}
+
+// RunChunked calls fn for every item across idle periods. Items are taken
+// chunk at a time; at least one chunk runs per idle period and further
+// chunks only while the period's deadline has time left. onDone, when not
+// nil, runs after the last item. cancel stops the chunks that have not run
+// yet, and onDone with them; it is also called when the current cleanup
+// scope is disposed.
+func RunChunked[T any](items []T, chunk int, fn func(T), onDone func()) (cancel func()) {
+	if chunk < 1 {
+		chunk = 1
+	}
+	var (
+		next      int
+		cancelled bool
+		pending   func()
+	)
+	var step func(deadline func() time.Duration)
+	step = func(deadline func() time.Duration) {
+		pending = nil
+		for !cancelled && next < len(items) {
+			end := min(next+chunk, len(items))
+			for ; next < end; next++ {
+				fn(items[next])
+			}
+			if deadline() <= 0 {
+				break
+			}
+		}
+		if cancelled {
+			return
+		}
+		if next < len(items) {
+			pending = scheduleIdleUnowned(step)
+			return
+		}
+		if onDone != nil {
+			onDone()
+		}
+	}
+	pending = scheduleIdleUnowned(step)
+
+	cancel = func() {
+		cancelled = true
+		if pending != nil {
+			pending()
+			pending = nil
+		}
+	}
+	reactivity.RegisterCleanup(cancel)
+	return cancel
+}
+
+// scheduleIdleUnowned is ScheduleIdle without registering with the current
+// cleanup scope; RunChunked owns the cancellation of its periods.
+func scheduleIdleUnowned(fn func(deadline func() time.Duration)) func() {
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(nil)
+	defer reactivity.SetCurrentCleanupScope(prev)
+	return ScheduleIdle(fn)
+}
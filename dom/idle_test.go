@@ -0,0 +1,167 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// stubIdleCallback replaces requestIdleCallback with a fake that queues the
+// callbacks so the test decides when idle periods happen and how long they
+// are.
+type stubIdleCallback struct {
+	queued    map[int]js.Value
+	nextID    int
+	cancelled []int
+	request   js.Func
+	cancel    js.Func
+	original  js.Value
+	origCan   js.Value
+}
+
+func installStubIdleCallback(t *testing.T) *stubIdleCallback {
+	global := js.Global()
+	s := &stubIdleCallback{
+		queued:   map[int]js.Value{},
+		original: global.Get("requestIdleCallback"),
+		origCan:  global.Get("cancelIdleCallback"),
+	}
+	s.request = js.FuncOf(func(this js.Value, args []js.Value) any {
+		s.nextID++
+		s.queued[s.nextID] = args[0]
+		return s.nextID
+	})
+	s.cancel = js.FuncOf(func(this js.Value, args []js.Value) any {
+		id := args[0].Int()
+		s.cancelled = append(s.cancelled, id)
+		delete(s.queued, id)
+		return nil
+	})
+	global.Set("requestIdleCallback", s.request)
+	global.Set("cancelIdleCallback", s.cancel)
+	t.Cleanup(func() {
+		global.Set("requestIdleCallback", s.original)
+		global.Set("cancelIdleCallback", s.origCan)
+		s.request.Release()
+		s.cancel.Release()
+	})
+	return s
+}
+
+// runPeriod runs the queued callbacks as one idle period in which
+// timeRemaining reports time left for the first checks calls.
+func (s *stubIdleCallback) runPeriod(checks int) {
+	calls := 0
+	remaining := js.FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		if calls <= checks {
+			return 5
+		}
+		return 0
+	})
+	defer remaining.Release()
+	deadline := js.Global().Get("Object").New()
+	deadline.Set("timeRemaining", remaining)
+	queued := s.queued
+	s.queued = map[int]js.Value{}
+	for _, cb := range queued {
+		cb.Invoke(deadline)
+	}
+}
+
+func TestRunChunkedRespectsDeadline(t *testing.T) {
+	stub := installStubIdleCallback(t)
+
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	var seen []int
+	done := false
+	RunChunked(items, 2, func(i int) { seen = append(seen, i) }, func() { done = true })
+
+	if len(seen) != 0 || len(stub.queued) != 1 {
+		t.Fatalf("RunChunked worked before an idle period: seen %v, queued %d", seen, len(stub.queued))
+	}
+
+	// Time left after the first two chunks only: three chunks run
+	stub.runPeriod(2)
+	if len(seen) != 6 {
+		t.Fatalf("first period processed %d items, want 6", len(seen))
+	}
+	// An exhausted period still makes progress by one chunk
+	stub.runPeriod(0)
+	if len(seen) != 8 || done {
+		t.Fatalf("second period: seen %v, done %v; want 8 items, not done", seen, done)
+	}
+	stub.runPeriod(0)
+	if len(seen) != 10 || !done {
+		t.Fatalf("third period: seen %v, done %v; want all items and done", seen, done)
+	}
+	for i, v := range seen {
+		if v != i {
+			t.Fatalf("items processed out of order: %v", seen)
+		}
+	}
+	if len(stub.queued) != 0 {
+		t.Errorf("%d idle callbacks still queued after finishing", len(stub.queued))
+	}
+}
+
+func TestRunChunkedCancel(t *testing.T) {
+	stub := installStubIdleCallback(t)
+
+	processed := 0
+	done := false
+	cancel := RunChunked(make([]struct{}, 9), 3, func(struct{}) { processed++ }, func() { done = true })
+	stub.runPeriod(0)
+	if processed != 3 {
+		t.Fatalf("processed %d items in the first period, want 3", processed)
+	}
+
+	cancel()
+	if len(stub.cancelled) != 1 || len(stub.queued) != 0 {
+		t.Fatalf("cancel left the next period scheduled: cancelled %v, queued %d", stub.cancelled, len(stub.queued))
+	}
+	stub.runPeriod(5)
+	if processed != 3 || done {
+		t.Errorf("chunks ran after cancel: processed %d, done %v", processed, done)
+	}
+}
+
+func TestScheduleIdleCancelledWithScope(t *testing.T) {
+	stub := installStubIdleCallback(t)
+
+	ran := false
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	ScheduleIdle(func(func() time.Duration) { ran = true })
+	reactivity.SetCurrentCleanupScope(prev)
+
+	scope.Dispose()
+	stub.runPeriod(1)
+	if ran || len(stub.cancelled) != 1 {
+		t.Errorf("disposing the scope did not cancel the idle callback: ran %v, cancelled %v", ran, stub.cancelled)
+	}
+}
+
+func TestScheduleIdleFallsBackToTimeout(t *testing.T) {
+	global := js.Global()
+	original := global.Get("requestIdleCallback")
+	global.Set("requestIdleCallback", js.Undefined())
+	t.Cleanup(func() { global.Set("requestIdleCallback", original) })
+
+	left := make(chan time.Duration, 1)
+	ScheduleIdle(func(deadline func() time.Duration) { left <- deadline() })
+
+	select {
+	case d := <-left:
+		if d <= 0 || d > idleFallbackBudget {
+			t.Errorf("fallback deadline = %v, want within (0, %v]", d, idleFallbackBudget)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fallback never ran fn")
+	}
+}
@@ -0,0 +1,171 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"strings"
+	"syscall/js"
+)
+
+// inlineListener handles an event delegated to the root of an
+// AttachInlineDelegates call; this is the root.
+type inlineListener func(this js.Value, args []js.Value) any
+
+// inlineDelegates installs the delegated listeners of one
+// AttachInlineDelegates call. Each event type gets a single DOM listener
+// running the listeners of every inline helper using it, in the order they
+// were added. The marked ancestors of the event target are resolved once
+// per event, on the first lookup, for all of them: one closest() call per
+// marked ancestor instead of one closest() and getAttribute() per helper.
+type inlineDelegates struct {
+	root      js.Value
+	types     []string // event types in the order they were first added
+	listeners map[string][]inlineListener
+	markers   map[string][]string // attribute names looked up per event type
+	funcs     []js.Func
+	// chain holds the marked ancestors of the event being dispatched
+	chain *markerChain
+}
+
+// markerChain resolves the nearest ancestor, target included, carrying each
+// of a set of marker attributes.
+type markerChain struct {
+	target   js.Value
+	selector string // the markers as one selector list
+	markers  []string
+	found    map[string]markedElement // nil until resolved
+}
+
+type markedElement struct {
+	el    js.Value
+	value string // the marker attribute's value, the handler id
+}
+
+func newInlineDelegates(root js.Value) *inlineDelegates {
+	return &inlineDelegates{
+		root:      root,
+		listeners: map[string][]inlineListener{},
+		markers:   map[string][]string{},
+	}
+}
+
+// listen adds fn for eventType. marker is the selector, such as
+// "[data-uiwgo-onclick]", fn passes to closest, or empty when it finds its
+// elements otherwise.
+func (d *inlineDelegates) listen(eventType, marker string, fn inlineListener) {
+	if _, ok := d.listeners[eventType]; !ok {
+		d.types = append(d.types, eventType)
+	}
+	d.listeners[eventType] = append(d.listeners[eventType], fn)
+	if name := strings.Trim(marker, "[]"); name != "" && !containsName(d.markers[eventType], name) {
+		d.markers[eventType] = append(d.markers[eventType], name)
+	}
+}
+
+// start adds one DOM listener per event type to the root.
+func (d *inlineDelegates) start() {
+	for _, eventType := range d.types {
+		listeners := d.listeners[eventType]
+		markers := d.markers[eventType]
+		selector := ""
+		if len(markers) > 0 {
+			selector = "[" + strings.Join(markers, "],[") + "]"
+		}
+		fn := js.FuncOf(func(this js.Value, args []js.Value) any {
+			// Dispatching may run again inside a handler, e.g. for el.click()
+			outer := d.chain
+			defer func() { d.chain = outer }()
+			d.chain = nil
+			if selector != "" && len(args) > 0 {
+				if target := args[0].Get("target"); target.Truthy() {
+					d.chain = &markerChain{target: target, selector: selector, markers: markers}
+				}
+			}
+			for _, listener := range listeners {
+				listener(this, args)
+			}
+			return nil
+		})
+		d.funcs = append(d.funcs, fn)
+		d.root.Call("addEventListener", eventType, fn)
+	}
+}
+
+// stop removes the DOM listeners added by start.
+func (d *inlineDelegates) stop() {
+	for i, fn := range d.funcs {
+		d.root.Call("removeEventListener", d.types[i], fn)
+		fn.Release()
+	}
+	d.funcs = nil
+}
+
+// closest returns target.closest(marker), from the chain resolved for the
+// event being dispatched when target is its target.
+func (d *inlineDelegates) closest(target js.Value, marker string) js.Value {
+	if c := d.chain; c != nil && c.target.Equal(target) {
+		if name := strings.Trim(marker, "[]"); containsName(c.markers, name) {
+			if found, ok := c.lookup(name); ok {
+				return found.el
+			}
+			return js.Null()
+		}
+	}
+	return target.Call("closest", marker)
+}
+
+// attr returns the value of the marker attribute name of el, an element
+// closest returned.
+func (d *inlineDelegates) attr(el js.Value, name string) string {
+	if c := d.chain; c != nil {
+		if found, ok := c.lookup(name); ok && found.el.Equal(el) {
+			return found.value
+		}
+	}
+	return el.Call("getAttribute", name).String()
+}
+
+// lookup returns the nearest element carrying the marker attribute name,
+// resolving the chain on first use. ok is false when there is none.
+func (c *markerChain) lookup(name string) (found markedElement, ok bool) {
+	if c.found == nil {
+		c.resolve()
+	}
+	found, ok = c.found[name]
+	return found, ok
+}
+
+// resolve walks the marked ancestors of the target, nearest first, until
+// each marker has been found or the document is left.
+func (c *markerChain) resolve() {
+	c.found = make(map[string]markedElement, len(c.markers))
+	if c.target.Get("closest").Type() != js.TypeFunction {
+		return
+	}
+	for el := c.target.Call("closest", c.selector); el.Truthy(); {
+		names := " " + el.Call("getAttributeNames").Call("join", " ").String() + " "
+		for _, name := range c.markers {
+			if _, done := c.found[name]; done || !strings.Contains(names, " "+name+" ") {
+				continue
+			}
+			c.found[name] = markedElement{el: el, value: el.Call("getAttribute", name).String()}
+		}
+		if len(c.found) == len(c.markers) {
+			return
+		}
+		parent := el.Get("parentElement")
+		if !parent.Truthy() {
+			return
+		}
+		el = parent.Call("closest", c.selector)
+	}
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
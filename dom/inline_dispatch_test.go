@@ -0,0 +1,273 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall/js"
+	"testing"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// mountDelegated renders node into a new element of the document and
+// delegates its inline handlers until the returned function is called.
+// listeners counts the listeners added to and removed from the root per
+// event type.
+func mountDelegated(t testing.TB, node g.Node) (root js.Value, listeners map[string]int, dispose func()) {
+	doc := js.Global().Get("document")
+	root = doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", root)
+	var buf bytes.Buffer
+	_ = node.Render(&buf)
+	root.Set("innerHTML", buf.String())
+
+	listeners = map[string]int{}
+	spy := func(method string, delta int) js.Func {
+		original := js.Global().Get("EventTarget").Get("prototype").Get(method)
+		return js.FuncOf(func(this js.Value, args []js.Value) any {
+			listeners[args[0].String()] += delta
+			return original.Call("apply", this, js.ValueOf([]any{args[0], args[1]}))
+		})
+	}
+	add, remove := spy("addEventListener", 1), spy("removeEventListener", -1)
+	root.Set("addEventListener", add)
+	root.Set("removeEventListener", remove)
+
+	// The delegates are removed when the effect they are attached in is
+	// disposed
+	effect := reactivity.CreateEffect(func() { AttachInlineDelegates(root) })
+
+	disposed := false
+	return root, listeners, func() {
+		if disposed {
+			return
+		}
+		disposed = true
+		effect.Dispose()
+		root.Call("remove")
+		add.Release()
+		remove.Release()
+	}
+}
+
+func dispatch(el js.Value, eventType string, init map[string]any) {
+	init["bubbles"] = true
+	init["cancelable"] = true
+	ctor := "Event"
+	if _, ok := init["key"]; ok {
+		ctor = "KeyboardEvent"
+	}
+	el.Call("dispatchEvent", js.Global().Get(ctor).New(eventType, init))
+}
+
+func TestInlineDelegatesShareOneListenerPerEventType(t *testing.T) {
+	fired := map[string]int{}
+	on := func(name string) func(Element) {
+		return func(Element) { fired[name]++ }
+	}
+	root, listeners, dispose := mountDelegated(t, h.Div(
+		h.Form(
+			h.Class("form"),
+			OnSubmitInline(func(Element, map[string]string) { fired["submit"]++ }),
+			OnFormResetInline(on("reset")),
+			h.Div(
+				h.Class("panel"),
+				OnClickOnceInline(on("clickonce")),
+				OnEscapeCloseInline(on("escapeclose")),
+				h.Input(
+					h.Class("field"),
+					OnInputInline(on("input")),
+					OnChangeInline(on("change")),
+					OnKeyDownInline(on("keydown"), ""),
+					OnEnterInline(on("enter")),
+					OnEscapeInline(on("escape")),
+					OnTabInline(on("tab")),
+					OnArrowKeysInline(func(Element, string) { fired["arrow"]++ }),
+				),
+				h.Button(h.Class("save"), OnClickInline(on("click")), h.Span(h.Class("label"), g.Text("Save"))),
+			),
+		),
+		h.Div(h.Class("outside"), OnOutsideClickInline(on("outside"))),
+	))
+	defer dispose()
+
+	var types []string
+	for eventType, n := range listeners {
+		if n != 1 {
+			t.Errorf("%d %s listeners on the root, want 1", n, eventType)
+		}
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	if got := strings.Join(types, " "); !strings.Contains(got, "click") || !strings.Contains(got, "keydown") {
+		t.Fatalf("listeners installed for %q", got)
+	}
+
+	field := root.Call("querySelector", ".field")
+	dispatch(root.Call("querySelector", ".label"), "click", map[string]any{})
+	dispatch(root.Call("querySelector", ".label"), "click", map[string]any{})
+	dispatch(field, "input", map[string]any{})
+	dispatch(field, "change", map[string]any{})
+	for _, key := range []string{"a", "Enter", "Escape", "Tab", "ArrowUp"} {
+		dispatch(field, "keydown", map[string]any{"key": key})
+	}
+	dispatch(root.Call("querySelector", ".form"), "submit", map[string]any{})
+	dispatch(root.Call("querySelector", ".form"), "reset", map[string]any{})
+
+	want := map[string]int{
+		"click": 2, "clickonce": 1, "outside": 2, "input": 1, "change": 1,
+		"keydown": 5, "enter": 1, "escape": 1, "tab": 1, "arrow": 1,
+		"escapeclose": 1, "submit": 1, "reset": 1,
+	}
+	for name, n := range want {
+		if fired[name] != n {
+			t.Errorf("%s handler fired %d times, want %d", name, fired[name], n)
+		}
+	}
+
+	dispose()
+	for eventType, n := range listeners {
+		if n != 0 {
+			t.Errorf("%d %s listeners left on the root after dispose", n, eventType)
+		}
+	}
+}
+
+func TestInlineDelegatesResolveNestedMarkers(t *testing.T) {
+	var got []string
+	record := func(name string) func(Element) {
+		return func(el Element) { got = append(got, name+":"+el.GetAttribute("class")) }
+	}
+	root, _, dispose := mountDelegated(t, h.Ul(
+		h.Li(
+			h.Class("row"),
+			OnClickOnceInline(record("once")),
+			h.Button(h.Class("remove"), OnClickInline(record("click")), h.Span(h.Class("icon"))),
+			h.Button(h.Class("edit"), h.Span(h.Class("icon"))),
+		),
+	))
+	defer dispose()
+
+	dispatch(root.Call("querySelector", ".remove .icon"), "click", map[string]any{})
+	dispatch(root.Call("querySelector", ".edit .icon"), "click", map[string]any{})
+	want := "click:remove once:row"
+	if strings.Join(got, " ") != want {
+		t.Errorf("handlers ran as %q, want %q", strings.Join(got, " "), want)
+	}
+}
+
+// benchmarkMarkup nests a target under elements carrying the markers of 15
+// delegated handler types.
+func benchmarkMarkup() g.Node {
+	noop := func(Element) {}
+	return h.Form(
+		OnSubmitInline(func(Element, map[string]string) {}),
+		OnFormResetInline(noop),
+		OnFormChangeInline(func(Element, map[string]string) {}),
+		h.Div(
+			OnClickOnceInline(noop),
+			OnOutsideClickInline(noop),
+			OnFocusWithinInline(func(Element, bool) {}),
+			OnDragStartInline(func(Element, js.Value) {}),
+			OnDropInline(func(Element, js.Value) {}),
+			h.Div(
+				OnEscapeCloseInline(noop),
+				OnArrowKeysInline(func(Element, string) {}),
+				h.Input(OnInputInline(noop), OnChangeInline(noop), OnKeyDownInline(noop, "x"), OnTabInline(noop)),
+				h.Button(OnClickInline(noop), h.Span(h.Class("target"), g.Text("Go"))),
+			),
+		),
+	)
+}
+
+// BenchmarkInlineClickDispatch measures a click delegated to the handlers
+// of benchmarkMarkup.
+func BenchmarkInlineClickDispatch(b *testing.B) {
+	root, _, dispose := mountDelegated(b, benchmarkMarkup())
+	defer dispose()
+	target := root.Call("querySelector", ".target")
+	event := js.Global().Get("MouseEvent")
+	init := map[string]any{"bubbles": true}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		target.Call("dispatchEvent", event.New("click", init))
+	}
+}
+
+// benchmarkMarkers are the markers of 15 handler types listening to the
+// same event type, two of which are on ancestors of the clicked element.
+var benchmarkMarkers = func() []string {
+	markers := []string{"data-bench-row", "data-bench-button"}
+	for i := 0; i < 13; i++ {
+		markers = append(markers, "data-bench-unused-"+strconv.Itoa(i))
+	}
+	return markers
+}()
+
+// benchmarkDispatch clicks an element under the benchmarkMarkers, with one
+// listener per marker or with the listeners shared through inlineDelegates.
+// Each listener looks up its marked ancestor and handler id.
+func benchmarkDispatch(b *testing.B, shared bool) {
+	doc := js.Global().Get("document")
+	root := doc.Call("createElement", "div")
+	root.Set("innerHTML", `<ul><li data-bench-row="r1"><button data-bench-button="b1"><span class="target">Go</span></button></li></ul>`)
+	doc.Get("body").Call("appendChild", root)
+	defer root.Call("remove")
+
+	lookup := func(target js.Value, marker string) {
+		if matched := target.Call("closest", marker); matched.Truthy() {
+			_ = matched.Call("getAttribute", strings.Trim(marker, "[]")).String()
+		}
+	}
+	if shared {
+		d := newInlineDelegates(root)
+		for _, name := range benchmarkMarkers {
+			marker := "[" + name + "]"
+			d.listen("click", marker, func(this js.Value, args []js.Value) any {
+				if matched := d.closest(args[0].Get("target"), marker); matched.Truthy() {
+					_ = d.attr(matched, name)
+				}
+				return nil
+			})
+		}
+		d.start()
+		defer d.stop()
+	} else {
+		for _, name := range benchmarkMarkers {
+			marker := "[" + name + "]"
+			fn := js.FuncOf(func(this js.Value, args []js.Value) any {
+				lookup(args[0].Get("target"), marker)
+				return nil
+			})
+			root.Call("addEventListener", "click", fn)
+			defer func() {
+				root.Call("removeEventListener", "click", fn)
+				fn.Release()
+			}()
+		}
+	}
+
+	target := root.Call("querySelector", ".target")
+	event := js.Global().Get("MouseEvent")
+	init := map[string]any{"bubbles": true}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		target.Call("dispatchEvent", event.New("click", init))
+	}
+}
+
+// BenchmarkDelegatesListenerPerHandler is how clicks were delegated before
+// handler types shared a listener.
+func BenchmarkDelegatesListenerPerHandler(b *testing.B) {
+	benchmarkDispatch(b, false)
+}
+
+func BenchmarkDelegatesSharedListener(b *testing.B) {
+	benchmarkDispatch(b, true)
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"syscall/js"
+	"unicode/utf8"
 
 	"github.com/ozanturksever/logutil"
 	reactivity "github.com/ozanturksever/uiwgo/reactivity"
@@ -23,9 +24,12 @@ var (
 	inlineClickHandlers          = map[string]func(Element){}
 	inlineClickOnceHandlers      = map[string]func(Element){}
 	inlineInputHandlers          = map[string]func(Element){}
+	inlineInputSkipSync          = map[string]bool{} // ids bound with SyncInitial(false)
 	inlineChangeHandlers         = map[string]func(Element){}
 	inlineKeydownHandlers        = map[string]func(Element){}
-	inlineKeyExpectations        = map[string]string{} // id -> expected key (optional)
+	inlineKeyExpectations        = map[string]string{}                        // id -> expected key (optional)
+	inlineEnterBeforeInput       = map[string]bool{}                          // OnEnterInline ids bound with EnterOptions{BeforeInput: true}
+	inlineBeforeInputHandlers    = map[string]func(Element, string, string){} // element, inputType, data
 	inlineSubmitHandlers         = map[string]func(Element, map[string]string){}
 	inlineFormResetHandlers      = map[string]func(Element){}
 	inlineFormChangeHandlers     = map[string]func(Element, map[string]string){}
@@ -36,6 +40,8 @@ var (
 	inlineBlurValidateHandlers   = map[string]func(Element, string) bool{}
 	inlineDebouncedInputHandlers = map[string]func(Element){}
 	inlineSearchHandlers         = map[string]func(Element, string){}
+	inlineSearchOptions          = map[string]SearchOptions{}
+	pendingSearches              = map[string]pendingSearch{}
 	inlineDebounceTimers         = map[string]js.Value{} // stores setTimeout IDs
 	inlineTabHandlers            = map[string]func(Element){}
 	inlineShiftTabHandlers       = map[string]func(Element){}
@@ -69,15 +75,51 @@ func OnClickInline(handler func(el Element)) g.Node {
 	return g.Attr("data-uiwgo-onclick", id)
 }
 
-// OnInputInline attaches an inline input handler (input event)
-func OnInputInline(handler func(el Element)) g.Node {
+// OnInputInline attaches an inline input handler (input event).
+// Unless SyncInitial(false) is given, the handler also runs once at attach
+// time when the element's value no longer matches the value it was rendered
+// with, so state filled in by the browser before binding is not lost.
+func OnInputInline(handler func(el Element), opts ...InputBindOption) g.Node {
 	id := nextInlineID("inp")
+	cfg := newInputBindConfig(opts)
 	inlineHandlersMu.Lock()
 	inlineInputHandlers[id] = handler
+	if !cfg.syncInitial {
+		inlineInputSkipSync[id] = true
+	}
 	inlineHandlersMu.Unlock()
 	return g.Attr("data-uiwgo-oninput", id)
 }
 
+// syncInlineInputs runs the inline input handler of every element under root
+// whose value differs from its rendered default value, e.g. after browser
+// autofill or form restoration.
+func syncInlineInputs(root js.Value) {
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-oninput]")
+	for i := 0; i < nodes.Get("length").Int(); i++ {
+		el := nodes.Call("item", i)
+		value, defaultValue := el.Get("value"), el.Get("defaultValue")
+		if value.Type() != js.TypeString || defaultValue.Type() != js.TypeString || value.String() == defaultValue.String() {
+			continue
+		}
+		id := el.Call("getAttribute", "data-uiwgo-oninput").String()
+		inlineHandlersMu.RLock()
+		h, skip := inlineInputHandlers[id], inlineInputSkipSync[id]
+		inlineHandlersMu.RUnlock()
+		if h == nil || skip {
+			continue
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logutil.Logf("panic in inline input sync: %v", r)
+				}
+			}()
+			h(domv2.WrapElement(el))
+		}()
+	}
+}
+
 // OnChangeInline attaches an inline change handler (change event)
 func OnChangeInline(handler func(el Element)) g.Node {
 	id := nextInlineID("chg")
@@ -99,16 +141,59 @@ func OnKeyDownInline(handler func(el Element), expectedKey string) g.Node {
 	return g.Attr("data-uiwgo-onkeydown", id)
 }
 
+// EnterOptions configures OnEnterInline.
+type EnterOptions struct {
+	// BeforeInput also treats insertLineBreak and insertParagraph
+	// beforeinput events as Enter, for mobile keyboards that report the key
+	// as "Unidentified". When the keydown did report Enter the handler still
+	// runs once. It has no effect where SupportsBeforeInput is false.
+	BeforeInput bool
+}
+
 // OnEnterInline convenience for keydown Enter; uses dedicated marker so multiple key handlers can coexist
-func OnEnterInline(handler func(el Element)) g.Node {
+func OnEnterInline(handler func(el Element), opts ...EnterOptions) g.Node {
 	id := nextInlineID("ent")
 	inlineHandlersMu.Lock()
 	inlineKeydownHandlers[id] = handler
 	inlineKeyExpectations[id] = "Enter"
+	if len(opts) > 0 && opts[0].BeforeInput {
+		inlineEnterBeforeInput[id] = true
+	}
 	inlineHandlersMu.Unlock()
 	return g.Attr("data-uiwgo-onenter", id)
 }
 
+// OnBeforeInputInline attaches an inline beforeinput handler. It receives
+// the event's inputType (e.g. "insertText", "deleteContentBackward") and
+// data, which is "" when the event carries none.
+func OnBeforeInputInline(handler func(el Element, inputType, data string)) g.Node {
+	id := nextInlineID("bin")
+	inlineHandlersMu.Lock()
+	inlineBeforeInputHandlers[id] = handler
+	inlineHandlersMu.Unlock()
+	return g.Attr("data-uiwgo-onbeforeinput", id)
+}
+
+// SupportsBeforeInput reports whether the browser fires cancelable
+// beforeinput events with an inputType.
+func SupportsBeforeInput() bool {
+	ctor := js.Global().Get("InputEvent")
+	if !ctor.Truthy() {
+		return false
+	}
+	return ctor.Get("prototype").Get("getTargetRanges").Type() == js.TypeFunction
+}
+
+// enterKeyProp marks an OnEnterInline element whose current key press
+// reported Enter, so its beforeinput does not fire the handler again.
+const enterKeyProp = "__uiwgoEnterKey"
+
+// isEnterInputType reports whether a beforeinput inputType is what pressing
+// Enter produces.
+func isEnterInputType(inputType string) bool {
+	return inputType == "insertLineBreak" || inputType == "insertParagraph"
+}
+
 // OnEscapeInline convenience for keydown Escape; uses dedicated marker
 func OnEscapeInline(handler func(el Element)) g.Node {
 	id := nextInlineID("esc")
@@ -267,11 +352,30 @@ func OnInputDebouncedInline(handler func(el Element), delayMs int) g.Node {
 	})
 }
 
-// OnSearchInline creates a search input handler with debouncing and query extraction
-func OnSearchInline(handler func(el Element, query string), delayMs int) g.Node {
+// SearchOptions configures OnSearchInline.
+type SearchOptions struct {
+	// MinChars is the shortest query the handler is called with. Shorter,
+	// non-empty queries cancel any pending call and are otherwise ignored,
+	// unless ClearBelowMinChars is set.
+	MinChars int
+	// ClearBelowMinChars calls the handler with an empty query right away
+	// when the query is shorter than MinChars.
+	ClearBelowMinChars bool
+	// FlushOnClear calls the handler right away, without waiting for the
+	// debounce, when the field is emptied.
+	FlushOnClear bool
+}
+
+// OnSearchInline creates a search input handler with debouncing and query extraction.
+// A pending call is dropped when the input is removed from the document
+// first; CancelSearchInline drops it explicitly.
+func OnSearchInline(handler func(el Element, query string), delayMs int, opts ...SearchOptions) g.Node {
 	id := nextInlineID("search")
 	inlineHandlersMu.Lock()
 	inlineSearchHandlers[id] = handler
+	if len(opts) > 0 {
+		inlineSearchOptions[id] = opts[0]
+	}
 	inlineHandlersMu.Unlock()
 	return g.Group([]g.Node{
 		g.Attr("data-inline-search", id),
@@ -463,15 +567,69 @@ func serializeFormData(formEl Element) map[string]string {
 	return formData
 }
 
+// claimInlineEvent reports whether the delegated listener handling marker
+// may act on event. A subtree can be delegated more than once (a For row and
+// the container it is mounted in), so the first listener the event bubbles
+// through claims it and the listeners above it leave it alone.
+func claimInlineEvent(event js.Value, marker string) bool {
+	key := "__uiwgo" + marker
+	if event.Get(key).Truthy() {
+		return false
+	}
+	event.Set(key, true)
+	return true
+}
+
+// pendingSearch is a debounced OnSearchInline call waiting for its timer.
+type pendingSearch struct {
+	timer js.Value
+	fire  js.Func
+}
+
+// cancelSearchTimer drops the pending OnSearchInline call for id, if any.
+func cancelSearchTimer(id string) {
+	inlineHandlersMu.Lock()
+	pending, ok := pendingSearches[id]
+	delete(pendingSearches, id)
+	inlineHandlersMu.Unlock()
+	if ok {
+		js.Global().Call("clearTimeout", pending.timer)
+		pending.fire.Release()
+	}
+}
+
+// CancelSearchInline drops the pending debounced call of the OnSearchInline
+// handler on el, e.g. right before el is removed.
+func CancelSearchInline(el Element) {
+	if el == nil {
+		return
+	}
+	if id := el.Underlying().Call("getAttribute", "data-inline-search"); id.Type() == js.TypeString {
+		cancelSearchTimer(id.String())
+	}
+}
+
 // AttachInlineDelegates scans under the provided root and installs delegated listeners
-// for supported inline events. It registers cleanup with the current reactivity scope.
+// for supported inline events, one per DOM event type shared by the inline helpers
+// using it. It registers cleanup with the current reactivity scope.
+// An undefined or null root falls back to DelegationRoot(). Delegating a subtree that
+// is already covered is harmless: each handler runs once per event.
 func AttachInlineDelegates(root js.Value) {
+	if root.IsUndefined() || root.IsNull() {
+		root = DelegationRoot().Underlying()
+	}
+	attachContentEditablesIn(root)
+	attachSelectClicksIn(root)
+	attachTooltipsIn(root)
+	attachVisibleIn(root)
+	attachAriaIn(root)
+	delegates := newInlineDelegates(root)
 	// Helper to install a delegated listener with marker and registry handlers
-	install := func(eventType, marker string, lookup func(id string) (func(Element), bool), collectIds func() []string) (installed bool, fn js.Func, ids []string) {
+	install := func(eventType, marker string, lookup func(id string) (func(Element), bool), collectIds func() []string) (installed bool, ids []string) {
 		// Check if any markers exist under root
 		nodes := root.Call("querySelectorAll", marker)
 		if !nodes.Truthy() || nodes.Get("length").Int() == 0 {
-			return false, js.Func{}, nil
+			return false, nil
 		}
 
 		// Collect ids for cleanup scoping
@@ -480,7 +638,7 @@ func AttachInlineDelegates(root js.Value) {
 			// still install, dynamic elements might appear later
 		}
 
-		fn = js.FuncOf(func(this js.Value, args []js.Value) any {
+		fn := func(this js.Value, args []js.Value) any {
 			if len(args) == 0 {
 				return nil
 			}
@@ -489,12 +647,15 @@ func AttachInlineDelegates(root js.Value) {
 			if target.IsUndefined() || target.IsNull() {
 				return nil
 			}
-			matched := target.Call("closest", marker)
+			matched := delegates.closest(target, marker)
 			if matched.IsUndefined() || matched.IsNull() {
 				return nil
 			}
+			if !claimInlineEvent(rawEvent, marker) {
+				return nil
+			}
 			attrName := marker[1 : len(marker)-1]
-			id := matched.Call("getAttribute", attrName).String()
+			id := delegates.attr(matched, attrName)
 			if id == "" {
 				return nil
 			}
@@ -515,12 +676,12 @@ func AttachInlineDelegates(root js.Value) {
 					logutil.Logf("panic in inline handler for %s: %v", eventType, r)
 				}
 			}()
-			h(el)
+			runProfiled(marker, id, matched, func() { h(el) })
 			return nil
-		})
+		}
 
-		root.Call("addEventListener", eventType, fn)
-		return true, fn, ids
+		delegates.listen(eventType, marker, fn)
+		return true, ids
 	}
 
 	collect := func(attr string) []string {
@@ -539,23 +700,26 @@ func AttachInlineDelegates(root js.Value) {
 	}
 
 	// Install for click
-	clickInstalled, clickFn, clickIDs := install("click", "[data-uiwgo-onclick]", func(id string) (func(Element), bool) {
+	clickInstalled, clickIDs := install("click", "[data-uiwgo-onclick]", func(id string) (func(Element), bool) {
 		return inlineClickHandlers[id], inlineClickHandlers[id] != nil
 	}, func() []string { return collect("data-uiwgo-onclick") })
 
 	// Install for input
-	inputInstalled, inputFn, inputIDs := install("input", "[data-uiwgo-oninput]", func(id string) (func(Element), bool) {
+	inputInstalled, inputIDs := install("input", "[data-uiwgo-oninput]", func(id string) (func(Element), bool) {
 		return inlineInputHandlers[id], inlineInputHandlers[id] != nil
 	}, func() []string { return collect("data-uiwgo-oninput") })
+	if inputInstalled {
+		syncInlineInputs(root)
+	}
 
 	// Install for change
-	changeInstalled, changeFn, changeIDs := install("change", "[data-uiwgo-onchange]", func(id string) (func(Element), bool) {
+	changeInstalled, changeIDs := install("change", "[data-uiwgo-onchange]", func(id string) (func(Element), bool) {
 		return inlineChangeHandlers[id], inlineChangeHandlers[id] != nil
 	}, func() []string { return collect("data-uiwgo-onchange") })
 
 	// Install for keydown (with optional key expectation)
 	keydownInstalled := false
-	var keydownFn js.Func
+	var keydownFn inlineListener
 	var keydownIDs []string
 	{
 		marker := "[data-uiwgo-onkeydown]"
@@ -563,7 +727,7 @@ func AttachInlineDelegates(root js.Value) {
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			// Collect ids
 			keydownIDs = collect("data-uiwgo-onkeydown")
-			keydownFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			keydownFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -573,11 +737,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onkeydown").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onkeydown")
 				if id == "" {
 					return nil
 				}
@@ -602,25 +769,26 @@ func AttachInlineDelegates(root js.Value) {
 						logutil.Logf("panic in inline keydown: %v", r)
 					}
 				}()
-				h(el)
+				runProfiled(marker, id, matched, func() { h(el) })
 				return nil
-			})
-			root.Call("addEventListener", "keydown", keydownFn)
+			}
+			delegates.listen("keydown", marker, keydownFn)
 			keydownInstalled = true
 		}
 	}
 
 	// Additional keydown delegates for specific keys (Enter/Escape) with dedicated markers
 	enterInstalled := false
-	var enterFn js.Func
-	var enterFnUp js.Func
+	var enterFn inlineListener
+	var enterFnUp inlineListener
+	var enterFnBefore inlineListener
 	var enterIDs []string
 	{
 		marker := "[data-uiwgo-onenter]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			enterIDs = collect("data-uiwgo-onenter")
-			enterFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			enterFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -633,11 +801,15 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onenter").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				matched.Set(enterKeyProp, true)
+				id := delegates.attr(matched, "data-uiwgo-onenter")
 				if id == "" {
 					return nil
 				}
@@ -658,10 +830,10 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "keydown", enterFn)
+			}
+			delegates.listen("keydown", marker, enterFn)
 			// Also listen on keyup for robustness across drivers
-			enterFnUp = js.FuncOf(func(this js.Value, args []js.Value) any {
+			enterFnUp = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -674,11 +846,15 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onenter").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				matched.Delete(enterKeyProp)
+				id := delegates.attr(matched, "data-uiwgo-onenter")
 				if id == "" {
 					return nil
 				}
@@ -699,22 +875,127 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "keyup", enterFnUp)
+			}
+			delegates.listen("keyup", marker, enterFnUp)
+			// Keyboards that report the key as "Unidentified" only tell
+			// Enter apart through the text change it causes
+			enterFnBefore = func(this js.Value, args []js.Value) any {
+				if len(args) == 0 {
+					return nil
+				}
+				rawEvent := args[0]
+				if !isEnterInputType(rawEvent.Get("inputType").String()) {
+					return nil
+				}
+				target := rawEvent.Get("target")
+				if target.IsUndefined() || target.IsNull() {
+					return nil
+				}
+				matched := delegates.closest(target, marker)
+				if matched.IsUndefined() || matched.IsNull() {
+					return nil
+				}
+				if matched.Get(enterKeyProp).Truthy() {
+					// The keydown reported Enter and ran the handler already
+					matched.Delete(enterKeyProp)
+					return nil
+				}
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onenter")
+				inlineHandlersMu.RLock()
+				h := inlineKeydownHandlers[id]
+				optedIn := inlineEnterBeforeInput[id]
+				inlineHandlersMu.RUnlock()
+				if h == nil || !optedIn {
+					return nil
+				}
+				el := domv2.WrapElement(matched)
+				if el == nil {
+					return nil
+				}
+				defer func() {
+					if r := recover(); r != nil {
+						logutil.Logf("panic in inline onenter (beforeinput): %v", r)
+					}
+				}()
+				h(el)
+				return nil
+			}
+			if SupportsBeforeInput() {
+				delegates.listen("beforeinput", marker, enterFnBefore)
+			}
 			enterInstalled = true
 		}
 	}
 
+	// Install for beforeinput (with inputType and data)
+	beforeInputInstalled := false
+	var beforeInputFn inlineListener
+	var beforeInputIDs []string
+	{
+		marker := "[data-uiwgo-onbeforeinput]"
+		nodes := root.Call("querySelectorAll", marker)
+		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
+			beforeInputIDs = collect("data-uiwgo-onbeforeinput")
+			beforeInputFn = func(this js.Value, args []js.Value) any {
+				if len(args) == 0 {
+					return nil
+				}
+				rawEvent := args[0]
+				target := rawEvent.Get("target")
+				if target.IsUndefined() || target.IsNull() {
+					return nil
+				}
+				matched := delegates.closest(target, marker)
+				if matched.IsUndefined() || matched.IsNull() {
+					return nil
+				}
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onbeforeinput")
+				inlineHandlersMu.RLock()
+				h := inlineBeforeInputHandlers[id]
+				inlineHandlersMu.RUnlock()
+				if h == nil {
+					return nil
+				}
+				el := domv2.WrapElement(matched)
+				if el == nil {
+					return nil
+				}
+				inputType, data := "", ""
+				if v := rawEvent.Get("inputType"); v.Type() == js.TypeString {
+					inputType = v.String()
+				}
+				if v := rawEvent.Get("data"); v.Type() == js.TypeString {
+					data = v.String()
+				}
+				defer func() {
+					if r := recover(); r != nil {
+						logutil.Logf("panic in inline beforeinput: %v", r)
+					}
+				}()
+				h(el, inputType, data)
+				return nil
+			}
+			delegates.listen("beforeinput", marker, beforeInputFn)
+			beforeInputInstalled = true
+		}
+	}
+
 	escapeInstalled := false
-	var escapeFn js.Func
-	var escapeFnUp js.Func
+	var escapeFn inlineListener
+	var escapeFnUp inlineListener
 	var escapeIDs []string
 	{
 		marker := "[data-uiwgo-onescape]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			escapeIDs = collect("data-uiwgo-onescape")
-			escapeFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			escapeFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -727,11 +1008,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onescape").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onescape")
 				if id == "" {
 					return nil
 				}
@@ -752,10 +1036,10 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "keydown", escapeFn)
+			}
+			delegates.listen("keydown", marker, escapeFn)
 			// Also listen on keyup for robustness
-			escapeFnUp = js.FuncOf(func(this js.Value, args []js.Value) any {
+			escapeFnUp = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -768,11 +1052,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onescape").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onescape")
 				if id == "" {
 					return nil
 				}
@@ -793,22 +1080,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "keyup", escapeFnUp)
+			}
+			delegates.listen("keyup", marker, escapeFnUp)
 			escapeInstalled = true
 		}
 	}
 
 	// Install for submit (with form data serialization)
 	submitInstalled := false
-	var submitFn js.Func
+	var submitFn inlineListener
 	var submitIDs []string
 	{
 		marker := "[data-uiwgo-onsubmit]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			submitIDs = collect("data-uiwgo-onsubmit")
-			submitFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			submitFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -818,11 +1105,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onsubmit").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onsubmit")
 				if id == "" {
 					return nil
 				}
@@ -844,22 +1134,22 @@ func AttachInlineDelegates(root js.Value) {
 				formData := serializeFormData(el)
 				h(el, formData)
 				return nil
-			})
-			root.Call("addEventListener", "submit", submitFn)
+			}
+			delegates.listen("submit", marker, submitFn)
 			submitInstalled = true
 		}
 	}
 
 	// Install for reset
 	resetInstalled := false
-	var resetFn js.Func
+	var resetFn inlineListener
 	var resetIDs []string
 	{
 		marker := "[data-uiwgo-onreset]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			resetIDs = collect("data-uiwgo-onreset")
-			resetFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			resetFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -868,11 +1158,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onreset").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onreset")
 				if id == "" {
 					return nil
 				}
@@ -893,25 +1186,25 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "reset", resetFn)
+			}
+			delegates.listen("reset", marker, resetFn)
 			resetInstalled = true
 		}
 	}
 
 	// Install for blur
-	blurInstalled, blurFn, blurIDs := install("blur", "[data-uiwgo-onblur]", func(id string) (func(Element), bool) {
+	blurInstalled, blurIDs := install("blur", "[data-uiwgo-onblur]", func(id string) (func(Element), bool) {
 		return inlineBlurHandlers[id], inlineBlurHandlers[id] != nil
 	}, func() []string { return collect("data-uiwgo-onblur") })
 
 	// Install for focus
-	focusInstalled, focusFn, focusIDs := install("focus", "[data-uiwgo-onfocus]", func(id string) (func(Element), bool) {
+	focusInstalled, focusIDs := install("focus", "[data-uiwgo-onfocus]", func(id string) (func(Element), bool) {
 		return inlineFocusHandlers[id], inlineFocusHandlers[id] != nil
 	}, func() []string { return collect("data-uiwgo-onfocus") })
 
 	// Install for focuswithin (using focusin/focusout events)
 	focusWithinInstalled := false
-	var focusInFn, focusOutFn js.Func
+	var focusInFn, focusOutFn inlineListener
 	var focusWithinIDs []string
 	{
 		marker := "[data-uiwgo-onfocuswithin]"
@@ -919,17 +1212,20 @@ func AttachInlineDelegates(root js.Value) {
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			focusWithinIDs = collect("data-uiwgo-onfocuswithin")
 			// focusin event (focus entering)
-			focusInFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			focusInFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
 				rawEvent := args[0]
 				target := rawEvent.Get("target")
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if !matched.Truthy() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onfocuswithin").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onfocuswithin")
 				if id == "" {
 					return nil
 				}
@@ -950,19 +1246,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, true) // focus entering
 				return nil
-			})
+			}
 			// focusout event (focus leaving)
-			focusOutFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			focusOutFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
 				rawEvent := args[0]
 				target := rawEvent.Get("target")
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if !matched.Truthy() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onfocuswithin").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onfocuswithin")
 				if id == "" {
 					return nil
 				}
@@ -983,23 +1282,23 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, false) // focus leaving
 				return nil
-			})
-			root.Call("addEventListener", "focusin", focusInFn)
-			root.Call("addEventListener", "focusout", focusOutFn)
+			}
+			delegates.listen("focusin", marker, focusInFn)
+			delegates.listen("focusout", marker, focusOutFn)
 			focusWithinInstalled = true
 		}
 	}
 
 	// Install for form change (delegated change on form elements with form data serialization)
 	formChangeInstalled := false
-	var formChangeFn js.Func
+	var formChangeFn inlineListener
 	var formChangeIDs []string
 	{
 		marker := "[data-uiwgo-onformchange]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			formChangeIDs = collect("data-uiwgo-onformchange")
-			formChangeFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			formChangeFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1018,7 +1317,10 @@ func AttachInlineDelegates(root js.Value) {
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onformchange").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onformchange")
 				if id == "" {
 					return nil
 				}
@@ -1040,22 +1342,22 @@ func AttachInlineDelegates(root js.Value) {
 				formData := serializeFormData(el)
 				h(el, formData)
 				return nil
-			})
-			root.Call("addEventListener", "change", formChangeFn)
+			}
+			delegates.listen("change", "", formChangeFn)
 			formChangeInstalled = true
 		}
 	}
 
 	// Install for input validation
 	validateInstalled := false
-	var validateFn js.Func
+	var validateFn inlineListener
 	var validateIDs []string
 	{
 		marker := "[data-uiwgo-validate]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			validateIDs = collect("data-uiwgo-validate")
-			validateFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			validateFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1064,11 +1366,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-validate").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-validate")
 				if id == "" {
 					return nil
 				}
@@ -1098,22 +1403,22 @@ func AttachInlineDelegates(root js.Value) {
 					matched.Call("setAttribute", "data-invalid", "true")
 				}
 				return nil
-			})
-			root.Call("addEventListener", "input", validateFn)
+			}
+			delegates.listen("input", marker, validateFn)
 			validateInstalled = true
 		}
 	}
 
 	// Install for blur validation
 	blurValidateInstalled := false
-	var blurValidateFn js.Func
+	var blurValidateFn inlineListener
 	var blurValidateIDs []string
 	{
 		marker := "[data-uiwgo-blur-validate]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			blurValidateIDs = collect("data-uiwgo-blur-validate")
-			blurValidateFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			blurValidateFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1122,11 +1427,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-blur-validate").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-blur-validate")
 				if id == "" {
 					return nil
 				}
@@ -1156,22 +1464,22 @@ func AttachInlineDelegates(root js.Value) {
 					matched.Call("setAttribute", "data-invalid", "true")
 				}
 				return nil
-			})
-			root.Call("addEventListener", "blur", blurValidateFn)
+			}
+			delegates.listen("blur", marker, blurValidateFn)
 			blurValidateInstalled = true
 		}
 	}
 
 	// Install for debounced input
 	debouncedInstalled := false
-	var debouncedFn js.Func
+	var debouncedFn inlineListener
 	var debouncedIDs []string
 	{
 		marker := "[data-inline-debounced]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			debouncedIDs = collect("data-inline-debounced")
-			debouncedFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			debouncedFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1180,11 +1488,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-debounced").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-debounced")
 				if id == "" {
 					return nil
 				}
@@ -1226,22 +1537,22 @@ func AttachInlineDelegates(root js.Value) {
 				inlineDebounceTimers[id] = timer
 				inlineHandlersMu.Unlock()
 				return nil
-			})
-			root.Call("addEventListener", "input", debouncedFn)
+			}
+			delegates.listen("input", marker, debouncedFn)
 			debouncedInstalled = true
 		}
 	}
 
 	// Install for search input
 	searchInstalled := false
-	var searchFn js.Func
+	var searchFn inlineListener
 	var searchIDs []string
 	{
 		marker := "[data-inline-search]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			searchIDs = collect("data-inline-search")
-			searchFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			searchFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1250,11 +1561,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-search").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-search")
 				if id == "" {
 					return nil
 				}
@@ -1267,6 +1581,7 @@ func AttachInlineDelegates(root js.Value) {
 				}
 				inlineHandlersMu.RLock()
 				h := inlineSearchHandlers[id]
+				opts := inlineSearchOptions[id]
 				inlineHandlersMu.RUnlock()
 				if h == nil {
 					return nil
@@ -1276,43 +1591,57 @@ func AttachInlineDelegates(root js.Value) {
 					return nil
 				}
 				query := target.Get("value").String()
-				// Clear existing timer
-				inlineHandlersMu.Lock()
-				if timer, exists := inlineDebounceTimers[id]; exists && !timer.IsUndefined() {
-					js.Global().Call("clearTimeout", timer)
-				}
-				// Set new timer
-				timer := js.Global().Call("setTimeout", js.FuncOf(func(this js.Value, args []js.Value) any {
+				call := func(query string) {
 					defer func() {
 						if r := recover(); r != nil {
 							logutil.Logf("panic in inline search: %v", r)
 						}
 					}()
 					h(el, query)
+				}
+				cancelSearchTimer(id)
+				if query != "" && utf8.RuneCountInString(query) < opts.MinChars {
+					if opts.ClearBelowMinChars {
+						call("")
+					}
+					return nil
+				}
+				if query == "" && opts.FlushOnClear {
+					call("")
+					return nil
+				}
+				// Set new timer
+				var fire js.Func
+				fire = js.FuncOf(func(this js.Value, args []js.Value) any {
 					inlineHandlersMu.Lock()
-					delete(inlineDebounceTimers, id)
+					delete(pendingSearches, id)
 					inlineHandlersMu.Unlock()
+					fire.Release()
+					if matched.Get("isConnected").Bool() {
+						call(query)
+					}
 					return nil
-				}), delay)
-				inlineDebounceTimers[id] = timer
+				})
+				inlineHandlersMu.Lock()
+				pendingSearches[id] = pendingSearch{timer: js.Global().Call("setTimeout", fire, delay), fire: fire}
 				inlineHandlersMu.Unlock()
 				return nil
-			})
-			root.Call("addEventListener", "input", searchFn)
+			}
+			delegates.listen("input", marker, searchFn)
 			searchInstalled = true
 		}
 	}
 
 	// Install for Tab navigation
 	tabInstalled := false
-	var tabFn js.Func
+	var tabFn inlineListener
 	var tabIDs []string
 	{
 		marker := "[data-inline-tab]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			tabIDs = collect("data-inline-tab")
-			tabFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			tabFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1329,11 +1658,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-tab").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-tab")
 				if id == "" {
 					return nil
 				}
@@ -1354,22 +1686,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "keydown", tabFn)
+			}
+			delegates.listen("keydown", marker, tabFn)
 			tabInstalled = true
 		}
 	}
 
 	// Install for Shift+Tab navigation
 	shiftTabInstalled := false
-	var shiftTabFn js.Func
+	var shiftTabFn inlineListener
 	var shiftTabIDs []string
 	{
 		marker := "[data-inline-shifttab]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			shiftTabIDs = collect("data-inline-shifttab")
-			shiftTabFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			shiftTabFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1386,11 +1718,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-shifttab").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-shifttab")
 				if id == "" {
 					return nil
 				}
@@ -1411,22 +1746,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el)
 				return nil
-			})
-			root.Call("addEventListener", "keydown", shiftTabFn)
+			}
+			delegates.listen("keydown", marker, shiftTabFn)
 			shiftTabInstalled = true
 		}
 	}
 
 	// Install for Arrow keys navigation
 	arrowInstalled := false
-	var arrowFn js.Func
+	var arrowFn inlineListener
 	var arrowIDs []string
 	{
 		marker := "[data-inline-arrow]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			arrowIDs = collect("data-inline-arrow")
-			arrowFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			arrowFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1449,11 +1784,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-arrow").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-arrow")
 				if id == "" {
 					return nil
 				}
@@ -1474,22 +1812,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, direction)
 				return nil
-			})
-			root.Call("addEventListener", "keydown", arrowFn)
+			}
+			delegates.listen("keydown", marker, arrowFn)
 			arrowInstalled = true
 		}
 	}
 
 	// Install for Drag Start events
 	dragStartInstalled := false
-	var dragStartFn js.Func
+	var dragStartFn inlineListener
 	var dragStartIDs []string
 	{
 		marker := "[data-inline-dragstart]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			dragStartIDs = collect("data-inline-dragstart")
-			dragStartFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			dragStartFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1498,11 +1836,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-dragstart").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-dragstart")
 				if id == "" {
 					return nil
 				}
@@ -1524,22 +1865,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, dataTransfer)
 				return nil
-			})
-			root.Call("addEventListener", "dragstart", dragStartFn)
+			}
+			delegates.listen("dragstart", marker, dragStartFn)
 			dragStartInstalled = true
 		}
 	}
 
 	// Install for Drop events
 	dropInstalled := false
-	var dropFn js.Func
+	var dropFn inlineListener
 	var dropIDs []string
 	{
 		marker := "[data-inline-drop]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			dropIDs = collect("data-inline-drop")
-			dropFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			dropFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1548,11 +1889,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-drop").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-drop")
 				if id == "" {
 					return nil
 				}
@@ -1574,22 +1918,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, dataTransfer)
 				return nil
-			})
-			root.Call("addEventListener", "drop", dropFn)
+			}
+			delegates.listen("drop", marker, dropFn)
 			dropInstalled = true
 		}
 	}
 
 	// Install for Drag Over events
 	dragOverInstalled := false
-	var dragOverFn js.Func
+	var dragOverFn inlineListener
 	var dragOverIDs []string
 	{
 		marker := "[data-inline-dragover]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			dragOverIDs = collect("data-inline-dragover")
-			dragOverFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			dragOverFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1598,11 +1942,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-inline-dragover").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-inline-dragover")
 				if id == "" {
 					return nil
 				}
@@ -1624,22 +1971,22 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, dataTransfer)
 				return nil
-			})
-			root.Call("addEventListener", "dragover", dragOverFn)
+			}
+			delegates.listen("dragover", marker, dragOverFn)
 			dragOverInstalled = true
 		}
 	}
 
 	// Install for Outside Click events
 	outsideClickInstalled := false
-	var outsideClickFn js.Func
+	var outsideClickFn inlineListener
 	var outsideClickIDs []string
 	{
 		marker := "[data-uiwgo-onoutsideclick]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			outsideClickIDs = collect("data-uiwgo-onoutsideclick")
-			outsideClickFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			outsideClickFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1674,22 +2021,22 @@ func AttachInlineDelegates(root js.Value) {
 					}
 				}
 				return nil
-			})
-			root.Call("addEventListener", "click", outsideClickFn)
+			}
+			delegates.listen("click", "", outsideClickFn)
 			outsideClickInstalled = true
 		}
 	}
 
 	// Install for Escape Close events
 	escapeCloseInstalled := false
-	var escapeCloseFn js.Func
+	var escapeCloseFn inlineListener
 	var escapeCloseIDs []string
 	{
 		marker := "[data-uiwgo-onescapeclose]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			escapeCloseIDs = collect("data-uiwgo-onescapeclose")
-			escapeCloseFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			escapeCloseFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1724,22 +2071,22 @@ func AttachInlineDelegates(root js.Value) {
 					h(el)
 				}
 				return nil
-			})
-			root.Call("addEventListener", "keydown", escapeCloseFn)
+			}
+			delegates.listen("keydown", "", escapeCloseFn)
 			escapeCloseInstalled = true
 		}
 	}
 
 	// Install for File Select events
 	fileSelectInstalled := false
-	var fileSelectFn js.Func
+	var fileSelectFn inlineListener
 	var fileSelectIDs []string
 	{
 		marker := "[data-uiwgo-onfileselect]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			fileSelectIDs = collect("data-uiwgo-onfileselect")
-			fileSelectFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			fileSelectFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1776,23 +2123,23 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, fileArray)
 				return nil
-			})
-			root.Call("addEventListener", "change", fileSelectFn)
+			}
+			delegates.listen("change", "", fileSelectFn)
 			fileSelectInstalled = true
 		}
 	}
 
 	// Install for File Drop events
 	fileDropInstalled := false
-	var fileDropFn js.Func
-	var dragOverPreventFn js.Func
+	var fileDropFn inlineListener
+	var dragOverPreventFn inlineListener
 	var fileDropIDs []string
 	{
 		marker := "[data-uiwgo-onfiledrop]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			fileDropIDs = collect("data-uiwgo-onfiledrop")
-			fileDropFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			fileDropFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1846,10 +2193,10 @@ func AttachInlineDelegates(root js.Value) {
 				}()
 				h(el, fileArray)
 				return nil
-			})
-			root.Call("addEventListener", "drop", fileDropFn)
+			}
+			delegates.listen("drop", "", fileDropFn)
 			// Also prevent default dragover to allow drop
-			dragOverPreventFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			dragOverPreventFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1865,22 +2212,22 @@ func AttachInlineDelegates(root js.Value) {
 					current = current.Get("parentElement")
 				}
 				return nil
-			})
-			root.Call("addEventListener", "dragover", dragOverPreventFn)
+			}
+			delegates.listen("dragover", "", dragOverPreventFn)
 			fileDropInstalled = true
 		}
 	}
 
 	// Alpine-inspired: click once handler
 	clickOnceInstalled := false
-	var clickOnceFn js.Func
+	var clickOnceFn inlineListener
 	var clickOnceIDs []string
 	{
 		marker := "[data-uiwgo-onclick-once]"
 		nodes := root.Call("querySelectorAll", marker)
 		if nodes.Truthy() && nodes.Get("length").Int() > 0 {
 			clickOnceIDs = collect("data-uiwgo-onclick-once")
-			clickOnceFn = js.FuncOf(func(this js.Value, args []js.Value) any {
+			clickOnceFn = func(this js.Value, args []js.Value) any {
 				if len(args) == 0 {
 					return nil
 				}
@@ -1889,11 +2236,14 @@ func AttachInlineDelegates(root js.Value) {
 				if target.IsUndefined() || target.IsNull() {
 					return nil
 				}
-				matched := target.Call("closest", marker)
+				matched := delegates.closest(target, marker)
 				if matched.IsUndefined() || matched.IsNull() {
 					return nil
 				}
-				id := matched.Call("getAttribute", "data-uiwgo-onclick-once").String()
+				if !claimInlineEvent(rawEvent, marker) {
+					return nil
+				}
+				id := delegates.attr(matched, "data-uiwgo-onclick-once")
 				if id == "" {
 					return nil
 				}
@@ -1918,8 +2268,8 @@ func AttachInlineDelegates(root js.Value) {
 				inlineHandlersMu.Unlock()
 				matched.Call("removeAttribute", "data-uiwgo-onclick-once")
 				return nil
-			})
-			root.Call("addEventListener", "click", clickOnceFn)
+			}
+			delegates.listen("click", marker, clickOnceFn)
 			clickOnceInstalled = true
 		}
 	}
@@ -1991,7 +2341,7 @@ func AttachInlineDelegates(root js.Value) {
 				removed := m.Get("removedNodes")
 				for j := 0; j < removed.Length(); j++ {
 					rn := removed.Index(j)
-					if !rn.Truthy() || rn.Get("nodeType").Int() != 1 {
+					if !rn.Truthy() || rn.Get("nodeType").Int() != 1 || rn.Get("isConnected").Truthy() {
 						continue
 					}
 					// If removed element itself has ondestroy
@@ -2103,16 +2453,14 @@ func AttachInlineDelegates(root js.Value) {
 				}
 				return nil
 			})
-			ctor := js.Global().Get("IntersectionObserver")
-			if ctor.Truthy() {
-				io = ctor.New(ioCb)
-				ln := nodes.Get("length").Int()
-				for i := 0; i < ln; i++ {
-					elNode := nodes.Call("item", i)
-					io.Call("observe", elNode)
-				}
-				visibleInstalled = true
+			// Polls element positions without IntersectionObserver
+			io = newIntersectionObserver(ioCb)
+			ln := nodes.Get("length").Int()
+			for i := 0; i < ln; i++ {
+				elNode := nodes.Call("item", i)
+				io.Call("observe", elNode)
 			}
+			visibleInstalled = true
 		}
 	}
 
@@ -2155,24 +2503,23 @@ func AttachInlineDelegates(root js.Value) {
 				}
 				return nil
 			})
-			ctor := js.Global().Get("ResizeObserver")
-			if ctor.Truthy() {
-				ro = ctor.New(roCb)
-				ln := nodes.Get("length").Int()
-				for i := 0; i < ln; i++ {
-					elNode := nodes.Call("item", i)
-					ro.Call("observe", elNode)
-				}
-				resizeInstalled = true
+			// Polls element sizes without ResizeObserver
+			ro = newResizeObserver(roCb)
+			ln := nodes.Get("length").Int()
+			for i := 0; i < ln; i++ {
+				elNode := nodes.Call("item", i)
+				ro.Call("observe", elNode)
 			}
+			resizeInstalled = true
 		}
 	}
 
+	delegates.start()
+
 	// Cleanup
 	reactivity.OnCleanup(func() {
+		delegates.stop()
 		if clickInstalled {
-			root.Call("removeEventListener", "click", clickFn)
-			clickFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range clickIDs {
 				delete(inlineClickHandlers, id)
@@ -2180,17 +2527,14 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if inputInstalled {
-			root.Call("removeEventListener", "input", inputFn)
-			inputFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range inputIDs {
 				delete(inlineInputHandlers, id)
+				delete(inlineInputSkipSync, id)
 			}
 			inlineHandlersMu.Unlock()
 		}
 		if changeInstalled {
-			root.Call("removeEventListener", "change", changeFn)
-			changeFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range changeIDs {
 				delete(inlineChangeHandlers, id)
@@ -2198,8 +2542,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if keydownInstalled {
-			root.Call("removeEventListener", "keydown", keydownFn)
-			keydownFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range keydownIDs {
 				delete(inlineKeydownHandlers, id)
@@ -2208,22 +2550,22 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if enterInstalled {
-			root.Call("removeEventListener", "keydown", enterFn)
-			enterFn.Release()
-			root.Call("removeEventListener", "keyup", enterFnUp)
-			enterFnUp.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range enterIDs {
 				delete(inlineKeydownHandlers, id)
 				delete(inlineKeyExpectations, id)
+				delete(inlineEnterBeforeInput, id)
+			}
+			inlineHandlersMu.Unlock()
+		}
+		if beforeInputInstalled {
+			inlineHandlersMu.Lock()
+			for _, id := range beforeInputIDs {
+				delete(inlineBeforeInputHandlers, id)
 			}
 			inlineHandlersMu.Unlock()
 		}
 		if escapeInstalled {
-			root.Call("removeEventListener", "keydown", escapeFn)
-			escapeFn.Release()
-			root.Call("removeEventListener", "keyup", escapeFnUp)
-			escapeFnUp.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range escapeIDs {
 				delete(inlineKeydownHandlers, id)
@@ -2232,8 +2574,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if submitInstalled {
-			root.Call("removeEventListener", "submit", submitFn)
-			submitFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range submitIDs {
 				delete(inlineSubmitHandlers, id)
@@ -2241,8 +2581,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if resetInstalled {
-			root.Call("removeEventListener", "reset", resetFn)
-			resetFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range resetIDs {
 				delete(inlineFormResetHandlers, id)
@@ -2250,8 +2588,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if blurInstalled {
-			root.Call("removeEventListener", "blur", blurFn)
-			blurFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range blurIDs {
 				delete(inlineBlurHandlers, id)
@@ -2259,8 +2595,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if focusInstalled {
-			root.Call("removeEventListener", "focus", focusFn)
-			focusFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range focusIDs {
 				delete(inlineFocusHandlers, id)
@@ -2268,10 +2602,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if focusWithinInstalled {
-			root.Call("removeEventListener", "focusin", focusInFn)
-			root.Call("removeEventListener", "focusout", focusOutFn)
-			focusInFn.Release()
-			focusOutFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range focusWithinIDs {
 				delete(inlineFocusWithinHandlers, id)
@@ -2279,8 +2609,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if formChangeInstalled {
-			root.Call("removeEventListener", "change", formChangeFn)
-			formChangeFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range formChangeIDs {
 				delete(inlineFormChangeHandlers, id)
@@ -2288,8 +2616,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if validateInstalled {
-			root.Call("removeEventListener", "input", validateFn)
-			validateFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range validateIDs {
 				delete(inlineValidateHandlers, id)
@@ -2297,8 +2623,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if blurValidateInstalled {
-			root.Call("removeEventListener", "blur", blurValidateFn)
-			blurValidateFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range blurValidateIDs {
 				delete(inlineBlurValidateHandlers, id)
@@ -2306,8 +2630,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if debouncedInstalled {
-			root.Call("removeEventListener", "input", debouncedFn)
-			debouncedFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range debouncedIDs {
 				if timer, exists := inlineDebounceTimers[id]; exists && !timer.IsUndefined() {
@@ -2319,21 +2641,17 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if searchInstalled {
-			root.Call("removeEventListener", "input", searchFn)
-			searchFn.Release()
+			for _, id := range searchIDs {
+				cancelSearchTimer(id)
+			}
 			inlineHandlersMu.Lock()
 			for _, id := range searchIDs {
-				if timer, exists := inlineDebounceTimers[id]; exists && !timer.IsUndefined() {
-					js.Global().Call("clearTimeout", timer)
-				}
 				delete(inlineSearchHandlers, id)
-				delete(inlineDebounceTimers, id)
+				delete(inlineSearchOptions, id)
 			}
 			inlineHandlersMu.Unlock()
 		}
 		if tabInstalled {
-			root.Call("removeEventListener", "keydown", tabFn)
-			tabFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range tabIDs {
 				delete(inlineTabHandlers, id)
@@ -2341,8 +2659,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if shiftTabInstalled {
-			root.Call("removeEventListener", "keydown", shiftTabFn)
-			shiftTabFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range shiftTabIDs {
 				delete(inlineShiftTabHandlers, id)
@@ -2350,8 +2666,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if arrowInstalled {
-			root.Call("removeEventListener", "keydown", arrowFn)
-			arrowFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range arrowIDs {
 				delete(inlineArrowKeyHandlers, id)
@@ -2359,8 +2673,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if dragStartInstalled {
-			root.Call("removeEventListener", "dragstart", dragStartFn)
-			dragStartFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range dragStartIDs {
 				delete(inlineDragStartHandlers, id)
@@ -2368,8 +2680,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if dropInstalled {
-			root.Call("removeEventListener", "drop", dropFn)
-			dropFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range dropIDs {
 				delete(inlineDropHandlers, id)
@@ -2377,8 +2687,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if dragOverInstalled {
-			root.Call("removeEventListener", "dragover", dragOverFn)
-			dragOverFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range dragOverIDs {
 				delete(inlineDragOverHandlers, id)
@@ -2386,8 +2694,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if outsideClickInstalled {
-			root.Call("removeEventListener", "click", outsideClickFn)
-			outsideClickFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range outsideClickIDs {
 				delete(inlineOutsideClickHandlers, id)
@@ -2395,8 +2701,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if escapeCloseInstalled {
-			root.Call("removeEventListener", "keydown", escapeCloseFn)
-			escapeCloseFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range escapeCloseIDs {
 				delete(inlineEscapeCloseHandlers, id)
@@ -2404,8 +2708,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if fileSelectInstalled {
-			root.Call("removeEventListener", "change", fileSelectFn)
-			fileSelectFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range fileSelectIDs {
 				delete(inlineFileSelectHandlers, id)
@@ -2413,10 +2715,6 @@ func AttachInlineDelegates(root js.Value) {
 			inlineHandlersMu.Unlock()
 		}
 		if fileDropInstalled {
-			root.Call("removeEventListener", "drop", fileDropFn)
-			root.Call("removeEventListener", "dragover", dragOverPreventFn)
-			fileDropFn.Release()
-			dragOverPreventFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range fileDropIDs {
 				delete(inlineFileDropHandlers, id)
@@ -2425,8 +2723,6 @@ func AttachInlineDelegates(root js.Value) {
 		}
 		// Cleanup for Alpine-inspired additions
 		if clickOnceInstalled {
-			root.Call("removeEventListener", "click", clickOnceFn)
-			clickOnceFn.Release()
 			inlineHandlersMu.Lock()
 			for _, id := range clickOnceIDs {
 				delete(inlineClickOnceHandlers, id)
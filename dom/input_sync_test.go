@@ -0,0 +1,106 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"strings"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+)
+
+// prefilledInput appends an input whose value was changed after rendering,
+// the way browser autofill or form restoration leaves it.
+func prefilledInput(t *testing.T, rendered, current string) js.Value {
+	t.Helper()
+	doc := js.Global().Get("document")
+	input := doc.Call("createElement", "input")
+	input.Call("setAttribute", "value", rendered)
+	input.Set("value", current)
+	doc.Get("body").Call("appendChild", input)
+	t.Cleanup(func() { input.Call("remove") })
+	return input
+}
+
+func TestBindInputToSignalAdoptsPrefilledValue(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input := prefilledInput(t, "", "autofilled")
+	name := reactivity.CreateSignal("")
+	binding := BindInputToSignal(domv2.WrapElement(input), name)
+	defer binding.Dispose()
+
+	if got := name.Get(); got != "autofilled" {
+		t.Fatalf("expected the signal to adopt the prefilled value, got %q", got)
+	}
+}
+
+func TestBindInputToSignalSyncInitialDisabled(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input := prefilledInput(t, "", "autofilled")
+	name := reactivity.CreateSignal("programmatic")
+	binding := BindInputToSignal(domv2.WrapElement(input), name, SyncInitial(false))
+	defer binding.Dispose()
+
+	if got := name.Get(); got != "programmatic" {
+		t.Fatalf("expected the programmatic value to win, got %q", got)
+	}
+}
+
+func TestOnInputInlineSyncsPrefilledValue(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	synced := reactivity.CreateSignal("")
+	skipped := reactivity.CreateSignal("")
+	untouched := 0
+
+	doc := js.Global().Get("document")
+	root := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", root)
+	defer root.Call("remove")
+
+	addInput := func(id, rendered, current string) {
+		input := doc.Call("createElement", "input")
+		input.Call("setAttribute", "data-uiwgo-oninput", id)
+		input.Call("setAttribute", "value", rendered)
+		input.Set("value", current)
+		root.Call("appendChild", input)
+	}
+	syncedID := registerInlineInput(func(el Element) { synced.Set(el.Underlying().Get("value").String()) })
+	skippedID := registerInlineInput(func(el Element) { skipped.Set(el.Underlying().Get("value").String()) }, SyncInitial(false))
+	untouchedID := registerInlineInput(func(el Element) { untouched++ })
+
+	addInput(syncedID, "", "restored")
+	addInput(skippedID, "", "restored")
+	addInput(untouchedID, "same", "same")
+
+	AttachInlineDelegates(root)
+
+	if got := synced.Get(); got != "restored" {
+		t.Errorf("expected the inline handler to sync the restored value, got %q", got)
+	}
+	if got := skipped.Get(); got != "" {
+		t.Errorf("SyncInitial(false) should not run the handler, got %q", got)
+	}
+	if untouched != 0 {
+		t.Errorf("unchanged inputs should not be synced, handler ran %d times", untouched)
+	}
+}
+
+// registerInlineInput registers an OnInputInline handler and returns its id.
+func registerInlineInput(handler func(el Element), opts ...InputBindOption) string {
+	var b strings.Builder
+	_ = OnInputInline(handler, opts...).Render(&b)
+	// Rendered as: data-uiwgo-oninput="<id>"
+	_, id, _ := strings.Cut(b.String(), `"`)
+	return strings.TrimSuffix(id, `"`)
+}
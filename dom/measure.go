@@ -0,0 +1,249 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// rectWatch is one element measured by BoundingRect and its subscribers.
+type rectWatch struct {
+	el   js.Value
+	rect reactivity.Signal[Rect]
+	refs int
+}
+
+// rectTracker re-measures every watched element at most once per animation
+// frame after a scroll, resize or size change. All BoundingRect calls share
+// its listeners and ResizeObserver, which exist only while something is
+// watched.
+var rectTracker struct {
+	watches   []*rectWatch
+	schedule  js.Func
+	frame     js.Func
+	scheduled bool
+	frameID   js.Value
+	ro        js.Value
+}
+
+// BoundingRect returns a signal holding el's bounding client rect. It is
+// updated, throttled to one measurement per animation frame, whenever the
+// page or any scroll container scrolls, the window resizes or el changes
+// size. Calls for the same element share one signal; listeners are removed
+// when the last calling cleanup scope is disposed.
+func BoundingRect(el Element) reactivity.Signal[Rect] {
+	raw := el.Underlying()
+	t := &rectTracker
+	var w *rectWatch
+	for _, existing := range t.watches {
+		if existing.el.Equal(raw) {
+			w = existing
+			break
+		}
+	}
+	if w == nil {
+		if len(t.watches) == 0 {
+			startRectTracking()
+		}
+		w = &rectWatch{el: raw, rect: reactivity.CreateSignal(measureRect(raw))}
+		t.watches = append(t.watches, w)
+		if t.ro.Truthy() {
+			t.ro.Call("observe", raw)
+		}
+	}
+	w.refs++
+
+	reactivity.RegisterCleanup(func() {
+		w.refs--
+		if w.refs > 0 {
+			return
+		}
+		for i, existing := range t.watches {
+			if existing == w {
+				t.watches = append(t.watches[:i], t.watches[i+1:]...)
+				break
+			}
+		}
+		if t.ro.Truthy() {
+			t.ro.Call("unobserve", raw)
+		}
+		if len(t.watches) == 0 {
+			stopRectTracking()
+		}
+	})
+	return w.rect
+}
+
+func startRectTracking() {
+	t := &rectTracker
+	t.frame = js.FuncOf(func(this js.Value, args []js.Value) any {
+		t.scheduled = false
+		reactivity.Batch(func() {
+			for _, w := range t.watches {
+				w.rect.Set(measureRect(w.el))
+			}
+		})
+		return nil
+	})
+	t.schedule = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if t.scheduled {
+			return nil
+		}
+		t.scheduled = true
+		t.frameID = js.Global().Call("requestAnimationFrame", t.frame)
+		return nil
+	})
+	// Capture scroll events so scrolling inside any container is seen
+	js.Global().Call("addEventListener", "scroll", t.schedule, map[string]any{"capture": true, "passive": true})
+	js.Global().Call("addEventListener", "resize", t.schedule, map[string]any{"passive": true})
+	t.ro = newResizeObserver(t.schedule)
+}
+
+func stopRectTracking() {
+	t := &rectTracker
+	js.Global().Call("removeEventListener", "scroll", t.schedule, map[string]any{"capture": true, "passive": true})
+	js.Global().Call("removeEventListener", "resize", t.schedule, map[string]any{"passive": true})
+	if t.ro.Truthy() {
+		t.ro.Call("disconnect")
+		t.ro = js.Undefined()
+	}
+	if t.scheduled {
+		js.Global().Call("cancelAnimationFrame", t.frameID)
+		t.scheduled = false
+	}
+	t.schedule.Release()
+	t.frame.Release()
+}
+
+func measureRect(el js.Value) Rect {
+	r := el.Call("getBoundingClientRect")
+	return Rect{
+		X:      r.Get("left").Float(),
+		Y:      r.Get("top").Float(),
+		Width:  r.Get("width").Float(),
+		Height: r.Get("height").Float(),
+	}
+}
+
+// visibilityThresholds are the intersection ratios at which VisibilityRatio
+// is updated: every 5%.
+var visibilityThresholds = func() []any {
+	steps := make([]any, 21)
+	for i := range steps {
+		steps[i] = float64(i) / 20
+	}
+	return steps
+}()
+
+// visibilityWatch is one element observed by VisibilityRatio.
+type visibilityWatch struct {
+	el    js.Value
+	ratio reactivity.Signal[float64]
+	refs  int
+}
+
+// visibilityTracker holds the IntersectionObserver shared by every
+// VisibilityRatio call; it exists only while something is observed.
+var visibilityTracker struct {
+	watches  []*visibilityWatch
+	observer js.Value
+	callback js.Func
+}
+
+// VisibilityRatio returns a signal holding the fraction of el that is
+// visible in the viewport, from 0 to 1 in steps of 0.05. Calls share one
+// IntersectionObserver and, for the same element, one signal; el is
+// unobserved when the last calling cleanup scope is disposed. Without
+// IntersectionObserver support the element's position is polled.
+func VisibilityRatio(el Element) reactivity.Signal[float64] {
+	raw := el.Underlying()
+	t := &visibilityTracker
+	var w *visibilityWatch
+	for _, existing := range t.watches {
+		if existing.el.Equal(raw) {
+			w = existing
+			break
+		}
+	}
+	if w == nil {
+		if len(t.watches) == 0 {
+			startVisibilityTracking()
+		}
+		w = &visibilityWatch{el: raw, ratio: reactivity.CreateSignal(0.0)}
+		t.watches = append(t.watches, w)
+		if t.observer.Truthy() {
+			t.observer.Call("observe", raw)
+		}
+	}
+	w.refs++
+
+	reactivity.RegisterCleanup(func() {
+		w.refs--
+		if w.refs > 0 {
+			return
+		}
+		for i, existing := range t.watches {
+			if existing == w {
+				t.watches = append(t.watches[:i], t.watches[i+1:]...)
+				break
+			}
+		}
+		if t.observer.Truthy() {
+			t.observer.Call("unobserve", raw)
+		}
+		if len(t.watches) == 0 {
+			stopVisibilityTracking()
+		}
+	})
+	return w.ratio
+}
+
+func startVisibilityTracking() {
+	t := &visibilityTracker
+	t.callback = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		entries := args[0]
+		reactivity.Batch(func() {
+			for i := 0; i < entries.Length(); i++ {
+				entry := entries.Index(i)
+				target := entry.Get("target")
+				ratio := 0.0
+				if entry.Get("isIntersecting").Truthy() {
+					ratio = entry.Get("intersectionRatio").Float()
+				}
+				for _, w := range t.watches {
+					if w.el.Equal(target) {
+						w.ratio.Set(ratio)
+					}
+				}
+			}
+		})
+		return nil
+	})
+	t.observer = newIntersectionObserver(t.callback, map[string]any{"threshold": visibilityThresholds})
+}
+
+func stopVisibilityTracking() {
+	t := &visibilityTracker
+	if t.observer.Truthy() {
+		t.observer.Call("disconnect")
+		t.observer = js.Undefined()
+	}
+	t.callback.Release()
+}
+
+// PositionRelativeTo computes where to put popup next to anchor. placement
+// is "top", "bottom", "left" or "right", optionally followed by "-start" or
+// "-end" to align edges instead of centering (e.g. "bottom-start"). When
+// the popup would overflow the viewport on that side and fits on the
+// opposite one, the placement is flipped. The result only describes the
+// position; apply it with position: fixed.
+func PositionRelativeTo(anchor, popup Element, placement string) Position {
+	win := js.Global()
+	viewport := Rect{Width: win.Get("innerWidth").Float(), Height: win.Get("innerHeight").Float()}
+	return computePosition(measureRect(anchor.Underlying()), measureRect(popup.Underlying()), viewport, placement)
+}
@@ -0,0 +1,147 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+)
+
+// stubIntersectionObserver replaces window.IntersectionObserver with a fake
+// that records how it is used and lets the test deliver entries.
+type stubIntersectionObserver struct {
+	callback   js.Value
+	observed   []js.Value
+	created    int
+	disconnect int
+	ctor       js.Func
+	original   js.Value
+}
+
+func installStubIntersectionObserver() *stubIntersectionObserver {
+	s := &stubIntersectionObserver{original: js.Global().Get("IntersectionObserver")}
+	s.ctor = js.FuncOf(func(this js.Value, args []js.Value) any {
+		s.created++
+		s.callback = args[0]
+		obs := js.Global().Get("Object").New()
+		obs.Set("observe", js.FuncOf(func(this js.Value, args []js.Value) any {
+			s.observed = append(s.observed, args[0])
+			return nil
+		}))
+		obs.Set("unobserve", js.FuncOf(func(this js.Value, args []js.Value) any {
+			for i, el := range s.observed {
+				if el.Equal(args[0]) {
+					s.observed = append(s.observed[:i], s.observed[i+1:]...)
+					break
+				}
+			}
+			return nil
+		}))
+		obs.Set("disconnect", js.FuncOf(func(this js.Value, args []js.Value) any {
+			s.disconnect++
+			s.observed = nil
+			return nil
+		}))
+		return obs
+	})
+	js.Global().Set("IntersectionObserver", s.ctor)
+	return s
+}
+
+func (s *stubIntersectionObserver) restore() {
+	js.Global().Set("IntersectionObserver", s.original)
+	s.ctor.Release()
+}
+
+func (s *stubIntersectionObserver) deliver(target js.Value, ratio float64) {
+	entry := map[string]any{"target": target, "isIntersecting": ratio > 0, "intersectionRatio": ratio}
+	s.callback.Invoke([]any{entry})
+}
+
+func TestVisibilityRatioSharesObserver(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	stub := installStubIntersectionObserver()
+	defer stub.restore()
+
+	doc := js.Global().Get("document")
+	a := doc.Call("createElement", "div")
+	b := doc.Call("createElement", "div")
+
+	scope1 := reactivity.NewCleanupScope(nil)
+	scope2 := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope1)
+	ratioA := VisibilityRatio(domv2.WrapElement(a))
+	reactivity.SetCurrentCleanupScope(scope2)
+	ratioA2 := VisibilityRatio(domv2.WrapElement(a))
+	ratioB := VisibilityRatio(domv2.WrapElement(b))
+	reactivity.SetCurrentCleanupScope(prev)
+
+	if stub.created != 1 {
+		t.Fatalf("IntersectionObserver created %d times, want 1", stub.created)
+	}
+	if len(stub.observed) != 2 {
+		t.Fatalf("observed %d elements, want 2", len(stub.observed))
+	}
+
+	stub.deliver(a, 0.5)
+	if ratioA.Get() != 0.5 || ratioA2.Get() != 0.5 {
+		t.Errorf("ratio for a = %v / %v, want 0.5", ratioA.Get(), ratioA2.Get())
+	}
+	if ratioB.Get() != 0 {
+		t.Errorf("ratio for b = %v, want 0", ratioB.Get())
+	}
+	stub.deliver(b, 1)
+	if ratioB.Get() != 1 {
+		t.Errorf("ratio for b = %v, want 1", ratioB.Get())
+	}
+
+	// a stays observed while scope2 still uses it
+	scope1.Dispose()
+	if len(stub.observed) != 2 {
+		t.Errorf("observed %d elements after first dispose, want 2", len(stub.observed))
+	}
+	scope2.Dispose()
+	if stub.disconnect != 1 {
+		t.Errorf("observer disconnected %d times, want 1", stub.disconnect)
+	}
+}
+
+func TestBoundingRectUpdatesOnResize(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	doc := js.Global().Get("document")
+	box := doc.Call("createElement", "div")
+	box.Get("style").Set("cssText", "position: fixed; left: 10px; top: 20px; width: 30px; height: 40px")
+	doc.Get("body").Call("appendChild", box)
+	defer box.Call("remove")
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	rect := BoundingRect(domv2.WrapElement(box))
+	shared := BoundingRect(domv2.WrapElement(box))
+	reactivity.SetCurrentCleanupScope(prev)
+	defer scope.Dispose()
+
+	if want := (Rect{X: 10, Y: 20, Width: 30, Height: 40}); rect.Get() != want {
+		t.Fatalf("initial rect = %+v, want %+v", rect.Get(), want)
+	}
+	if rect != shared {
+		t.Error("expected calls for the same element to share a signal")
+	}
+
+	box.Get("style").Set("left", "50px")
+	js.Global().Call("dispatchEvent", js.Global().Get("Event").New("resize"))
+	time.Sleep(50 * time.Millisecond)
+	if got := rect.Get().X; got != 50 {
+		t.Errorf("rect.X after resize = %v, want 50", got)
+	}
+}
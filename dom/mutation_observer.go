@@ -157,6 +157,10 @@ func (mo *MutationObserver) processRemovedNodes(removedNodes js.Value) {
 	length := removedNodes.Get("length").Int()
 	for i := 0; i < length; i++ {
 		node := removedNodes.Index(i)
+		// A node that is still connected was moved, not removed
+		if node.Get("isConnected").Truthy() {
+			continue
+		}
 		mo.cleanupNodeAndDescendants(node)
 	}
 }
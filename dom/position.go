@@ -0,0 +1,90 @@
+package dom
+
+import "strings"
+
+// Rect is an element box in viewport coordinates, as returned by
+// getBoundingClientRect.
+type Rect struct {
+	X, Y, Width, Height float64
+}
+
+// Right returns the x coordinate of the right edge.
+func (r Rect) Right() float64 { return r.X + r.Width }
+
+// Bottom returns the y coordinate of the bottom edge.
+func (r Rect) Bottom() float64 { return r.Y + r.Height }
+
+// Position is where to place a popup, in viewport coordinates, and the
+// placement actually used after flipping.
+type Position struct {
+	X, Y      float64
+	Placement string
+}
+
+// computePosition is the geometry behind PositionRelativeTo.
+func computePosition(anchor, popup, viewport Rect, placement string) Position {
+	side, align, _ := strings.Cut(placement, "-")
+	switch side {
+	case "top", "bottom", "left", "right":
+	default:
+		side = "bottom"
+	}
+
+	// Flip to the opposite side when this one overflows and that one fits
+	switch side {
+	case "bottom":
+		if anchor.Bottom()+popup.Height > viewport.Bottom() && anchor.Y-popup.Height >= viewport.Y {
+			side = "top"
+		}
+	case "top":
+		if anchor.Y-popup.Height < viewport.Y && anchor.Bottom()+popup.Height <= viewport.Bottom() {
+			side = "bottom"
+		}
+	case "right":
+		if anchor.Right()+popup.Width > viewport.Right() && anchor.X-popup.Width >= viewport.X {
+			side = "left"
+		}
+	case "left":
+		if anchor.X-popup.Width < viewport.X && anchor.Right()+popup.Width <= viewport.Right() {
+			side = "right"
+		}
+	}
+
+	var pos Position
+	switch side {
+	case "top":
+		pos.Y = anchor.Y - popup.Height
+	case "bottom":
+		pos.Y = anchor.Bottom()
+	case "left":
+		pos.X = anchor.X - popup.Width
+	case "right":
+		pos.X = anchor.Right()
+	}
+
+	if side == "top" || side == "bottom" {
+		switch align {
+		case "start":
+			pos.X = anchor.X
+		case "end":
+			pos.X = anchor.Right() - popup.Width
+		default:
+			pos.X = anchor.X + (anchor.Width-popup.Width)/2
+		}
+	} else {
+		switch align {
+		case "start":
+			pos.Y = anchor.Y
+		case "end":
+			pos.Y = anchor.Bottom() - popup.Height
+		default:
+			pos.Y = anchor.Y + (anchor.Height-popup.Height)/2
+		}
+	}
+
+	pos.Placement = side
+	if align == "start" || align == "end" {
+		pos.Placement += "-" + align
+	}
+	return pos
+}
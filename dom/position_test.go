@@ -0,0 +1,33 @@
+package dom
+
+import "testing"
+
+func TestComputePosition(t *testing.T) {
+	viewport := Rect{Width: 800, Height: 600}
+	popup := Rect{Width: 100, Height: 50}
+
+	tests := []struct {
+		name      string
+		anchor    Rect
+		placement string
+		want      Position
+	}{
+		{"bottom centered", Rect{X: 300, Y: 100, Width: 40, Height: 20}, "bottom", Position{X: 270, Y: 120, Placement: "bottom"}},
+		{"bottom start", Rect{X: 300, Y: 100, Width: 40, Height: 20}, "bottom-start", Position{X: 300, Y: 120, Placement: "bottom-start"}},
+		{"top end", Rect{X: 300, Y: 100, Width: 40, Height: 20}, "top-end", Position{X: 240, Y: 50, Placement: "top-end"}},
+		{"right centered", Rect{X: 300, Y: 100, Width: 40, Height: 20}, "right", Position{X: 340, Y: 85, Placement: "right"}},
+		{"bottom flips to top near viewport bottom", Rect{X: 300, Y: 560, Width: 40, Height: 20}, "bottom", Position{X: 270, Y: 510, Placement: "top"}},
+		{"top flips to bottom near viewport top", Rect{X: 300, Y: 10, Width: 40, Height: 20}, "top-start", Position{X: 300, Y: 30, Placement: "bottom-start"}},
+		{"right flips to left near viewport edge", Rect{X: 740, Y: 100, Width: 40, Height: 20}, "right", Position{X: 640, Y: 85, Placement: "left"}},
+		{"left flips to right near viewport edge", Rect{X: 20, Y: 100, Width: 40, Height: 20}, "left-end", Position{X: 60, Y: 70, Placement: "right-end"}},
+		{"no flip when neither side fits", Rect{X: 300, Y: 0, Width: 40, Height: 600}, "bottom", Position{X: 270, Y: 600, Placement: "bottom"}},
+		{"unknown placement defaults to bottom", Rect{X: 300, Y: 100, Width: 40, Height: 20}, "middle", Position{X: 270, Y: 120, Placement: "bottom"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computePosition(tt.anchor, popup, viewport, tt.placement); got != tt.want {
+				t.Errorf("computePosition(%+v, %q) = %+v, want %+v", tt.anchor, tt.placement, got, tt.want)
+			}
+		})
+	}
+}
@@ -59,7 +59,7 @@ func (re *ReactiveElement) BindText(textSignal reactivity.Signal[string]) *React
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		re.element.SetTextContent(textSignal.Get())
 	})
 	reactivity.SetCurrentCleanupScope(prevScope)
@@ -79,7 +79,7 @@ func (re *ReactiveElement) BindTextFunc(textFn func() string) *ReactiveElement {
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		re.element.SetTextContent(textFn())
 	})
 	reactivity.SetCurrentCleanupScope(prevScope)
@@ -99,7 +99,7 @@ func (re *ReactiveElement) BindHTML(htmlSignal reactivity.Signal[string]) *React
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		re.element.SetInnerHTML(htmlSignal.Get())
 	})
 	reactivity.SetCurrentCleanupScope(prevScope)
@@ -119,7 +119,7 @@ func (re *ReactiveElement) BindHTMLFunc(htmlFn func() string) *ReactiveElement {
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		re.element.SetInnerHTML(htmlFn())
 	})
 	reactivity.SetCurrentCleanupScope(prevScope)
@@ -139,7 +139,7 @@ func (re *ReactiveElement) BindAttribute(attrName string, valueSignal reactivity
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		re.element.SetAttribute(attrName, valueSignal.Get())
 	})
 	reactivity.SetCurrentCleanupScope(prevScope)
@@ -159,7 +159,7 @@ func (re *ReactiveElement) BindAttributeFunc(attrName string, valueFn func() str
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		re.element.SetAttribute(attrName, valueFn())
 	})
 	reactivity.SetCurrentCleanupScope(prevScope)
@@ -203,7 +203,7 @@ func (re *ReactiveElement) BindVisibility(visibleSignal reactivity.Signal[bool])
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		if visibleSignal.Get() {
 			re.element.SetAttribute("style", "display: block;")
 		} else {
@@ -231,7 +231,7 @@ func (re *ReactiveElement) BindVisibilityFunc(visibleFn func() bool) *ReactiveEl
 	// Set element's scope as current scope for effect creation
 	prevScope := reactivity.GetCurrentCleanupScope()
 	reactivity.SetCurrentCleanupScope(re.scope)
-	effect := reactivity.CreateEffect(func() {
+	effect := reactivity.CreateRenderEffect(func() {
 		if visibleFn() {
 			re.element.SetAttribute("style", "display: block;")
 		} else {
@@ -0,0 +1,239 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"strings"
+	"syscall/js"
+	"time"
+)
+
+// RovingOptions configures RovingFocus.
+type RovingOptions struct {
+	// ItemSelector matches the focusable items inside the container.
+	ItemSelector string
+	// Wrap moves from the last item to the first (and back) instead of stopping.
+	Wrap bool
+	// Orientation selects the arrow keys: "vertical" (Up/Down, default),
+	// "horizontal" (Left/Right) or "both".
+	Orientation string
+}
+
+// typeaheadTimeout is how long typed characters accumulate into one query.
+const typeaheadTimeout = 500 * time.Millisecond
+
+// RovingFocus manages a roving tabindex across the items in container that
+// match opts.ItemSelector: exactly one item has tabindex=0, arrow keys move
+// focus between items, Home/End jump to the ends, and typing a letter jumps
+// to the next item whose text starts with it. Items added or removed later
+// are picked up automatically. The returned function removes all listeners.
+func RovingFocus(container Element, opts RovingOptions) (dispose func()) {
+	root := container.Underlying()
+	items := func() []js.Value {
+		nodes := root.Call("querySelectorAll", opts.ItemSelector)
+		res := make([]js.Value, 0, nodes.Get("length").Int())
+		for i := 0; i < nodes.Get("length").Int(); i++ {
+			item := nodes.Index(i)
+			if item.Call("hasAttribute", "disabled").Bool() || item.Call("getAttribute", "aria-disabled").String() == "true" {
+				continue
+			}
+			res = append(res, item)
+		}
+		return res
+	}
+	indexOf := func(list []js.Value, el js.Value) int {
+		for i, item := range list {
+			if item.Equal(el) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	active := js.Null()
+	activate := func(list []js.Value, index int, focus bool) {
+		for i, item := range list {
+			if i == index {
+				item.Call("setAttribute", "tabindex", "0")
+			} else {
+				item.Call("setAttribute", "tabindex", "-1")
+			}
+		}
+		if index >= 0 && index < len(list) {
+			active = list[index]
+			if focus {
+				active.Call("focus")
+			}
+		}
+	}
+	// resync restores the single tabindex=0 item after items change
+	resync := func() {
+		list := items()
+		if len(list) == 0 {
+			active = js.Null()
+			return
+		}
+		index := indexOf(list, active)
+		if index < 0 {
+			for i, item := range list {
+				if item.Call("getAttribute", "tabindex").String() == "0" {
+					index = i
+					break
+				}
+			}
+		}
+		if index < 0 {
+			index = 0
+		}
+		activate(list, index, false)
+	}
+	resync()
+
+	var typed string
+	var lastTyped time.Time
+	keydown := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		evt := args[0]
+		list := items()
+		current := indexOf(list, evt.Get("target").Call("closest", opts.ItemSelector))
+		if current < 0 {
+			return nil
+		}
+		key := evt.Get("key").String()
+		if next, ok := rovingNextIndex(current, len(list), key, opts); ok {
+			evt.Call("preventDefault")
+			activate(list, next, true)
+			return nil
+		}
+		if len([]rune(key)) != 1 || evt.Get("ctrlKey").Bool() || evt.Get("metaKey").Bool() || evt.Get("altKey").Bool() {
+			return nil
+		}
+		if time.Since(lastTyped) > typeaheadTimeout {
+			typed = ""
+		}
+		lastTyped = time.Now()
+		typed += strings.ToLower(key)
+		labels := make([]string, len(list))
+		for i, item := range list {
+			labels[i] = item.Get("textContent").String()
+		}
+		if next := typeaheadMatch(labels, current, typed); next >= 0 {
+			activate(list, next, true)
+		}
+		return nil
+	})
+	focusin := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		list := items()
+		if index := indexOf(list, args[0].Get("target")); index >= 0 {
+			activate(list, index, false)
+		}
+		return nil
+	})
+	root.Call("addEventListener", "keydown", keydown)
+	root.Call("addEventListener", "focusin", focusin)
+
+	var observer js.Value
+	mutated := js.FuncOf(func(this js.Value, args []js.Value) any {
+		resync()
+		return nil
+	})
+	if ctor := js.Global().Get("MutationObserver"); ctor.Truthy() {
+		observer = ctor.New(mutated)
+		observer.Call("observe", root, map[string]any{"childList": true, "subtree": true})
+	}
+
+	disposed := false
+	return func() {
+		if disposed {
+			return
+		}
+		disposed = true
+		if observer.Truthy() {
+			observer.Call("disconnect")
+		}
+		root.Call("removeEventListener", "keydown", keydown)
+		root.Call("removeEventListener", "focusin", focusin)
+		keydown.Release()
+		focusin.Release()
+		mutated.Release()
+	}
+}
+
+// rovingNextIndex returns the item index a navigation key moves to, and
+// whether key navigates for the configured orientation.
+func rovingNextIndex(current, count int, key string, opts RovingOptions) (int, bool) {
+	if count == 0 {
+		return 0, false
+	}
+	horizontal := opts.Orientation == "horizontal" || opts.Orientation == "both"
+	vertical := opts.Orientation != "horizontal"
+
+	step := 0
+	switch key {
+	case "ArrowDown":
+		if vertical {
+			step = 1
+		}
+	case "ArrowUp":
+		if vertical {
+			step = -1
+		}
+	case "ArrowRight":
+		if horizontal {
+			step = 1
+		}
+	case "ArrowLeft":
+		if horizontal {
+			step = -1
+		}
+	case "Home":
+		return 0, true
+	case "End":
+		return count - 1, true
+	}
+	if step == 0 {
+		return current, false
+	}
+
+	next := current + step
+	switch {
+	case next >= count && opts.Wrap:
+		next = 0
+	case next >= count:
+		next = count - 1
+	case next < 0 && opts.Wrap:
+		next = count - 1
+	case next < 0:
+		next = 0
+	}
+	return next, true
+}
+
+// typeaheadMatch returns the index of the first label after current that
+// starts with query (case-insensitive), wrapping around, or -1. A query of a
+// single repeated letter cycles through items starting with that letter.
+func typeaheadMatch(labels []string, current int, query string) int {
+	if query == "" || len(labels) == 0 {
+		return -1
+	}
+	if strings.Count(query, query[:1]) == len(query) {
+		query = query[:1]
+	}
+	// A multi-letter query may still match the current item
+	start := 1
+	if len(query) > 1 {
+		start = 0
+	}
+	for i := start; i < len(labels)+start; i++ {
+		index := (current + i) % len(labels)
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(labels[index])), query) {
+			return index
+		}
+	}
+	return -1
+}
@@ -0,0 +1,113 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+	"testing"
+
+	domv2 "honnef.co/go/js/dom/v2"
+)
+
+func TestRovingNextIndexWrap(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int
+		key     string
+		opts    RovingOptions
+		want    int
+		handled bool
+	}{
+		{"down moves forward", 0, "ArrowDown", RovingOptions{}, 1, true},
+		{"down stops at end without wrap", 2, "ArrowDown", RovingOptions{}, 2, true},
+		{"down wraps to start", 2, "ArrowDown", RovingOptions{Wrap: true}, 0, true},
+		{"up wraps to end", 0, "ArrowUp", RovingOptions{Wrap: true}, 2, true},
+		{"up stops at start without wrap", 0, "ArrowUp", RovingOptions{}, 0, true},
+		{"right ignored when vertical", 0, "ArrowRight", RovingOptions{}, 0, false},
+		{"right wraps when horizontal", 2, "ArrowRight", RovingOptions{Wrap: true, Orientation: "horizontal"}, 0, true},
+		{"down ignored when horizontal", 0, "ArrowDown", RovingOptions{Orientation: "horizontal"}, 0, false},
+		{"both accepts left", 1, "ArrowLeft", RovingOptions{Orientation: "both"}, 0, true},
+		{"home", 2, "Home", RovingOptions{}, 0, true},
+		{"end", 0, "End", RovingOptions{}, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, handled := rovingNextIndex(tt.current, 3, tt.key, tt.opts)
+			if got != tt.want || handled != tt.handled {
+				t.Errorf("rovingNextIndex(%d, 3, %q) = %d, %v; want %d, %v", tt.current, tt.key, got, handled, tt.want, tt.handled)
+			}
+		})
+	}
+}
+
+func TestTypeaheadMatch(t *testing.T) {
+	labels := []string{"Apple", "Banana", "Blueberry", "Cherry", "Avocado"}
+	tests := []struct {
+		current int
+		query   string
+		want    int
+	}{
+		{0, "b", 1},  // next item starting with b
+		{1, "b", 2},  // cycles to the following b item
+		{2, "b", 1},  // wraps around
+		{2, "bb", 1}, // repeated letter cycles like a single letter
+		{0, "bl", 2}, // multi-letter prefix
+		{3, "a", 4},  // searches after the current item first
+		{0, "z", -1}, // no match
+	}
+	for _, tt := range tests {
+		if got := typeaheadMatch(labels, tt.current, tt.query); got != tt.want {
+			t.Errorf("typeaheadMatch(from %d, %q) = %d, want %d", tt.current, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestRovingFocusManagesTabindexAndTypeahead(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	doc := js.Global().Get("document")
+	list := doc.Call("createElement", "ul")
+	list.Set("innerHTML", `<li role="option">Red</li><li role="option">Green</li><li role="option">Blue</li>`)
+	doc.Get("body").Call("appendChild", list)
+	defer list.Call("remove")
+
+	dispose := RovingFocus(domv2.WrapElement(list), RovingOptions{ItemSelector: "[role=option]", Wrap: true})
+	defer dispose()
+
+	items := list.Call("querySelectorAll", "[role=option]")
+	tabindexes := func() string {
+		s := ""
+		for i := 0; i < items.Get("length").Int(); i++ {
+			s += items.Index(i).Call("getAttribute", "tabindex").String() + " "
+		}
+		return s
+	}
+	if got := tabindexes(); got != "0 -1 -1 " {
+		t.Fatalf("Expected first item to be the tab stop, got %q", got)
+	}
+
+	press := func(target js.Value, key string) {
+		evt := js.Global().Get("KeyboardEvent").New("keydown", map[string]any{"key": key, "bubbles": true})
+		target.Call("dispatchEvent", evt)
+	}
+
+	press(items.Index(0), "ArrowUp")
+	if got := tabindexes(); got != "-1 -1 0 " {
+		t.Errorf("Expected ArrowUp to wrap to the last item, got %q", got)
+	}
+
+	press(items.Index(2), "g")
+	if got := tabindexes(); got != "-1 0 -1 " {
+		t.Errorf("Expected typeahead 'g' to select Green, got %q", got)
+	}
+
+	// Items added later become part of the roving set
+	list.Call("insertAdjacentHTML", "beforeend", `<li role="option">Yellow</li>`)
+	press(items.Index(1), "End")
+	items = list.Call("querySelectorAll", "[role=option]")
+	if got := items.Index(3).Call("getAttribute", "tabindex").String(); got != "0" {
+		t.Errorf("Expected End to reach the newly added item, got tabindex %q", got)
+	}
+}
@@ -0,0 +1,107 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// ScrollProgress returns a signal holding how far target has been scrolled,
+// from 0 (top) to 1 (bottom). Without a target the document is tracked.
+//
+// The value is recomputed at most once per animation frame from a passive
+// scroll listener, and again whenever the scroller or its content changes
+// size, so content loaded later keeps the value accurate. A scroller whose
+// content fits without scrolling reports 0. Listeners are removed when the
+// current cleanup scope is disposed.
+func ScrollProgress(target ...Element) reactivity.Signal[float64] {
+	progress := reactivity.CreateSignal(0.0)
+
+	var scroller, listenTarget js.Value
+	var observed []js.Value
+	if len(target) > 0 && target[0] != nil {
+		scroller = target[0].Underlying()
+		listenTarget = scroller
+		observed = append(observed, scroller)
+		children := scroller.Get("children")
+		for i := 0; i < children.Length(); i++ {
+			observed = append(observed, children.Index(i))
+		}
+	} else {
+		doc := js.Global().Get("document")
+		scroller = doc.Get("scrollingElement")
+		if !scroller.Truthy() {
+			scroller = doc.Get("documentElement")
+		}
+		listenTarget = js.Global()
+		observed = append(observed, doc.Get("documentElement"))
+		if body := doc.Get("body"); body.Truthy() {
+			observed = append(observed, body)
+		}
+	}
+
+	measure := func() {
+		progress.Set(scrollProgressRatio(
+			scroller.Get("scrollTop").Float(),
+			scroller.Get("scrollHeight").Float(),
+			scroller.Get("clientHeight").Float(),
+		))
+	}
+
+	scheduled := false
+	var frameID js.Value
+	frameFn := js.FuncOf(func(this js.Value, args []js.Value) any {
+		scheduled = false
+		measure()
+		return nil
+	})
+	schedule := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if scheduled {
+			return nil
+		}
+		scheduled = true
+		frameID = js.Global().Call("requestAnimationFrame", frameFn)
+		return nil
+	})
+
+	listenTarget.Call("addEventListener", "scroll", schedule, map[string]any{"passive": true})
+
+	ro := newResizeObserver(schedule)
+	for _, el := range observed {
+		ro.Call("observe", el)
+	}
+
+	measure()
+
+	reactivity.RegisterCleanup(func() {
+		listenTarget.Call("removeEventListener", "scroll", schedule, map[string]any{"passive": true})
+		if ro.Truthy() {
+			ro.Call("disconnect")
+		}
+		if scheduled {
+			js.Global().Call("cancelAnimationFrame", frameID)
+		}
+		schedule.Release()
+		frameFn.Release()
+	})
+
+	return progress
+}
+
+// scrollProgressRatio converts scroll metrics into a 0–1 progress value.
+func scrollProgressRatio(scrollTop, scrollHeight, clientHeight float64) float64 {
+	max := scrollHeight - clientHeight
+	if max <= 0 {
+		return 0
+	}
+	ratio := scrollTop / max
+	if ratio < 0 {
+		return 0
+	}
+	if ratio > 1 {
+		return 1
+	}
+	return ratio
+}
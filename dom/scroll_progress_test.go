@@ -0,0 +1,90 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"math"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+)
+
+func TestScrollProgressRatio(t *testing.T) {
+	tests := []struct {
+		name                      string
+		top, height, clientHeight float64
+		want                      float64
+	}{
+		{"top", 0, 1000, 200, 0},
+		{"middle", 400, 1000, 200, 0.5},
+		{"bottom", 800, 1000, 200, 1},
+		{"overscroll clamps high", 900, 1000, 200, 1},
+		{"negative clamps low", -20, 1000, 200, 0},
+		{"content fits", 0, 200, 200, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrollProgressRatio(tt.top, tt.height, tt.clientHeight); got != tt.want {
+				t.Errorf("scrollProgressRatio(%v, %v, %v) = %v, want %v", tt.top, tt.height, tt.clientHeight, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrollProgressTracksContainer(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	doc := js.Global().Get("document")
+	scroller := doc.Call("createElement", "div")
+	scroller.Get("style").Set("cssText", "height: 100px; overflow-y: scroll")
+	content := doc.Call("createElement", "div")
+	content.Get("style").Set("height", "500px")
+	scroller.Call("appendChild", content)
+	doc.Get("body").Call("appendChild", scroller)
+	defer scroller.Call("remove")
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	progress := ScrollProgress(domv2.WrapElement(scroller))
+	reactivity.SetCurrentCleanupScope(prev)
+	defer scope.Dispose()
+
+	if got := progress.Get(); got != 0 {
+		t.Fatalf("initial progress = %v, want 0", got)
+	}
+
+	scrollTo := func(top float64) {
+		scroller.Set("scrollTop", top)
+		scroller.Call("dispatchEvent", js.Global().Get("Event").New("scroll"))
+		time.Sleep(50 * time.Millisecond)
+	}
+	near := func(got, want float64) bool { return math.Abs(got-want) < 0.01 }
+
+	scrollTo(200)
+	if got := progress.Get(); !near(got, 0.5) {
+		t.Errorf("progress at middle = %v, want 0.5", got)
+	}
+	scrollTo(400)
+	if got := progress.Get(); !near(got, 1) {
+		t.Errorf("progress at bottom = %v, want 1", got)
+	}
+
+	// Growing the content moves the bottom further away without a scroll event.
+	content.Get("style").Set("height", "900px")
+	time.Sleep(50 * time.Millisecond)
+	if got := progress.Get(); !near(got, 0.5) {
+		t.Errorf("progress after content growth = %v, want 0.5", got)
+	}
+
+	// After the scope is disposed scroll events are no longer tracked.
+	scope.Dispose()
+	scrollTo(0)
+	if got := progress.Get(); !near(got, 0.5) {
+		t.Errorf("progress changed after dispose: %v", got)
+	}
+}
@@ -0,0 +1,137 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"reflect"
+	"syscall/js"
+	"testing"
+	"time"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	h "maragu.dev/gomponents/html"
+)
+
+// mountSearch renders a search input into a container attached to body and
+// returns the input, the queries the handler received and a disposer.
+func mountSearch(t *testing.T, delayMs int, opts ...SearchOptions) (js.Value, *[]string, func()) {
+	t.Helper()
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+
+	queries := &[]string{}
+	var buf bytes.Buffer
+	_ = h.Input(OnSearchInline(func(el Element, query string) {
+		*queries = append(*queries, query)
+	}, delayMs, opts...)).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+
+	return container.Call("querySelector", "input"), queries, func() {
+		scope.Dispose()
+		container.Call("remove")
+	}
+}
+
+func typeInto(input js.Value, value string) {
+	input.Set("value", value)
+	input.Call("dispatchEvent", js.Global().Get("Event").New("input", map[string]any{"bubbles": true}))
+}
+
+func TestOnSearchInlineMinChars(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input, queries, dispose := mountSearch(t, 10, SearchOptions{MinChars: 3})
+	defer dispose()
+
+	typeInto(input, "go")
+	time.Sleep(30 * time.Millisecond)
+	if len(*queries) != 0 {
+		t.Fatalf("queries below MinChars should be suppressed, got %v", *queries)
+	}
+
+	typeInto(input, "gom")
+	time.Sleep(30 * time.Millisecond)
+	// Shortening the query again cancels the pending call
+	typeInto(input, "gomp")
+	typeInto(input, "g")
+	time.Sleep(30 * time.Millisecond)
+	if want := []string{"gom"}; !reflect.DeepEqual(*queries, want) {
+		t.Errorf("queries = %v, want %v", *queries, want)
+	}
+}
+
+func TestOnSearchInlineClearBelowMinChars(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input, queries, dispose := mountSearch(t, 10, SearchOptions{MinChars: 2, ClearBelowMinChars: true})
+	defer dispose()
+
+	typeInto(input, "a")
+	if want := []string{""}; !reflect.DeepEqual(*queries, want) {
+		t.Errorf("short query should clear immediately, got %v", *queries)
+	}
+}
+
+func TestOnSearchInlineFlushOnClear(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input, queries, dispose := mountSearch(t, 50, SearchOptions{FlushOnClear: true})
+	defer dispose()
+
+	typeInto(input, "tasks")
+	typeInto(input, "")
+	if want := []string{""}; !reflect.DeepEqual(*queries, want) {
+		t.Fatalf("clearing should report immediately, got %v", *queries)
+	}
+	time.Sleep(80 * time.Millisecond)
+	if want := []string{""}; !reflect.DeepEqual(*queries, want) {
+		t.Errorf("the pending query should have been dropped, got %v", *queries)
+	}
+}
+
+func TestOnSearchInlineDropsCallForRemovedInput(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input, queries, dispose := mountSearch(t, 10)
+	defer dispose()
+
+	typeInto(input, "removed")
+	input.Call("remove")
+	time.Sleep(30 * time.Millisecond)
+	if len(*queries) != 0 {
+		t.Errorf("handler ran for a removed input: %v", *queries)
+	}
+}
+
+func TestCancelSearchInline(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	input, queries, dispose := mountSearch(t, 10)
+	defer dispose()
+
+	typeInto(input, "cancelled")
+	CancelSearchInline(domv2.WrapElement(input))
+	time.Sleep(30 * time.Millisecond)
+	if len(*queries) != 0 {
+		t.Errorf("cancelled search still ran: %v", *queries)
+	}
+}
@@ -0,0 +1,136 @@
+package dom
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// selectionModelSeq numbers selection models so OnSelectClickInline can
+// find the rows that belong to one.
+var selectionModelSeq uint64
+
+// SelectionModel tracks which items of a list are selected, with the
+// anchor used by range selection. Create it with NewSelectionModel.
+type SelectionModel[K comparable] struct {
+	id        string
+	selected  reactivity.Signal[map[K]bool]
+	rows      map[K]reactivity.Signal[bool]
+	anchor    K
+	hasAnchor bool
+}
+
+// NewSelectionModel returns an empty selection.
+func NewSelectionModel[K comparable]() *SelectionModel[K] {
+	return &SelectionModel[K]{
+		id:       "sel-" + strconv.FormatUint(atomic.AddUint64(&selectionModelSeq, 1), 36),
+		selected: reactivity.CreateSignal(map[K]bool{}),
+		rows:     make(map[K]reactivity.Signal[bool]),
+	}
+}
+
+// Selected returns a signal holding the set of selected ids. The map is
+// replaced, never modified, on every change.
+func (s *SelectionModel[K]) Selected() reactivity.ReadonlySignal[map[K]bool] {
+	return s.selected
+}
+
+// IsSelected reports whether id is selected. Inside an effect it tracks
+// only id, so a selection change re-runs the effects of the rows whose
+// state flipped rather than every row.
+func (s *SelectionModel[K]) IsSelected(id K) bool {
+	row, ok := s.rows[id]
+	if !ok {
+		row = reactivity.CreateSignal(s.selected.Peek()[id])
+		s.rows[id] = row
+	}
+	return row.Get()
+}
+
+// Anchor returns the item range selections extend from: the last item
+// selected or toggled on its own. ok is false when there is none.
+func (s *SelectionModel[K]) Anchor() (id K, ok bool) {
+	return s.anchor, s.hasAnchor
+}
+
+// Select makes id the only selected item and the anchor.
+func (s *SelectionModel[K]) Select(id K) {
+	s.setAnchor(id)
+	s.set(map[K]bool{id: true})
+}
+
+// Toggle adds or removes id from the selection and makes it the anchor.
+func (s *SelectionModel[K]) Toggle(id K) {
+	s.setAnchor(id)
+	next := s.copySelected()
+	if next[id] {
+		delete(next, id)
+	} else {
+		next[id] = true
+	}
+	s.set(next)
+}
+
+// SelectRange selects the items from anchor to id, inclusive, in the
+// order given by orderedIDs (the order the list is displayed in), replacing
+// the current selection. The anchor is kept so further range selections
+// extend from the same item. If anchor is not in orderedIDs only id is
+// selected.
+func (s *SelectionModel[K]) SelectRange(anchor, id K, orderedIDs []K) {
+	from, to := -1, -1
+	for i, k := range orderedIDs {
+		if k == anchor {
+			from = i
+		}
+		if k == id {
+			to = i
+		}
+	}
+	if from < 0 || to < 0 {
+		s.Select(id)
+		return
+	}
+	if from > to {
+		from, to = to, from
+	}
+	s.setAnchor(anchor)
+	next := make(map[K]bool, to-from+1)
+	for _, k := range orderedIDs[from : to+1] {
+		next[k] = true
+	}
+	s.set(next)
+}
+
+// Clear deselects everything and forgets the anchor.
+func (s *SelectionModel[K]) Clear() {
+	var zero K
+	s.anchor, s.hasAnchor = zero, false
+	s.set(map[K]bool{})
+}
+
+func (s *SelectionModel[K]) setAnchor(id K) {
+	s.anchor, s.hasAnchor = id, true
+}
+
+func (s *SelectionModel[K]) copySelected() map[K]bool {
+	current := s.selected.Peek()
+	next := make(map[K]bool, len(current)+1)
+	for k := range current {
+		next[k] = true
+	}
+	return next
+}
+
+// set replaces the selection, updating only the rows whose state changed.
+func (s *SelectionModel[K]) set(next map[K]bool) {
+	prev := s.selected.Peek()
+	reactivity.Batch(func() {
+		s.selected.Set(next)
+		for id, row := range s.rows {
+			if prev[id] != next[id] {
+				row.Set(next[id])
+			}
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package dom
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+func TestSelectionModelToggleAndClear(t *testing.T) {
+	sel := NewSelectionModel[string]()
+	sel.Select("a")
+	sel.Toggle("c")
+	if got, want := sel.Selected().Get(), map[string]bool{"a": true, "c": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Selected = %v, want %v", got, want)
+	}
+	sel.Toggle("a")
+	if got, want := sel.Selected().Get(), map[string]bool{"c": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Selected after toggling a off = %v, want %v", got, want)
+	}
+	if anchor, ok := sel.Anchor(); !ok || anchor != "a" {
+		t.Errorf("Anchor = %q, %v; want a, true", anchor, ok)
+	}
+
+	sel.Clear()
+	if len(sel.Selected().Get()) != 0 {
+		t.Errorf("Selected after Clear = %v", sel.Selected().Get())
+	}
+	if _, ok := sel.Anchor(); ok {
+		t.Error("expected Clear to forget the anchor")
+	}
+}
+
+func TestSelectionModelRangeFollowsDisplayOrder(t *testing.T) {
+	sel := NewSelectionModel[string]()
+	sel.Select("b")
+	sel.SelectRange("b", "d", []string{"a", "b", "c", "d", "e"})
+	if got, want := sel.Selected().Get(), map[string]bool{"b": true, "c": true, "d": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("range b..d = %v, want %v", got, want)
+	}
+
+	// After reordering, the same range covers whatever is displayed between
+	// the anchor and the clicked row, and may run backwards
+	reordered := []string{"d", "a", "e", "b", "c"}
+	sel.SelectRange("b", "a", reordered)
+	if got, want := sel.Selected().Get(), map[string]bool{"a": true, "e": true, "b": true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("range b..a in reordered list = %v, want %v", got, want)
+	}
+	if anchor, _ := sel.Anchor(); anchor != "b" {
+		t.Errorf("expected the anchor to stay b, got %q", anchor)
+	}
+
+	// An anchor that is no longer listed selects just the clicked row
+	sel.SelectRange("gone", "c", reordered)
+	if got, want := sel.Selected().Get(), map[string]bool{"c": true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("range from a missing anchor = %v, want %v", got, want)
+	}
+}
+
+func TestSelectionModelIsSelectedTracksRowsIndividually(t *testing.T) {
+	sel := NewSelectionModel[int]()
+	runs := map[int]int{}
+	for id := 0; id < 100; id++ {
+		id := id
+		eff := reactivity.CreateEffect(func() {
+			sel.IsSelected(id)
+			runs[id]++
+		})
+		defer eff.Dispose()
+	}
+
+	sel.Select(5)
+	sel.Toggle(7)
+	total := 0
+	for _, n := range runs {
+		total += n
+	}
+	// 100 initial runs, then 5 flips on and 7 flips on
+	if total != 102 || runs[5] != 2 || runs[7] != 2 {
+		t.Errorf("effect runs = %d (5: %d, 7: %d), want 102 (2, 2)", total, runs[5], runs[7])
+	}
+	if !sel.IsSelected(5) || !sel.IsSelected(7) || sel.IsSelected(6) {
+		t.Error("IsSelected disagrees with the selection")
+	}
+}
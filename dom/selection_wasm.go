@@ -0,0 +1,105 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/logutil"
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// inlineSelectClickHandlers maps OnSelectClickInline ids to their handlers,
+// which receive the clicked row and the click event.
+var inlineSelectClickHandlers = map[string]func(Element, js.Value){}
+
+// OnSelectClickInline makes the element a selectable row of sel. A plain
+// click selects just the row, Ctrl/Cmd-click toggles it and Shift-click
+// selects the range from the anchor to the row in the order the rows of
+// sel currently appear in the document, so reordering the list is taken
+// into account. idFn returns the id of a row element.
+func OnSelectClickInline[K comparable](idFn func(el Element) K, sel *SelectionModel[K]) g.Node {
+	id := nextInlineID("selclk")
+	inlineHandlersMu.Lock()
+	inlineSelectClickHandlers[id] = func(el Element, event js.Value) {
+		key := idFn(el)
+		anchor, hasAnchor := sel.Anchor()
+		switch {
+		case event.Get("shiftKey").Truthy() && hasAnchor:
+			rows := js.Global().Get("document").Call("querySelectorAll", `[data-uiwgo-selection="`+sel.id+`"]`)
+			ordered := make([]K, 0, rows.Length())
+			for i := 0; i < rows.Length(); i++ {
+				ordered = append(ordered, idFn(domv2.WrapElement(rows.Index(i))))
+			}
+			sel.SelectRange(anchor, key, ordered)
+		case event.Get("ctrlKey").Truthy() || event.Get("metaKey").Truthy():
+			sel.Toggle(key)
+		default:
+			sel.Select(key)
+		}
+	}
+	inlineHandlersMu.Unlock()
+	return g.Group([]g.Node{
+		g.Attr("data-uiwgo-onselectclick", id),
+		g.Attr("data-uiwgo-selection", sel.id),
+	})
+}
+
+// attachSelectClicksIn installs the delegated click listener for
+// OnSelectClickInline rows under root.
+func attachSelectClicksIn(root js.Value) {
+	const marker = "[data-uiwgo-onselectclick]"
+	nodes := root.Call("querySelectorAll", marker)
+	if nodes.Length() == 0 {
+		return
+	}
+	ids := make([]string, 0, nodes.Length())
+	for i := 0; i < nodes.Length(); i++ {
+		ids = append(ids, nodes.Index(i).Call("getAttribute", "data-uiwgo-onselectclick").String())
+	}
+
+	fn := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		event := args[0]
+		target := event.Get("target")
+		if !target.Truthy() {
+			return nil
+		}
+		matched := target.Call("closest", marker)
+		if !matched.Truthy() || !claimInlineEvent(event, marker) {
+			return nil
+		}
+		inlineHandlersMu.RLock()
+		h := inlineSelectClickHandlers[matched.Call("getAttribute", "data-uiwgo-onselectclick").String()]
+		inlineHandlersMu.RUnlock()
+		if h == nil {
+			return nil
+		}
+		if event.Get("shiftKey").Truthy() {
+			// Keep the browser from selecting text across the range
+			event.Call("preventDefault")
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				logutil.Logf("panic in inline select click: %v", r)
+			}
+		}()
+		h(domv2.WrapElement(matched), event)
+		return nil
+	})
+	root.Call("addEventListener", "click", fn)
+
+	reactivity.OnCleanup(func() {
+		root.Call("removeEventListener", "click", fn)
+		fn.Release()
+		inlineHandlersMu.Lock()
+		for _, id := range ids {
+			delete(inlineSelectClickHandlers, id)
+		}
+		inlineHandlersMu.Unlock()
+	})
+}
@@ -0,0 +1,70 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"reflect"
+	"syscall/js"
+	"testing"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+func TestOnSelectClickInlineUsesModifiersAndDocumentOrder(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+	defer container.Call("remove")
+
+	sel := NewSelectionModel[string]()
+	rowID := func(el Element) string { return el.GetAttribute("data-id") }
+	rows := []g.Node{}
+	for _, id := range []string{"a", "b", "c", "d"} {
+		rows = append(rows, g.El("li", g.Attr("data-id", id), OnSelectClickInline(rowID, sel), g.Text(id)))
+	}
+	var buf bytes.Buffer
+	_ = g.El("ul", rows...).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	eff := reactivity.CreateEffect(func() { AttachInlineDelegates(container) })
+	defer eff.Dispose()
+
+	click := func(id string, modifiers map[string]any) {
+		init := map[string]any{"bubbles": true}
+		for k, v := range modifiers {
+			init[k] = v
+		}
+		row := container.Call("querySelector", `[data-id="`+id+`"]`)
+		row.Call("dispatchEvent", js.Global().Get("MouseEvent").New("click", init))
+	}
+	expect := func(want ...string) {
+		t.Helper()
+		wantSet := map[string]bool{}
+		for _, id := range want {
+			wantSet[id] = true
+		}
+		if got := sel.Selected().Get(); !reflect.DeepEqual(got, wantSet) {
+			t.Errorf("Selected = %v, want %v", got, wantSet)
+		}
+	}
+
+	click("b", nil)
+	expect("b")
+	click("d", map[string]any{"ctrlKey": true})
+	expect("b", "d")
+
+	// Move d to the front: d, a, b, c. A shift-click on a from anchor d
+	// now covers only d and a.
+	list := container.Call("querySelector", "ul")
+	list.Call("prepend", container.Call("querySelector", `[data-id="d"]`))
+	click("a", map[string]any{"shiftKey": true})
+	expect("d", "a")
+
+	click("c", map[string]any{"shiftKey": true})
+	expect("d", "a", "b", "c")
+}
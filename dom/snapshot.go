@@ -0,0 +1,214 @@
+package dom
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SnapshotAttr is a single attribute captured in a DOM snapshot.
+type SnapshotAttr struct {
+	Name  string
+	Value string
+}
+
+// SnapshotNode is a normalized, printable representation of a DOM node.
+// Element nodes carry a lowercase Tag and sorted Attrs; text nodes use the
+// tag "#text" and carry their trimmed content in Text.
+type SnapshotNode struct {
+	Tag      string
+	Attrs    []SnapshotAttr
+	Text     string
+	Children []*SnapshotNode
+}
+
+// DOMSnapshot is a normalized tree captured from a live DOM subtree.
+// It is intended for test assertions and debugging reconciliation.
+type DOMSnapshot struct {
+	Root *SnapshotNode
+}
+
+// DOMChangeKind describes what kind of difference was found between snapshots.
+type DOMChangeKind string
+
+const (
+	DOMChangeAdded   DOMChangeKind = "added"   // node exists only in the new snapshot
+	DOMChangeRemoved DOMChangeKind = "removed" // node exists only in the old snapshot
+	DOMChangeTag     DOMChangeKind = "tag"     // node at the same path has a different tag
+	DOMChangeText    DOMChangeKind = "text"    // text node content changed
+	DOMChangeAttr    DOMChangeKind = "attr"    // attribute added, removed, or changed
+)
+
+// DOMChange reports a single difference between two snapshots.
+// Path addresses the node as slash-separated "tag[index]" segments, where
+// index is the position among the parent's children. For attribute changes
+// Attr holds the attribute name; Before/After are empty when the attribute
+// (or node) is absent on that side.
+type DOMChange struct {
+	Kind   DOMChangeKind
+	Path   string
+	Attr   string
+	Before string
+	After  string
+}
+
+// String renders the change in a compact, human-readable form.
+func (c DOMChange) String() string {
+	if c.Kind == DOMChangeAttr {
+		return fmt.Sprintf("%s %s @%s: %q -> %q", c.Kind, c.Path, c.Attr, c.Before, c.After)
+	}
+	return fmt.Sprintf("%s %s: %q -> %q", c.Kind, c.Path, c.Before, c.After)
+}
+
+// snapshotOptions controls snapshot normalization.
+type snapshotOptions struct {
+	stripMarkers bool
+}
+
+// SnapshotOption configures Snapshot normalization.
+type SnapshotOption func(*snapshotOptions)
+
+// SnapshotStripMarkers removes framework-internal marker attributes
+// (data-uiwgo-*, data-inline-* and friends) from the captured tree so that
+// assertions only see user-visible markup.
+func SnapshotStripMarkers() SnapshotOption {
+	return func(o *snapshotOptions) {
+		o.stripMarkers = true
+	}
+}
+
+// isMarkerAttr reports whether name is a framework-internal marker attribute.
+func isMarkerAttr(name string) bool {
+	return strings.HasPrefix(name, "data-uiwgo-") ||
+		strings.HasPrefix(name, "data-inline-") ||
+		name == "data-debounce-delay" ||
+		name == "data-search-delay"
+}
+
+// newSnapshotElement builds a normalized element node with sorted attributes.
+func newSnapshotElement(tag string, attrs map[string]string, opts snapshotOptions) *SnapshotNode {
+	n := &SnapshotNode{Tag: strings.ToLower(tag)}
+	for name, value := range attrs {
+		if opts.stripMarkers && isMarkerAttr(name) {
+			continue
+		}
+		n.Attrs = append(n.Attrs, SnapshotAttr{Name: name, Value: value})
+	}
+	sort.Slice(n.Attrs, func(i, j int) bool { return n.Attrs[i].Name < n.Attrs[j].Name })
+	return n
+}
+
+// String prints the snapshot as an indented tree, one node per line.
+func (s DOMSnapshot) String() string {
+	if s.Root == nil {
+		return ""
+	}
+	var b strings.Builder
+	writeSnapshotNode(&b, s.Root, 0)
+	return b.String()
+}
+
+func writeSnapshotNode(b *strings.Builder, n *SnapshotNode, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	if n.Tag == "#text" {
+		fmt.Fprintf(b, "%q\n", n.Text)
+		return
+	}
+	b.WriteString("<" + n.Tag)
+	for _, a := range n.Attrs {
+		fmt.Fprintf(b, " %s=%q", a.Name, a.Value)
+	}
+	b.WriteString(">\n")
+	for _, c := range n.Children {
+		writeSnapshotNode(b, c, depth+1)
+	}
+}
+
+// DiffSnapshots compares two snapshots positionally and reports the
+// added, removed, and changed nodes. Identical snapshots yield no changes.
+func DiffSnapshots(a, b DOMSnapshot) []DOMChange {
+	var changes []DOMChange
+	diffSnapshotNodes(a.Root, b.Root, "", 0, &changes)
+	return changes
+}
+
+func snapshotPath(parent string, n *SnapshotNode, index int) string {
+	seg := fmt.Sprintf("%s[%d]", n.Tag, index)
+	if parent == "" {
+		return seg
+	}
+	return parent + "/" + seg
+}
+
+// summary returns a one-line description of a node for added/removed changes.
+func (n *SnapshotNode) summary() string {
+	if n.Tag == "#text" {
+		return n.Text
+	}
+	return "<" + n.Tag + ">"
+}
+
+func diffSnapshotNodes(a, b *SnapshotNode, parent string, index int, changes *[]DOMChange) {
+	switch {
+	case a == nil && b == nil:
+		return
+	case a == nil:
+		*changes = append(*changes, DOMChange{Kind: DOMChangeAdded, Path: snapshotPath(parent, b, index), After: b.summary()})
+		return
+	case b == nil:
+		*changes = append(*changes, DOMChange{Kind: DOMChangeRemoved, Path: snapshotPath(parent, a, index), Before: a.summary()})
+		return
+	}
+
+	path := snapshotPath(parent, b, index)
+	if a.Tag != b.Tag {
+		*changes = append(*changes, DOMChange{Kind: DOMChangeTag, Path: path, Before: a.Tag, After: b.Tag})
+		return
+	}
+	if a.Tag == "#text" {
+		if a.Text != b.Text {
+			*changes = append(*changes, DOMChange{Kind: DOMChangeText, Path: path, Before: a.Text, After: b.Text})
+		}
+		return
+	}
+
+	diffSnapshotAttrs(a.Attrs, b.Attrs, path, changes)
+
+	n := len(a.Children)
+	if len(b.Children) > n {
+		n = len(b.Children)
+	}
+	for i := 0; i < n; i++ {
+		var ac, bc *SnapshotNode
+		if i < len(a.Children) {
+			ac = a.Children[i]
+		}
+		if i < len(b.Children) {
+			bc = b.Children[i]
+		}
+		diffSnapshotNodes(ac, bc, path, i, changes)
+	}
+}
+
+func diffSnapshotAttrs(a, b []SnapshotAttr, path string, changes *[]DOMChange) {
+	before := make(map[string]string, len(a))
+	for _, attr := range a {
+		before[attr.Name] = attr.Value
+	}
+	after := make(map[string]string, len(b))
+	for _, attr := range b {
+		after[attr.Name] = attr.Value
+	}
+
+	// Report changed/removed attributes first, then ones that only exist in b.
+	for _, attr := range a {
+		if v, ok := after[attr.Name]; !ok || v != attr.Value {
+			*changes = append(*changes, DOMChange{Kind: DOMChangeAttr, Path: path, Attr: attr.Name, Before: attr.Value, After: v})
+		}
+	}
+	for _, attr := range b {
+		if _, ok := before[attr.Name]; !ok {
+			*changes = append(*changes, DOMChange{Kind: DOMChangeAttr, Path: path, Attr: attr.Name, After: attr.Value})
+		}
+	}
+}
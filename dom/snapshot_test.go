@@ -0,0 +1,91 @@
+package dom
+
+import (
+	"strings"
+	"testing"
+)
+
+func snapText(s string) *SnapshotNode { return &SnapshotNode{Tag: "#text", Text: s} }
+
+func snapEl(tag string, attrs map[string]string, children ...*SnapshotNode) *SnapshotNode {
+	n := newSnapshotElement(tag, attrs, snapshotOptions{})
+	n.Children = children
+	return n
+}
+
+func TestDiffSnapshotsIdentical(t *testing.T) {
+	build := func() DOMSnapshot {
+		return DOMSnapshot{Root: snapEl("DIV", map[string]string{"class": "row", "id": "a"},
+			snapEl("span", nil, snapText("hello")),
+		)}
+	}
+	if changes := DiffSnapshots(build(), build()); len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiffSnapshotsSingleTextChange(t *testing.T) {
+	a := DOMSnapshot{Root: snapEl("table", nil,
+		snapEl("tr", nil, snapEl("td", nil, snapText("Widget")), snapEl("td", nil, snapText("$10"))),
+	)}
+	b := DOMSnapshot{Root: snapEl("table", nil,
+		snapEl("tr", nil, snapEl("td", nil, snapText("Widget")), snapEl("td", nil, snapText("$12"))),
+	)}
+
+	changes := DiffSnapshots(a, b)
+	if len(changes) != 1 {
+		t.Fatalf("expected exactly one change, got %v", changes)
+	}
+	c := changes[0]
+	if c.Kind != DOMChangeText || c.Path != "table[0]/tr[0]/td[1]/#text[0]" || c.Before != "$10" || c.After != "$12" {
+		t.Fatalf("unexpected change: %v", c)
+	}
+}
+
+func TestDiffSnapshotsAddedRemovedAndAttrs(t *testing.T) {
+	a := DOMSnapshot{Root: snapEl("ul", map[string]string{"class": "list", "data-x": "1"},
+		snapEl("li", nil, snapText("one")),
+		snapEl("li", nil, snapText("two")),
+	)}
+	b := DOMSnapshot{Root: snapEl("ul", map[string]string{"class": "list active", "title": "t"},
+		snapEl("li", nil, snapText("one")),
+	)}
+
+	got := make([]string, 0)
+	for _, c := range DiffSnapshots(a, b) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		`attr ul[0] @class: "list" -> "list active"`,
+		`attr ul[0] @data-x: "1" -> ""`,
+		`attr ul[0] @title: "" -> "t"`,
+		`removed ul[0]/li[1]: "<li>" -> ""`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	back := DiffSnapshots(b, a)
+	last := back[len(back)-1]
+	if last.Kind != DOMChangeAdded || last.Path != "ul[0]/li[1]" {
+		t.Fatalf("expected trailing added li, got %v", last)
+	}
+}
+
+func TestSnapshotStripMarkersAndString(t *testing.T) {
+	attrs := map[string]string{"data-uiwgo-txt": "t1", "data-uiwgo-bound-text": "1", "class": "c"}
+	stripped := newSnapshotElement("SPAN", attrs, snapshotOptions{stripMarkers: true})
+	if len(stripped.Attrs) != 1 || stripped.Attrs[0].Name != "class" {
+		t.Fatalf("expected only class attribute after stripping, got %v", stripped.Attrs)
+	}
+	kept := newSnapshotElement("SPAN", attrs, snapshotOptions{})
+	if len(kept.Attrs) != 3 || kept.Attrs[0].Name != "class" || kept.Attrs[1].Name != "data-uiwgo-bound-text" {
+		t.Fatalf("expected sorted attributes, got %v", kept.Attrs)
+	}
+
+	stripped.Children = []*SnapshotNode{snapText("hi")}
+	want := "<span class=\"c\">\n  \"hi\"\n"
+	if got := (DOMSnapshot{Root: stripped}).String(); got != want {
+		t.Fatalf("String() = %q, want %q", got, want)
+	}
+}
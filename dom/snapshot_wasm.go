@@ -0,0 +1,57 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"strings"
+	"syscall/js"
+)
+
+// Snapshot captures a normalized tree of root and its descendants.
+// Attributes are sorted by name, text is trimmed, and whitespace-only text
+// nodes and comments are dropped so snapshots compare stably across renders.
+func Snapshot(root Element, opts ...SnapshotOption) DOMSnapshot {
+	var o snapshotOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if root == nil {
+		return DOMSnapshot{}
+	}
+	return DOMSnapshot{Root: snapshotJSNode(root.Underlying(), o)}
+}
+
+// snapshotJSNode converts a raw DOM node into a SnapshotNode, returning nil
+// for nodes that are not part of the normalized tree.
+func snapshotJSNode(node js.Value, opts snapshotOptions) *SnapshotNode {
+	if !node.Truthy() {
+		return nil
+	}
+	switch node.Get("nodeType").Int() {
+	case 3: // TEXT_NODE
+		text := strings.TrimSpace(node.Get("textContent").String())
+		if text == "" {
+			return nil
+		}
+		return &SnapshotNode{Tag: "#text", Text: text}
+	case 1: // ELEMENT_NODE
+	default:
+		return nil
+	}
+
+	attrs := map[string]string{}
+	list := node.Get("attributes")
+	for i := 0; i < list.Get("length").Int(); i++ {
+		attr := list.Index(i)
+		attrs[attr.Get("name").String()] = attr.Get("value").String()
+	}
+	n := newSnapshotElement(node.Get("tagName").String(), attrs, opts)
+
+	children := node.Get("childNodes")
+	for i := 0; i < children.Get("length").Int(); i++ {
+		if child := snapshotJSNode(children.Index(i), opts); child != nil {
+			n.Children = append(n.Children, child)
+		}
+	}
+	return n
+}
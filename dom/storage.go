@@ -0,0 +1,159 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// StorageItem is a string signal backed by one Web Storage key. Reads
+// return the stored value, or "" when the key is absent; Set writes
+// through to storage.
+type StorageItem interface {
+	reactivity.Signal[string]
+	// Exists reports whether the key is currently present in storage.
+	Exists() reactivity.ReadonlySignal[bool]
+	// Remove deletes the key, leaving the value "" and Exists false.
+	Remove()
+}
+
+// storageItem implements StorageItem on top of a pair of signals.
+type storageItem struct {
+	reactivity.Signal[string]
+	exists  reactivity.Signal[bool]
+	area    string
+	storage js.Value
+	key     string
+}
+
+// storageItems lists live items per area and key so that writes through
+// one item reach the others in the same tab; the browser only fires the
+// storage event in other tabs.
+var storageItems = map[string][]*storageItem{}
+
+// LocalStorageItem returns a signal bound to key in localStorage. Changes
+// made by other tabs arrive through the window storage event. The listener
+// is removed when the current cleanup scope is disposed.
+func LocalStorageItem(key string) StorageItem {
+	return newStorageItem("localStorage", key)
+}
+
+// SessionStorageItem is like LocalStorageItem but uses sessionStorage.
+func SessionStorageItem(key string) StorageItem {
+	return newStorageItem("sessionStorage", key)
+}
+
+func newStorageItem(area, key string) StorageItem {
+	item := &storageItem{
+		Signal:  reactivity.CreateSignal(""),
+		exists:  reactivity.CreateSignal(false),
+		area:    area,
+		storage: webStorage(area),
+		key:     key,
+	}
+	if item.storage.Truthy() {
+		item.apply(item.storage.Call("getItem", key))
+	}
+
+	registryKey := area + "\x00" + key
+	storageItems[registryKey] = append(storageItems[registryKey], item)
+
+	onStorage := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 || !item.storage.Truthy() {
+			return nil
+		}
+		evt := args[0]
+		if area := evt.Get("storageArea"); area.Truthy() && !area.Equal(item.storage) {
+			return nil
+		}
+		k := evt.Get("key")
+		if k.Type() == js.TypeString && k.String() != key {
+			return nil
+		}
+		// A null key means the other tab called clear()
+		raw := js.Null()
+		if k.Type() == js.TypeString {
+			raw = evt.Get("newValue")
+		}
+		// Only local signals are updated here; writing back to storage
+		// would bounce the event between tabs.
+		item.apply(raw)
+		return nil
+	})
+	js.Global().Call("addEventListener", "storage", onStorage)
+
+	reactivity.RegisterCleanup(func() {
+		js.Global().Call("removeEventListener", "storage", onStorage)
+		onStorage.Release()
+		items := storageItems[registryKey]
+		for i, it := range items {
+			if it == item {
+				storageItems[registryKey] = append(items[:i], items[i+1:]...)
+				break
+			}
+		}
+		if len(storageItems[registryKey]) == 0 {
+			delete(storageItems, registryKey)
+		}
+	})
+
+	return item
+}
+
+// webStorage returns window[area], or undefined when storage is disabled
+// (accessing it throws in some privacy modes).
+func webStorage(area string) (storage js.Value) {
+	defer func() {
+		if r := recover(); r != nil {
+			storage = js.Undefined()
+		}
+	}()
+	return js.Global().Get(area)
+}
+
+func (s *storageItem) Set(value string) {
+	if s.storage.Truthy() {
+		s.storage.Call("setItem", s.key, value)
+	}
+	s.broadcast(js.ValueOf(value))
+}
+
+func (s *storageItem) Remove() {
+	if s.storage.Truthy() {
+		s.storage.Call("removeItem", s.key)
+	}
+	s.broadcast(js.Null())
+}
+
+func (s *storageItem) Exists() reactivity.ReadonlySignal[bool] {
+	return reactivity.ReadOnly(s.exists)
+}
+
+// broadcast applies a stored value to every item bound to the same key.
+func (s *storageItem) broadcast(raw js.Value) {
+	items := storageItems[s.area+"\x00"+s.key]
+	if len(items) == 0 {
+		s.apply(raw)
+		return
+	}
+	reactivity.Batch(func() {
+		for _, it := range items {
+			it.apply(raw)
+		}
+	})
+}
+
+// apply mirrors a raw storage value (a string or null) into the signals.
+func (s *storageItem) apply(raw js.Value) {
+	present := raw.Type() == js.TypeString
+	value := ""
+	if present {
+		value = raw.String()
+	}
+	reactivity.Batch(func() {
+		s.Signal.Set(value)
+		s.exists.Set(present)
+	})
+}
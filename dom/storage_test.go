@@ -0,0 +1,111 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// dispatchStorageEvent simulates a write made by another tab.
+func dispatchStorageEvent(area, key string, newValue any) {
+	js.Global().Call("dispatchEvent", js.Global().Get("StorageEvent").New("storage", map[string]any{
+		"key":         key,
+		"newValue":    newValue,
+		"storageArea": js.Global().Get(area),
+	}))
+}
+
+func TestLocalStorageItemReadsAndWrites(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() || !js.Global().Get("localStorage").Truthy() {
+		t.Skip("Skipping browser-specific test")
+	}
+	storage := js.Global().Get("localStorage")
+	storage.Call("setItem", "uiwgo-test-theme", "dark")
+	defer storage.Call("removeItem", "uiwgo-test-theme")
+
+	scope := reactivity.NewCleanupScope(nil)
+	defer scope.Dispose()
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	item := LocalStorageItem("uiwgo-test-theme")
+	missing := LocalStorageItem("uiwgo-test-missing")
+	reactivity.SetCurrentCleanupScope(prev)
+
+	if item.Get() != "dark" || !item.Exists().Get() {
+		t.Fatalf("initial = %q (exists %v), want \"dark\" (exists true)", item.Get(), item.Exists().Get())
+	}
+	if missing.Get() != "" || missing.Exists().Get() {
+		t.Fatalf("missing key = %q (exists %v), want \"\" (exists false)", missing.Get(), missing.Exists().Get())
+	}
+
+	item.Set("light")
+	if got := storage.Call("getItem", "uiwgo-test-theme").String(); got != "light" {
+		t.Errorf("stored value = %q, want \"light\"", got)
+	}
+
+	item.Remove()
+	if !storage.Call("getItem", "uiwgo-test-theme").IsNull() {
+		t.Error("expected key to be removed from storage")
+	}
+	if item.Get() != "" || item.Exists().Get() {
+		t.Errorf("after Remove = %q (exists %v), want \"\" (exists false)", item.Get(), item.Exists().Get())
+	}
+}
+
+func TestStorageItemFollowsOtherTabs(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() || !js.Global().Get("sessionStorage").Truthy() {
+		t.Skip("Skipping browser-specific test")
+	}
+	storage := js.Global().Get("sessionStorage")
+	storage.Call("setItem", "uiwgo-test-draft", "v1")
+	defer storage.Call("removeItem", "uiwgo-test-draft")
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	item := SessionStorageItem("uiwgo-test-draft")
+	reactivity.SetCurrentCleanupScope(prev)
+
+	var seen []string
+	eff := reactivity.CreateEffect(func() {
+		seen = append(seen, item.Get())
+	})
+	defer eff.Dispose()
+
+	// The event carries a value this tab never wrote; it must reach the
+	// signal without being written back to storage.
+	dispatchStorageEvent("sessionStorage", "uiwgo-test-draft", "v2")
+	if item.Get() != "v2" {
+		t.Fatalf("after storage event = %q, want \"v2\"", item.Get())
+	}
+	if got := storage.Call("getItem", "uiwgo-test-draft").String(); got != "v1" {
+		t.Errorf("storage was written back: got %q, want \"v1\"", got)
+	}
+
+	// Events for other keys and other areas are ignored
+	dispatchStorageEvent("sessionStorage", "uiwgo-test-other", "x")
+	dispatchStorageEvent("localStorage", "uiwgo-test-draft", "x")
+	if item.Get() != "v2" {
+		t.Errorf("unrelated events changed value to %q", item.Get())
+	}
+
+	// A null newValue means the key was removed
+	dispatchStorageEvent("sessionStorage", "uiwgo-test-draft", nil)
+	if item.Get() != "" || item.Exists().Get() {
+		t.Errorf("after removal event = %q (exists %v), want \"\" (exists false)", item.Get(), item.Exists().Get())
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("effect runs = %v, want one per change", seen)
+	}
+
+	// After disposal the listener is gone
+	scope.Dispose()
+	dispatchStorageEvent("sessionStorage", "uiwgo-test-draft", "v3")
+	if item.Get() != "" {
+		t.Errorf("disposed item still follows events: %q", item.Get())
+	}
+}
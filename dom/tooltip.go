@@ -0,0 +1,253 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"fmt"
+	"syscall/js"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	domv2 "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// TooltipOptions configures Tooltip.
+type TooltipOptions struct {
+	// Placement is passed to PositionRelativeTo, e.g. "top" or
+	// "bottom-start". Defaults to "top".
+	Placement string
+	// DelayMs is how long the pointer must rest on the trigger before the
+	// tooltip shows. Defaults to 300ms. Focus shows it immediately.
+	DelayMs int
+	// Interactive keeps the tooltip open while the pointer moves from the
+	// trigger onto it, so its content can be selected or clicked.
+	Interactive bool
+}
+
+type tooltipBinding struct {
+	content func() g.Node
+	opts    TooltipOptions
+}
+
+var tooltipBindings = map[string]*tooltipBinding{}
+
+// tooltipLeaveGrace is how long an interactive tooltip stays open after the
+// pointer leaves the trigger, giving it time to reach the tooltip.
+const tooltipLeaveGrace = 100
+
+// Tooltip returns the attributes that give the element they are added to a
+// tooltip. After mount the tooltip is rendered from content into a shared
+// layer at the end of <body> when the pointer rests on the element or it
+// receives focus, positioned next to it (flipped to stay in the viewport),
+// and removed on pointer leave, blur or Escape. The element's
+// aria-describedby points at the tooltip.
+func Tooltip(content func() g.Node, opts TooltipOptions) g.Node {
+	id := nextInlineID("tip")
+	inlineHandlersMu.Lock()
+	tooltipBindings[id] = &tooltipBinding{content: content, opts: opts}
+	inlineHandlersMu.Unlock()
+
+	return g.Group([]g.Node{
+		g.Attr("data-uiwgo-tooltip", id),
+		g.Attr("aria-describedby", tooltipElementID(id)),
+	})
+}
+
+// tooltipElementID is the DOM id of the tooltip bound under id.
+func tooltipElementID(id string) string {
+	return "uiwgo-tooltip-" + id
+}
+
+// tooltipLayer returns the shared element tooltips are rendered into,
+// creating it at the end of <body> on first use.
+func tooltipLayer() js.Value {
+	doc := js.Global().Get("document")
+	layer := doc.Call("querySelector", "[data-uiwgo-tooltip-layer]")
+	if layer.Truthy() {
+		return layer
+	}
+	layer = doc.Call("createElement", "div")
+	layer.Call("setAttribute", "data-uiwgo-tooltip-layer", "")
+	doc.Get("body").Call("appendChild", layer)
+	return layer
+}
+
+// attachTooltipsIn binds Tooltip triggers marked under root.
+func attachTooltipsIn(root js.Value) {
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-tooltip]")
+	for i := 0; i < nodes.Get("length").Int(); i++ {
+		el := nodes.Index(i)
+		if el.Call("hasAttribute", "data-uiwgo-bound-tooltip").Bool() {
+			continue
+		}
+		id := el.Call("getAttribute", "data-uiwgo-tooltip").String()
+		inlineHandlersMu.RLock()
+		binding := tooltipBindings[id]
+		inlineHandlersMu.RUnlock()
+		if binding == nil {
+			continue
+		}
+		el.Call("setAttribute", "data-uiwgo-bound-tooltip", "1")
+		bindTooltip(id, el, binding)
+	}
+}
+
+func bindTooltip(id string, trigger js.Value, binding *tooltipBinding) {
+	win := js.Global()
+	doc := win.Get("document")
+	opts := binding.opts
+	if opts.Placement == "" {
+		opts.Placement = "top"
+	}
+	delay := opts.DelayMs
+	if delay <= 0 {
+		delay = 300
+	}
+
+	// focused reports whether focus is on or inside the trigger
+	focused := func() bool {
+		return trigger.Call("contains", doc.Get("activeElement")).Bool()
+	}
+
+	tip := js.Null()
+	var timer js.Value
+	var scheduled func()
+	var fire, tipEnter, tipLeave, onKey js.Func
+	cancelTimer := func() {
+		if timer.Truthy() {
+			win.Call("clearTimeout", timer)
+			timer = js.Undefined()
+		}
+	}
+	// after runs fn in ms milliseconds, replacing any call still pending
+	after := func(ms int, fn func()) {
+		cancelTimer()
+		scheduled = fn
+		timer = win.Call("setTimeout", fire, ms)
+	}
+	fire = js.FuncOf(func(this js.Value, args []js.Value) any {
+		timer = js.Undefined()
+		scheduled()
+		return nil
+	})
+
+	hide := func() {
+		cancelTimer()
+		if !tip.Truthy() {
+			return
+		}
+		doc.Call("removeEventListener", "keydown", onKey)
+		tip.Call("remove")
+		tip = js.Null()
+	}
+	show := func() {
+		cancelTimer()
+		if tip.Truthy() || !trigger.Get("isConnected").Bool() {
+			return
+		}
+		var buf bytes.Buffer
+		_ = binding.content().Render(&buf)
+
+		tip = doc.Call("createElement", "div")
+		tip.Set("id", tooltipElementID(id))
+		tip.Call("setAttribute", "role", "tooltip")
+		tip.Call("setAttribute", "data-uiwgo-tooltip-panel", "")
+		tip.Set("innerHTML", buf.String())
+		style := tip.Get("style")
+		style.Set("position", "fixed")
+		style.Set("left", "0px")
+		style.Set("top", "0px")
+		style.Set("visibility", "hidden")
+		if !opts.Interactive {
+			style.Set("pointerEvents", "none")
+		}
+		tooltipLayer().Call("appendChild", tip)
+
+		// Measure the rendered tooltip before placing it
+		pos := PositionRelativeTo(domv2.WrapElement(trigger), domv2.WrapElement(tip), opts.Placement)
+		style.Set("left", fmt.Sprintf("%gpx", pos.X))
+		style.Set("top", fmt.Sprintf("%gpx", pos.Y))
+		style.Set("visibility", "")
+		tip.Call("setAttribute", "data-placement", pos.Placement)
+
+		if opts.Interactive {
+			tip.Call("addEventListener", "mouseenter", tipEnter)
+			tip.Call("addEventListener", "mouseleave", tipLeave)
+		}
+		doc.Call("addEventListener", "keydown", onKey)
+	}
+
+	tipEnter = js.FuncOf(func(this js.Value, args []js.Value) any {
+		cancelTimer()
+		return nil
+	})
+	tipLeave = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if !focused() {
+			after(tooltipLeaveGrace, hide)
+		}
+		return nil
+	})
+	onKey = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) > 0 && args[0].Get("key").String() == "Escape" {
+			hide()
+		}
+		return nil
+	})
+
+	enter := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if !tip.Truthy() {
+			after(delay, show)
+		} else {
+			cancelTimer()
+		}
+		return nil
+	})
+	leave := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if focused() {
+			cancelTimer()
+			return nil
+		}
+		if opts.Interactive && tip.Truthy() {
+			after(tooltipLeaveGrace, hide)
+			return nil
+		}
+		hide()
+		return nil
+	})
+	focus := js.FuncOf(func(this js.Value, args []js.Value) any {
+		show()
+		return nil
+	})
+	blur := js.FuncOf(func(this js.Value, args []js.Value) any {
+		// Focus moving between elements inside the trigger keeps it open
+		if len(args) > 0 && trigger.Call("contains", args[0].Get("relatedTarget")).Bool() {
+			return nil
+		}
+		hide()
+		return nil
+	})
+	trigger.Call("addEventListener", "mouseenter", enter)
+	trigger.Call("addEventListener", "mouseleave", leave)
+	trigger.Call("addEventListener", "focusin", focus)
+	trigger.Call("addEventListener", "focusout", blur)
+
+	reactivity.RegisterCleanup(func() {
+		hide()
+		trigger.Call("removeEventListener", "mouseenter", enter)
+		trigger.Call("removeEventListener", "mouseleave", leave)
+		trigger.Call("removeEventListener", "focusin", focus)
+		trigger.Call("removeEventListener", "focusout", blur)
+		enter.Release()
+		leave.Release()
+		focus.Release()
+		blur.Release()
+		fire.Release()
+		tipEnter.Release()
+		tipLeave.Release()
+		onKey.Release()
+		inlineHandlersMu.Lock()
+		delete(tooltipBindings, id)
+		inlineHandlersMu.Unlock()
+	})
+}
@@ -0,0 +1,147 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"syscall/js"
+	"testing"
+	"time"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// mountTooltip renders a button with a tooltip into a container attached to
+// body and returns the button and a disposer.
+func mountTooltip(t *testing.T, text string, opts TooltipOptions) (js.Value, func()) {
+	t.Helper()
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+
+	var buf bytes.Buffer
+	_ = h.Button(g.Text("Save"), Tooltip(func() g.Node { return h.Span(g.Text(text)) }, opts)).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+
+	return container.Call("querySelector", "button"), func() {
+		scope.Dispose()
+		container.Call("remove")
+	}
+}
+
+func openTooltip(trigger js.Value) js.Value {
+	id := trigger.Call("getAttribute", "aria-describedby").String()
+	return js.Global().Get("document").Call("getElementById", id)
+}
+
+func TestTooltipShowsOnFocus(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	trigger, dispose := mountTooltip(t, "Save changes", TooltipOptions{Placement: "bottom"})
+	defer dispose()
+
+	if openTooltip(trigger).Truthy() {
+		t.Fatal("tooltip should not be shown before focus")
+	}
+	trigger.Call("focus")
+	tip := openTooltip(trigger)
+	if !tip.Truthy() {
+		t.Fatal("focus should show the tooltip immediately")
+	}
+	if got := tip.Get("textContent").String(); got != "Save changes" {
+		t.Errorf("tooltip text = %q", got)
+	}
+	if got := tip.Call("getAttribute", "role").String(); got != "tooltip" {
+		t.Errorf("role = %q, want tooltip", got)
+	}
+	if !tip.Get("parentElement").Call("hasAttribute", "data-uiwgo-tooltip-layer").Bool() {
+		t.Error("tooltip should be rendered into the shared layer")
+	}
+	if got := tip.Call("getAttribute", "data-placement").String(); got != "bottom" && got != "top" {
+		t.Errorf("data-placement = %q", got)
+	}
+
+	trigger.Call("blur")
+	if openTooltip(trigger).Truthy() {
+		t.Error("blur should hide the tooltip")
+	}
+}
+
+func TestTooltipEscapeDismisses(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	trigger, dispose := mountTooltip(t, "Delete", TooltipOptions{})
+	defer dispose()
+
+	trigger.Call("focus")
+	if !openTooltip(trigger).Truthy() {
+		t.Fatal("focus should show the tooltip")
+	}
+	evt := js.Global().Get("KeyboardEvent").New("keydown", map[string]any{"key": "Escape", "bubbles": true})
+	trigger.Call("dispatchEvent", evt)
+	if openTooltip(trigger).Truthy() {
+		t.Error("Escape should hide the tooltip")
+	}
+	if !js.Global().Get("document").Get("activeElement").Equal(trigger) {
+		t.Error("Escape should leave focus on the trigger")
+	}
+}
+
+func TestTooltipShowsAfterHoverDelay(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	trigger, dispose := mountTooltip(t, "Hint", TooltipOptions{DelayMs: 20})
+	defer dispose()
+
+	mouse := func(typ string) {
+		trigger.Call("dispatchEvent", js.Global().Get("MouseEvent").New(typ))
+	}
+	mouse("mouseenter")
+	if openTooltip(trigger).Truthy() {
+		t.Fatal("tooltip should wait for the hover delay")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if !openTooltip(trigger).Truthy() {
+		t.Fatal("tooltip should show after the hover delay")
+	}
+	mouse("mouseleave")
+	if openTooltip(trigger).Truthy() {
+		t.Error("mouseleave should hide the tooltip")
+	}
+
+	// Leaving before the delay cancels the pending show
+	mouse("mouseenter")
+	mouse("mouseleave")
+	time.Sleep(60 * time.Millisecond)
+	if openTooltip(trigger).Truthy() {
+		t.Error("tooltip should not show after the pointer left")
+	}
+}
+
+func TestTooltipRemovedOnDispose(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+
+	trigger, dispose := mountTooltip(t, "Gone", TooltipOptions{})
+	trigger.Call("focus")
+	id := trigger.Call("getAttribute", "aria-describedby").String()
+	dispose()
+	if js.Global().Get("document").Call("getElementById", id).Truthy() {
+		t.Error("disposing the scope should remove an open tooltip")
+	}
+}
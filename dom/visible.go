@@ -0,0 +1,87 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"syscall/js"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// VisibleOptions configures BindVisible.
+type VisibleOptions struct {
+	// HiddenClass is added to the element while it is hidden, e.g. for a
+	// fade-out transition; the hidden attribute is still set.
+	HiddenClass string
+}
+
+type visibleBinding struct {
+	visible reactivity.ReadonlySignal[bool]
+	opts    VisibleOptions
+}
+
+var visibleBindings = map[string]*visibleBinding{}
+
+// BindVisible returns the attributes that show the element they are added
+// to while visible is true and set its hidden attribute otherwise. Unlike
+// comps.Show the element and its subtree stay mounted, keeping their state
+// and handlers:
+//
+//	h.Div(h.Class("error-message"), dom.BindVisible(hasError), g.Text(msg))
+//
+// The element starts out hidden when visible is false at render time, so it
+// does not flash before mount. A CSS display rule on the element overrides
+// the hidden attribute; use HiddenClass with such styles.
+func BindVisible(visible reactivity.ReadonlySignal[bool], opts ...VisibleOptions) g.Node {
+	id := nextInlineID("vis")
+	binding := &visibleBinding{visible: visible}
+	if len(opts) > 0 {
+		binding.opts = opts[0]
+	}
+	inlineHandlersMu.Lock()
+	visibleBindings[id] = binding
+	inlineHandlersMu.Unlock()
+
+	return g.Group([]g.Node{
+		g.Attr("data-uiwgo-visible", id),
+		g.If(!reactivity.Untrack(visible.Get), g.Attr("hidden", "")),
+	})
+}
+
+// attachVisibleIn binds BindVisible elements marked under root.
+func attachVisibleIn(root js.Value) {
+	nodes := root.Call("querySelectorAll", "[data-uiwgo-visible]")
+	for i := 0; i < nodes.Get("length").Int(); i++ {
+		el := nodes.Index(i)
+		if el.Call("hasAttribute", "data-uiwgo-bound-visible").Bool() {
+			continue
+		}
+		id := el.Call("getAttribute", "data-uiwgo-visible").String()
+		inlineHandlersMu.RLock()
+		binding := visibleBindings[id]
+		inlineHandlersMu.RUnlock()
+		if binding == nil {
+			continue
+		}
+		el.Call("setAttribute", "data-uiwgo-bound-visible", "1")
+		bindVisibleElement(id, el, binding)
+	}
+}
+
+func bindVisibleElement(id string, el js.Value, binding *visibleBinding) {
+	class := binding.opts.HiddenClass
+	effect := reactivity.CreateRenderEffect(func() {
+		visible := binding.visible.Get()
+		el.Call("toggleAttribute", "hidden", !visible)
+		if class != "" {
+			el.Get("classList").Call("toggle", class, !visible)
+		}
+	})
+	reactivity.RegisterCleanup(func() {
+		effect.Dispose()
+		inlineHandlersMu.Lock()
+		delete(visibleBindings, id)
+		inlineHandlersMu.Unlock()
+	})
+}
@@ -0,0 +1,66 @@
+//go:build js && wasm
+
+package dom
+
+import (
+	"bytes"
+	"syscall/js"
+	"testing"
+
+	reactivity "github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func TestBindVisibleTogglesHiddenAttribute(t *testing.T) {
+	doc := js.Global().Get("document")
+	container := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", container)
+	defer container.Call("remove")
+
+	visible := reactivity.CreateSignal(false)
+	clicks := 0
+	var buf bytes.Buffer
+	_ = h.Div(
+		h.Class("error-message"),
+		BindVisible(visible, VisibleOptions{HiddenClass: "is-hidden"}),
+		h.Button(g.Text("Dismiss"), OnClickInline(func(Element) { clicks++ })),
+	).Render(&buf)
+	container.Set("innerHTML", buf.String())
+
+	el := container.Call("querySelector", ".error-message")
+	if !el.Call("hasAttribute", "hidden").Bool() {
+		t.Fatal("element is not hidden before mount while the signal is false")
+	}
+
+	scope := reactivity.NewCleanupScope(nil)
+	defer scope.Dispose()
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	AttachInlineDelegates(container)
+	reactivity.SetCurrentCleanupScope(prev)
+
+	if !el.Get("classList").Call("contains", "is-hidden").Bool() {
+		t.Error("hidden class not added while hidden")
+	}
+
+	visible.Set(true)
+	if el.Call("hasAttribute", "hidden").Bool() || el.Get("classList").Call("contains", "is-hidden").Bool() {
+		t.Error("element still hidden after the signal turned true")
+	}
+	el.Call("querySelector", "button").Call("click")
+
+	visible.Set(false)
+	if !container.Call("querySelector", ".error-message").Equal(el) {
+		t.Fatal("element was replaced when hidden")
+	}
+	if !el.Call("hasAttribute", "hidden").Bool() {
+		t.Error("hidden attribute not set after the signal turned false")
+	}
+
+	visible.Set(true)
+	el.Call("querySelector", "button").Call("click")
+	if clicks != 2 {
+		t.Errorf("button handled %d clicks across the toggles, want 2", clicks)
+	}
+}
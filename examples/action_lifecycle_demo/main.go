@@ -17,13 +17,22 @@ import (
 
 // Define action types
 var (
-	IncrementAction    = action.DefineAction[int]("counter.increment")
-	DecrementAction    = action.DefineAction[int]("counter.decrement")
+	IncrementAction    = action.DefineActionWithValidation[int]("counter.increment", positiveStep)
+	DecrementAction    = action.DefineActionWithValidation[int]("counter.decrement", positiveStep)
 	ErrorAction        = action.DefineAction[string]("demo.error")
 	AnalyticsAction    = action.DefineAction[string]("analytics.event")
 	ToggleLoggerAction = action.DefineAction[bool]("observability.toggle_logger")
 )
 
+// positiveStep rejects counter steps that are not positive, so a malformed
+// payload never reaches the counter handlers.
+func positiveStep(step int) error {
+	if step <= 0 {
+		return fmt.Errorf("counter step must be positive, got %d", step)
+	}
+	return nil
+}
+
 func main() {
 	// Create a bus instance
 	bus := action.New()
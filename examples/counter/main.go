@@ -28,11 +28,24 @@ func CounterApp() Node {
 	count := reactivity.CreateSignal(0)
 	double := reactivity.CreateMemo(func() int { return count.Get() * 2 })
 
-	// Effect logging to console
-	reactivity.CreateEffect(func() {
+	// Effect logging to console; the returned handle lets a button stop it
+	logger := reactivity.CreateEffect(func() {
 		logutil.Log("Count changed:", count.Get())
 	})
 
+	// Tint the display's border by the direction of each change
+	count.OnChange(func(old, new int) {
+		display := dom.GetElementByID("count-display")
+		if display == nil {
+			return
+		}
+		color := "#28a745"
+		if new < old {
+			color = "#dc3545"
+		}
+		display.Underlying().Get("style").Set("borderColor", color)
+	})
+
 	// Setup DOM event handlers after mount
 	comps.OnMount(func() {
 		// Get DOM elements and bind events using the new DOM API
@@ -51,6 +64,13 @@ func CounterApp() Node {
 		if resetBtn := dom.GetElementByID("reset-btn"); resetBtn != nil {
 			dom.BindClickToSignal(resetBtn, count, 0)
 		}
+
+		if stopLoggingBtn := dom.GetElementByID("stop-logging-btn"); stopLoggingBtn != nil {
+			dom.BindClickToCallback(stopLoggingBtn, func() {
+				logger.Dispose()
+				logutil.Log("Stopped logging count changes")
+			})
+		}
 	})
 
 	return Div(
@@ -83,6 +103,11 @@ func CounterApp() Node {
 					Style("font-size: 1.2em; padding: 10px 20px; margin: 10px; border: none; border-radius: 5px; cursor: pointer; background-color: #6c757d; color: white; transition: background-color 0.2s;"),
 					Text("Reset"),
 				),
+				Button(
+					ID("stop-logging-btn"),
+					Style("font-size: 1.2em; padding: 10px 20px; margin: 10px; border: none; border-radius: 5px; cursor: pointer; background-color: #17a2b8; color: white; transition: background-color 0.2s;"),
+					Text("Stop logging"),
+				),
 			),
 
 			Div(
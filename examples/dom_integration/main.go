@@ -297,10 +297,42 @@ func runApp() {
 	exposeGlobalFunctions(counter, name, isVisible, todos, newTodo, items, selectedTab, currentComponent)
 }
 
-// Global registry for dynamic counter instances
-var dynamicCounters = make(map[string]reactivity.Signal[int])
+// dynamicCounterID numbers the counters loaded into the Dynamic component
 var dynamicCounterID = 0
 
+// DynamicCounterProps configures DynamicCounter.
+type DynamicCounterProps struct {
+	ID string
+}
+
+// DynamicCounter is a counter with state of its own. Dynamic disposes its
+// binders when another component is loaded, and the count starts over
+// when it is loaded again.
+func DynamicCounter(props DynamicCounterProps) gomponents.Node {
+	count := reactivity.CreateSignal(0)
+	return html.Div(
+		html.Class("counter-component"),
+		html.DataAttr("dynamic-component", "counter"),
+		html.DataAttr("counter-id", props.ID),
+		html.H4(gomponents.Text("Dynamic Counter")),
+		html.P(
+			gomponents.Text("Count: "),
+			comps.BindText(func() string {
+				return strconv.Itoa(count.Get())
+			}),
+		),
+		html.Button(
+			html.Class("dyn-counter-btn"),
+			html.DataAttr("counter-id", props.ID),
+			gomponents.Text("Increment"),
+			dom.OnClickInline(func(dom.Element) {
+				count.Set(count.Get() + 1)
+				logutil.Logf("Dynamic counter %s incremented to %d", props.ID, count.Get())
+			}),
+		),
+	)
+}
+
 func enhanceWithDOMv2(counter reactivity.Signal[int], name reactivity.Signal[string], isVisible reactivity.Signal[bool], todos reactivity.Signal[[]string], newTodo reactivity.Signal[string], items reactivity.Signal[[]string], selectedTab reactivity.Signal[string], currentComponent reactivity.Signal[func() gomponents.Node]) {
 	doc := domv2.GetWindow().Document()
 
@@ -460,63 +492,16 @@ func enhanceWithDOMv2(counter reactivity.Signal[int], name reactivity.Signal[str
 	}
 	if counterBtn := doc.GetElementByID("load-counter-comp"); counterBtn != nil {
 		dom.BindClickToCallback(counterBtn, func() {
-			// Create a unique ID for this counter instance
 			dynamicCounterID++
-			counterInstanceID := fmt.Sprintf("dyn-counter-%d", dynamicCounterID)
-
-			// Create and register the counter signal
-			localCounter := reactivity.CreateSignal(0)
-			dynamicCounters[counterInstanceID] = localCounter
-
-			currentComponent.Set(func() gomponents.Node {
-				return html.Div(
-					html.Class("counter-component"),
-					html.DataAttr("dynamic-component", "counter"),
-					html.DataAttr("counter-id", counterInstanceID),
-					html.H4(gomponents.Text("Dynamic Counter")),
-					html.P(
-						gomponents.Text("Count: "),
-						comps.BindText(func() string {
-							return strconv.Itoa(localCounter.Get())
-						}),
-					),
-					html.Button(
-						html.Class("dyn-counter-btn"),
-						html.DataAttr("counter-id", counterInstanceID),
-						gomponents.Text("Increment"),
-					),
-				)
-			})
+			props := DynamicCounterProps{ID: fmt.Sprintf("dyn-counter-%d", dynamicCounterID)}
+			currentComponent.Set(func() gomponents.Node { return DynamicCounter(props) })
 		})
 	}
 	if clearBtn := doc.GetElementByID("clear-comp"); clearBtn != nil {
 		dom.BindClickToCallback(clearBtn, func() {
-			// Clean up any dynamic counters before clearing
-			for id := range dynamicCounters {
-				delete(dynamicCounters, id)
-			}
 			currentComponent.Set(nil)
 		})
 	}
-
-	// Dynamic counter button delegation
-	if body := doc.QuerySelector("body"); body != nil {
-		dom.DelegateEvent(body, "click", ".dyn-counter-btn", func(event domv2.Event, target domv2.Element) {
-			// Get the counter ID from the button's data attribute
-			if counterID := target.GetAttribute("data-counter-id"); counterID != "" {
-				// Find the counter signal in our registry
-				if counter, exists := dynamicCounters[counterID]; exists {
-					// Increment the counter
-					counter.Set(counter.Get() + 1)
-					logutil.Logf("Dynamic counter %s incremented to %d", counterID, counter.Get())
-				} else {
-					logutil.Logf("Counter %s not found in registry", counterID)
-				}
-			} else {
-				logutil.Log("No counter ID found on button")
-			}
-		})
-	}
 }
 
 func addTodo(todos reactivity.Signal[[]string], newTodo reactivity.Signal[string]) {
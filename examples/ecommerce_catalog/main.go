@@ -174,17 +174,16 @@ func (pc *ProductCatalog) render() g.Node {
 				h.Class("search-bar"),
 				h.Style("margin: 1rem 0;"),
 				h.Input(
-					h.ID("search-input"),
 					h.Type("text"),
 					h.Placeholder("Search products..."),
 					h.Value(pc.searchTerm.Get()),
 					h.Style("padding: 0.5rem; width: 300px; border: 1px solid #ddd; border-radius: 4px;"),
+					comps.Ref(func(searchInput dom.Element) {
+						if searchInput != nil {
+							dom.BindInputToSignal(searchInput, pc.searchTerm)
+						}
+					}),
 				),
-				comps.OnMount(func() {
-					if searchInput := dom.GetElementByID("search-input"); searchInput != nil {
-						dom.BindInputToSignal(searchInput, pc.searchTerm)
-					}
-				}),
 			),
 
 			// Filters row
@@ -272,24 +271,24 @@ func (pc *ProductCatalog) render() g.Node {
 					h.Style("margin-left: 1rem;"),
 					h.Button(
 						h.ID("grid-view-btn"),
-						h.Style(func() string {
-					style := "padding: 0.25rem 0.5rem; margin-right: 0.25rem;"
-					if pc.viewMode.Get() == ViewModeGrid {
-						style += " background: #007bff; color: white;"
-					}
-					return style
-				}()),
+						comps.BindAttr("style", func() string {
+							style := "padding: 0.25rem 0.5rem; margin-right: 0.25rem;"
+							if pc.viewMode.Get() == ViewModeGrid {
+								style += " background: #007bff; color: white;"
+							}
+							return style
+						}),
 						g.Text("Grid"),
 					),
 					h.Button(
 						h.ID("list-view-btn"),
-						h.Style(func() string {
-					style := "padding: 0.25rem 0.5rem;"
-					if pc.viewMode.Get() == ViewModeList {
-						style += " background: #007bff; color: white;"
-					}
-					return style
-				}()),
+						comps.BindAttr("style", func() string {
+							style := "padding: 0.25rem 0.5rem;"
+							if pc.viewMode.Get() == ViewModeList {
+								style += " background: #007bff; color: white;"
+							}
+							return style
+						}),
 						g.Text("List"),
 					),
 					comps.OnMount(func() {
@@ -344,17 +343,11 @@ func (pc *ProductCatalog) render() g.Node {
 			When: reactivity.CreateMemo(func() bool {
 				return !pc.loading.Get()
 			}),
-			Children: comps.Switch(comps.SwitchProps{
+			Children: comps.SwitchOn(comps.SwitchOnProps[ViewMode]{
 				When: pc.viewMode,
-				Children: []g.Node{
-					comps.Match(comps.MatchProps{
-						When:     ViewModeGrid,
-						Children: pc.renderProductGrid(filteredProducts),
-					}),
-					comps.Match(comps.MatchProps{
-						When:     ViewModeList,
-						Children: pc.renderProductList(filteredProducts),
-					}),
+				Cases: []comps.Case[ViewMode]{
+					{When: ViewModeGrid, Render: func() g.Node { return pc.renderProductGrid(filteredProducts) }},
+					{When: ViewModeList, Render: func() g.Node { return pc.renderProductList(filteredProducts) }},
 				},
 			}),
 		}),
@@ -384,13 +377,13 @@ func (pc *ProductCatalog) renderProductGrid(products reactivity.Signal[[]Product
 			Children: func(p Product, index int) g.Node {
 				return h.Div(
 					h.Class("product-card"),
-					h.Style(func() string {
-						style := "border: 1px solid #ddd; border-radius: 8px; padding: 1rem; background: white; box-shadow: 0 2px 4px rgba(0,0,0,0.1);"
+					h.Style("border: 1px solid #ddd; border-radius: 8px; padding: 1rem; background: white; box-shadow: 0 2px 4px rgba(0,0,0,0.1);"),
+					comps.BindStyle(func() map[string]string {
 						if !p.InStock {
-							style += " opacity: 0.6;"
+							return map[string]string{"opacity": "0.6"}
 						}
-						return style
-					}()),
+						return nil
+					}),
 
 					h.Img(
 						h.Src(p.ImageURL),
@@ -445,13 +438,13 @@ func (pc *ProductCatalog) renderProductList(products reactivity.Signal[[]Product
 			Children: func(p Product, index int) g.Node {
 				return h.Div(
 					h.Class("product-row"),
-					h.Style(func() string {
-						style := "display: flex; gap: 1rem; padding: 1rem; border: 1px solid #ddd; border-radius: 8px; margin-bottom: 1rem; background: white;"
+					h.Style("display: flex; gap: 1rem; padding: 1rem; border: 1px solid #ddd; border-radius: 8px; margin-bottom: 1rem; background: white;"),
+					comps.BindStyle(func() map[string]string {
 						if !p.InStock {
-							style += " opacity: 0.6;"
+							return map[string]string{"opacity": "0.6"}
 						}
-						return style
-					}()),
+						return nil
+					}),
 
 					h.Img(
 						h.Src(p.ImageURL),
@@ -94,7 +94,8 @@ func (app *AppState) ForDemo() g.Node {
 			comps.For(comps.ForProps[Todo]{
 				Items: app.todos,
 				Key: func(todo Todo) string { return todo.ID },
-				Children: func(todo Todo, index int) g.Node {
+				// Rows are kept by key; only the number follows the position
+				ChildrenWithIndex: func(todo Todo, index reactivity.Signal[int]) g.Node {
 					return Li(
 						Style("background: #f5f5f5; margin: 5px 0; padding: 10px; border-radius: 4px; display: flex; align-items: center;"),
 						Input(
@@ -113,7 +114,7 @@ func (app *AppState) ForDemo() g.Node {
 				}),
 						),
 						Span(
-							g.Text(fmt.Sprintf("%d. %s", index+1, todo.Text)),
+							comps.BindText(func() string { return fmt.Sprintf("%d. %s", index.Get()+1, todo.Text) }),
 							// Conditional styling handled by signal
 						),
 					)
@@ -395,15 +396,21 @@ func (app *AppState) LazyDemo() g.Node {
 	)
 }
 
-func RiskyComponent() g.Node {
+// RiskyComponent panics in an effect once the error is triggered, as a
+// component failing on bad data would.
+func RiskyComponent(hasError reactivity.Signal[bool]) g.Node {
+	reactivity.CreateEffect(func() {
+		if hasError.Get() {
+			panic("Simulated error for ErrorBoundary demo")
+		}
+	})
 	return Div(
 		P(g.Text("This component might throw an error...")),
 		Button(
 			g.Text("Trigger Error"),
 			Style("background: #dc3545; color: white; border: none; padding: 8px 16px; border-radius: 4px; cursor: pointer;"),
 			dom.OnClickInline(func(el dom.Element) {
-				// Simulate an error
-				panic("Simulated error for ErrorBoundary demo")
+				hasError.Set(true)
 			}),
 		),
 	)
@@ -413,32 +420,27 @@ func (app *AppState) ErrorBoundaryDemo() g.Node {
 	return Div(
 		Class("demo-section"),
 		H3(g.Text("ErrorBoundary Helper - Error Handling")),
-		Button(
-			g.Text("Toggle Error Component"),
-			dom.OnClickInline(func(el dom.Element) {
-				app.hasError.Set(!app.hasError.Get())
-			}),
-		),
-		comps.Show(comps.ShowProps{
-			When: app.hasError,
-			Children: comps.ErrorBoundary(comps.ErrorBoundaryProps{
-				Fallback: func(err error) g.Node {
-					return Div(
-						Style("background: #f8d7da; border: 1px solid #f5c6cb; color: #721c24; padding: 15px; border-radius: 4px; margin: 10px 0;"),
-						H4(g.Text("🚨 Error Boundary Caught an Error")),
-						P(g.Text(fmt.Sprintf("Error: %s", err.Error()))),
-						Button(
-							g.Text("Retry"),
-							Style("background: #007bff; color: white; border: none; padding: 8px 16px; border-radius: 4px; cursor: pointer;"),
-							dom.OnClickInline(func(el dom.Element) {
-								app.hasError.Set(false)
-								logutil.Log("Retrying after error")
-							}),
-						),
-					)
-				},
-				Children: RiskyComponent(),
-			}),
+		comps.ErrorBoundary(comps.ErrorBoundaryProps{
+			Fallback: func(err error, reset func()) g.Node {
+				return Div(
+					Style("background: #f8d7da; border: 1px solid #f5c6cb; color: #721c24; padding: 15px; border-radius: 4px; margin: 10px 0;"),
+					H4(g.Text("🚨 Error Boundary Caught an Error")),
+					P(g.Text(fmt.Sprintf("Error: %s", err.Error()))),
+					Button(
+						g.Text("Retry"),
+						Style("background: #007bff; color: white; border: none; padding: 8px 16px; border-radius: 4px; cursor: pointer;"),
+						dom.OnClickInline(func(el dom.Element) {
+							app.hasError.Set(false)
+							logutil.Log("Retrying after error")
+							reset()
+						}),
+					),
+				)
+			},
+			OnError: func(err error, stack string) {
+				logutil.Logf("ErrorBoundary demo caught: %v\n%s", err, stack)
+			},
+			Render: func() g.Node { return RiskyComponent(app.hasError) },
 		}),
 	)
 }
@@ -228,25 +228,18 @@ func (mfs *MultiStepFormState) renderStepIndicator() g.Node {
 }
 
 func (mfs *MultiStepFormState) renderCurrentStep() g.Node {
-	return comps.Switch(comps.SwitchProps{
-		When: reactivity.CreateMemo(func() string {
-			step := mfs.currentStep.Get()
-			if step >= 0 && step < len(mfs.steps) {
-				return mfs.steps[step].ID
-			}
-			return "unknown"
-		}),
-		Children: func() []g.Node {
-			var children []g.Node
-			for _, step := range mfs.steps {
-				children = append(children, comps.Match(comps.MatchProps{
-					When:     step.ID,
-					Children: step.Component(),
-				}))
-			}
-			return children
-		}(),
-	})
+	// Steps stay mounted once visited, so typed values survive going back
+	var children []g.Node
+	for i, step := range mfs.steps {
+		children = append(children, comps.Show(comps.ShowProps{
+			When: reactivity.CreateMemo(func() bool {
+				return mfs.currentStep.Get() == i
+			}),
+			KeepAlive: true,
+			Children:  step.Component(),
+		}))
+	}
+	return h.Div(children...)
 }
 
 func (mfs *MultiStepFormState) renderPersonalInfoStep() g.Node {
@@ -272,7 +265,7 @@ func (mfs *MultiStepFormState) renderPersonalInfoStep() g.Node {
 					return ""
 				}()),
 				dom.OnInputInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.personalInfo.Peek()
 					newInfo.FirstName = el.Underlying().Get("value").String()
 					mfs.personalInfo.Set(newInfo)
 				}),
@@ -301,7 +294,7 @@ func (mfs *MultiStepFormState) renderPersonalInfoStep() g.Node {
 					return ""
 				}()),
 				dom.OnInputInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.personalInfo.Peek()
 					newInfo.LastName = el.Underlying().Get("value").String()
 					mfs.personalInfo.Set(newInfo)
 				}),
@@ -330,7 +323,7 @@ func (mfs *MultiStepFormState) renderPersonalInfoStep() g.Node {
 					return ""
 				}()),
 				dom.OnInputInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.personalInfo.Peek()
 					newInfo.BirthDate = el.Underlying().Get("value").String()
 					mfs.personalInfo.Set(newInfo)
 				}),
@@ -352,7 +345,7 @@ func (mfs *MultiStepFormState) renderPersonalInfoStep() g.Node {
 			h.Select(
 				h.Value(info.Gender),
 				dom.OnChangeInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.personalInfo.Peek()
 					newInfo.Gender = el.Underlying().Get("value").String()
 					mfs.personalInfo.Set(newInfo)
 				}),
@@ -389,7 +382,7 @@ func (mfs *MultiStepFormState) renderContactInfoStep() g.Node {
 					return ""
 				}()),
 				dom.OnInputInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.contactInfo.Peek()
 					newInfo.Email = el.Underlying().Get("value").String()
 					mfs.contactInfo.Set(newInfo)
 				}),
@@ -418,7 +411,7 @@ func (mfs *MultiStepFormState) renderContactInfoStep() g.Node {
 					return ""
 				}()),
 				dom.OnInputInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.contactInfo.Peek()
 					newInfo.Phone = el.Underlying().Get("value").String()
 					mfs.contactInfo.Set(newInfo)
 				}),
@@ -447,7 +440,7 @@ func (mfs *MultiStepFormState) renderContactInfoStep() g.Node {
 					return ""
 				}()),
 				dom.OnInputInline(func(el dom.Element) {
-					newInfo := info
+					newInfo := mfs.contactInfo.Peek()
 					newInfo.Address = el.Underlying().Get("value").String()
 					mfs.contactInfo.Set(newInfo)
 				}),
@@ -473,7 +466,7 @@ func (mfs *MultiStepFormState) renderContactInfoStep() g.Node {
 					h.Type("text"),
 					h.Value(info.City),
 					dom.OnInputInline(func(el dom.Element) {
-						newInfo := info
+						newInfo := mfs.contactInfo.Peek()
 						newInfo.City = el.Underlying().Get("value").String()
 						mfs.contactInfo.Set(newInfo)
 					}),
@@ -488,7 +481,7 @@ func (mfs *MultiStepFormState) renderContactInfoStep() g.Node {
 					h.Type("text"),
 					h.Value(info.Country),
 					dom.OnInputInline(func(el dom.Element) {
-						newInfo := info
+						newInfo := mfs.contactInfo.Peek()
 						newInfo.Country = el.Underlying().Get("value").String()
 						mfs.contactInfo.Set(newInfo)
 					}),
@@ -515,7 +508,7 @@ func (mfs *MultiStepFormState) renderPreferencesStep() g.Node {
 					h.Type("checkbox"),
 					g.Attr("checked", fmt.Sprintf("%t", prefs.Newsletter)),
 					dom.OnChangeInline(func(el dom.Element) {
-						newPrefs := prefs
+						newPrefs := mfs.preferences.Peek()
 						newPrefs.Newsletter = el.Underlying().Get("checked").Bool()
 						mfs.preferences.Set(newPrefs)
 					}),
@@ -532,7 +525,7 @@ func (mfs *MultiStepFormState) renderPreferencesStep() g.Node {
 					h.Type("checkbox"),
 					g.Attr("checked", fmt.Sprintf("%t", prefs.Notifications)),
 					dom.OnChangeInline(func(el dom.Element) {
-						newPrefs := prefs
+						newPrefs := mfs.preferences.Peek()
 						newPrefs.Notifications = el.Underlying().Get("checked").Bool()
 						mfs.preferences.Set(newPrefs)
 					}),
@@ -547,7 +540,7 @@ func (mfs *MultiStepFormState) renderPreferencesStep() g.Node {
 			h.Select(
 				h.Value(prefs.Theme),
 				dom.OnChangeInline(func(el dom.Element) {
-					newPrefs := prefs
+					newPrefs := mfs.preferences.Peek()
 					newPrefs.Theme = el.Underlying().Get("value").String()
 					mfs.preferences.Set(newPrefs)
 				}),
@@ -563,7 +556,7 @@ func (mfs *MultiStepFormState) renderPreferencesStep() g.Node {
 			h.Select(
 				h.Value(prefs.Language),
 				dom.OnChangeInline(func(el dom.Element) {
-					newPrefs := prefs
+					newPrefs := mfs.preferences.Peek()
 					newPrefs.Language = el.Underlying().Get("value").String()
 					mfs.preferences.Set(newPrefs)
 				}),
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"syscall/js"
@@ -42,8 +43,10 @@ func UserProfileApp() Node {
 	// Source signal: current user ID to load
 	userID := reactivity.CreateSignal(1)
 
-	// Resource: fetch user by id (simulated API with delay and possible error)
-	userRes := reactivity.CreateResource(userID, fetchUser)
+	// Resource: fetch user by id (simulated API with delay and possible error).
+	// Clicking quickly cancels the previous fetch, so a slow old response
+	// never replaces the user that was requested last.
+	userRes := reactivity.CreateResourceWithContext(userID, fetchUser)
 
 	global := js.Global()
 	setUser1 := js.FuncOf(func(this js.Value, args []js.Value) interface{} { userID.Set(1); return nil })
@@ -58,7 +61,7 @@ func UserProfileApp() Node {
 		Div(
 			Style("background: white; padding: 30px; border-radius: 10px; box-shadow: 0 2px 10px rgba(0,0,0,0.1);"),
 			H1(Text("Resource Example")),
-			P(Text("Demonstrates CreateResource: loading, error handling, and re-fetch on source change")),
+			P(Text("Demonstrates CreateResource with Suspense: loading, error handling, and re-fetch on source change")),
 
 			// Controls
 			Div(
@@ -72,22 +75,18 @@ func UserProfileApp() Node {
 			Div(
 				ID("user-display"),
 				Style("margin-top: 16px; padding: 16px; border: 1px solid #eee; border-radius: 8px; background:#fafafa;"),
-				comps.BindHTML(func() Node {
-					if userRes.Loading() {
-						return P(Style("color:#555"), Text("Loading..."))
-					}
-					if err := userRes.Error(); err != nil {
-						return P(Style("color:#c00"), Text(fmt.Sprintf("Error: %v", err)))
-					}
-					u := userRes.Data()
-					// If no data yet (e.g., initial zero), show a hint
-					if u.ID == 0 && u.Name == "" {
-						return P(Style("color:#777; font-style:italic;"), Text("No user loaded yet"))
-					}
-					return Div(
-						H2(Text(fmt.Sprintf("User #%d", u.ID))),
-						P(Text(fmt.Sprintf("Name: %s", u.Name))),
-					)
+				comps.Suspense(comps.SuspenseProps{
+					Fallback: P(Style("color:#555"), Text("Loading...")),
+					Render: func() Node {
+						if err := userRes.Error(); err != nil {
+							return P(Style("color:#c00"), Text(fmt.Sprintf("Error: %v", err)))
+						}
+						u := userRes.Data()
+						return Div(
+							H2(Text(fmt.Sprintf("User #%d", u.ID))),
+							P(Text(fmt.Sprintf("Name: %s", u.Name))),
+						)
+					},
 				}),
 			),
 		),
@@ -95,9 +94,14 @@ func UserProfileApp() Node {
 }
 
 // fetchUser simulates an asynchronous API call with latency and possible error for ID=2.
-func fetchUser(id int) (User, error) {
+// It stops early when ctx is cancelled by a newer request.
+func fetchUser(ctx context.Context, id int) (User, error) {
 	// Simulate network delay
-	time.Sleep(900 * time.Millisecond)
+	select {
+	case <-time.After(900 * time.Millisecond):
+	case <-ctx.Done():
+		return User{}, ctx.Err()
+	}
 
 	// Simulate an error for certain IDs
 	if id == 2 {
@@ -166,26 +166,9 @@ func FileBrowserComponent(props ...any) interface{} {
 	)
 }
 
-// AdminLayoutComponent demonstrates nested routes
-func AdminLayoutComponent(props ...any) interface{} {
-	// Get child content from props (for nested routing)
-	var content Node
-	if len(props) > 0 {
-		if childNode, ok := props[0].(Node); ok {
-			content = childNode
-		} else {
-			// Fallback content if no child is provided
-			content = Div(Class("bg-white border p-4 rounded"),
-				P(Text("Select an option from the navigation above.")),
-			)
-		}
-	} else {
-		// Default content when no child is matched
-		content = Div(Class("bg-white border p-4 rounded"),
-			P(Text("Select an option from the navigation above.")),
-		)
-	}
-
+// AdminLayoutComponent demonstrates nested routes: the matched child route
+// is rendered through rc.Outlet()
+func AdminLayoutComponent(rc router.RouteContext) Node {
 	return Div(
 		Class("p-6 max-w-4xl mx-auto"),
 		H1(Class("text-3xl font-bold mb-6"), Text("Admin Panel")),
@@ -200,7 +183,7 @@ func AdminLayoutComponent(props ...any) interface{} {
 			),
 		),
 		// Render the child content
-		content,
+		rc.Outlet(),
 		Div(Class("mt-6 pt-4 border-t"),
 			router.A("/", Class("bg-blue-500 text-white px-4 py-2 rounded hover:bg-blue-600"), Text("← Back to Home")),
 		),
@@ -293,7 +276,7 @@ func main() {
 		router.Route("/files/*filepath", FileBrowserComponent),
 
 		// Nested routes - proper nested structure
-		router.Route("/admin", AdminLayoutComponent,
+		router.Route("/admin", router.Comp(AdminLayoutComponent),
 			// Child routes for admin section
 			router.Route("/", AdminDashboardComponent),        // matches /admin exactly
 			router.Route("/settings", AdminSettingsComponent), // matches /admin/settings
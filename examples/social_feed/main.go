@@ -212,7 +212,9 @@ func (sf *SocialFeed) render() g.Node {
 				h.Class("post-filters"),
 				h.Button(
 					h.Class("filter-tab"),
-					g.If(sf.selectedFilter.Get() == "", g.Attr("class", "filter-tab active")),
+					comps.BindClassMap(func() map[string]bool {
+						return map[string]bool{"active": sf.selectedFilter.Get() == ""}
+					}),
 					g.Text("All"),
 					dom.OnClickInline(func(el dom.Element) {
 						sf.selectedFilter.Set("")
@@ -224,13 +226,11 @@ func (sf *SocialFeed) render() g.Node {
 					}),
 					Key: func(pType PostType) string { return string(pType) },
 					Children: func(pType PostType, index int) g.Node {
-						isActive := reactivity.CreateMemo(func() bool {
-							return sf.selectedFilter.Get() == pType
-						})
-
 						return h.Button(
 							h.Class("filter-tab"),
-							g.If(isActive.Get(), h.Class("active")),
+							comps.BindClassMap(func() map[string]bool {
+								return map[string]bool{"active": sf.selectedFilter.Get() == pType}
+							}),
 							g.Text(strings.Title(string(pType))),
 							dom.OnClickInline(func(el dom.Element) {
 								sf.selectedFilter.Set(pType)
@@ -313,7 +313,7 @@ func (sf *SocialFeed) renderPost(post Post) g.Node {
 				),
 				h.Time(
 					h.Class("timestamp"),
-					g.Text(formatTimeAgo(post.Timestamp)),
+					comps.RelativeTime(post.Timestamp),
 				),
 			),
 		),
@@ -434,7 +434,7 @@ func (sf *SocialFeed) renderComment(comment Comment) g.Node {
 				h.Class("comment-actions"),
 				h.Time(
 					h.Class("comment-time"),
-					g.Text(formatTimeAgo(comment.Timestamp)),
+					comps.RelativeTime(comment.Timestamp),
 				),
 				h.Button(
 					h.Class("comment-like"),
@@ -486,7 +486,7 @@ func (sf *SocialFeed) renderPostComposer() g.Node {
 
 // Helper methods
 func (sf *SocialFeed) toggleLike(postID string) {
-	posts := sf.posts.Get()
+	posts := sf.posts.Peek()
 	for i, post := range posts {
 		if post.ID == postID {
 			posts[i].IsLiked = !post.IsLiked
@@ -502,13 +502,13 @@ func (sf *SocialFeed) toggleLike(postID string) {
 }
 
 func (sf *SocialFeed) toggleComments(postID string) {
-	current := sf.showComments.Get()
+	current := sf.showComments.Peek()
 	current[postID] = !current[postID]
 	sf.showComments.Set(current)
 }
 
 func (sf *SocialFeed) sharePost(postID string) {
-	posts := sf.posts.Get()
+	posts := sf.posts.Peek()
 	for i, post := range posts {
 		if post.ID == postID {
 			posts[i].IsShared = !post.IsShared
@@ -578,41 +578,13 @@ func (sf *SocialFeed) createPost() {
 	sf.newPostContent.Set("")
 }
 
-func formatTimeAgo(t time.Time) string {
-	duration := time.Since(t)
-
-	if duration < time.Minute {
-		return "just now"
-	} else if duration < time.Hour {
-		minutes := int(duration.Minutes())
-		if minutes == 1 {
-			return "1m ago"
-		}
-		return fmt.Sprintf("%dm ago", minutes)
-	} else if duration < 24*time.Hour {
-		hours := int(duration.Hours())
-		if hours == 1 {
-			return "1h ago"
-		}
-		return fmt.Sprintf("%dh ago", hours)
-	} else {
-		days := int(duration.Hours() / 24)
-		if days == 1 {
-			return "1d ago"
-		}
-		return fmt.Sprintf("%dd ago", days)
-	}
-}
-
 func main() {
 	wasm.Initialize(wasm.InitConfig{})
 
 	feed := NewSocialFeed()
 
-	// Load sample data on startup
-	reactivity.CreateEffect(func() {
-		feed.loadSampleData()
-	})
+	// Load sample data on startup; with no deps On runs it exactly once
+	reactivity.On(nil, feed.loadSampleData)
 
 	comps.Mount("app", feed.render)
 
@@ -68,10 +68,13 @@ func TodoApp() Node {
 		if title == "" {
 			return
 		}
-		list := append([]Todo{}, todos.Get()...)
-		list = append(list, Todo{ID: nextID, Title: title})
-		nextID++
-		todos.Set(list)
+		// Batch so the stats effect and memos settle in a single pass
+		reactivity.Batch(func() {
+			list := append([]Todo{}, todos.Get()...)
+			list = append(list, Todo{ID: nextID, Title: title})
+			nextID++
+			todos.Set(list)
+		})
 	}
 
 	toggleTodo := func(id int) {
@@ -72,14 +72,9 @@ func TodoStoreApp() Node {
 		if v == "" {
 			return nil
 		}
-		// Build new slice
-		// read snapshot once here (non-reactive)
-		cur := store.Get().Todos
-		list := make([]TodoItem, 0, len(cur)+1)
-		list = append(list, cur...)
-		list = append(list, TodoItem{ID: nextID, Title: v})
+		// Append in place; only the list length is notified
+		store.Push("Todos", TodoItem{ID: nextID, Title: v})
 		nextID++
-		setState("Todos", list)
 		// clear
 		input.SetValue("")
 		return nil
@@ -98,24 +93,23 @@ func TodoStoreApp() Node {
 			return nil
 		}
 		idx := args[0].Int()
-		cur := store.Get().Todos
-		if idx < 0 || idx >= len(cur) {
+		if idx < 0 || idx >= store.SelectLen("Todos").Peek() {
 			return nil
 		}
-		list := append([]TodoItem{}, cur[:idx]...)
-		list = append(list, cur[idx+1:]...)
-		setState("Todos", list)
+		// Items before idx keep their views; later ones shift down
+		store.RemoveAt("Todos", idx)
 		return nil
 	})
 	clearCompletedFn := js.FuncOf(func(this js.Value, args []js.Value) any {
 		cur := store.Get().Todos
-		list := make([]TodoItem, 0, len(cur))
-		for _, t := range cur {
-			if !t.Completed {
-				list = append(list, t)
+		reactivity.Batch(func() {
+			// Remove from the end so earlier indices stay valid
+			for i := len(cur) - 1; i >= 0; i-- {
+				if cur[i].Completed {
+					store.RemoveAt("Todos", i)
+				}
 			}
-		}
-		setState("Todos", list)
+		})
 		return nil
 	})
 	js.Global().Set("addTodo", addFn)
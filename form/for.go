@@ -104,6 +104,7 @@ func ClearForm(state *State) {
 	
 	// Clear global error
 	state.SetGlobalError(nil)
+	state.errorShown = make(map[string]bool)
 }
 
 // ResetForm resets all fields to their initial values and clears errors
@@ -116,4 +117,5 @@ func ResetForm(state *State) {
 	
 	// Clear global error
 	state.SetGlobalError(nil)
+	state.errorShown = make(map[string]bool)
 }
@@ -0,0 +1,35 @@
+package form
+
+import "strings"
+
+// TrimSpace is a Normalize function that removes leading and trailing
+// whitespace from string values.
+func TrimSpace(value any) any {
+	if s, ok := value.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return value
+}
+
+// ToUpper is a Normalize function that upper-cases string values, e.g. for
+// postal codes.
+func ToUpper(value any) any {
+	if s, ok := value.(string); ok {
+		return strings.ToUpper(s)
+	}
+	return value
+}
+
+// DigitsOnly is a Normalize function that strips everything but the ASCII
+// digits from string values, e.g. for phone numbers.
+func DigitsOnly(value any) any {
+	if s, ok := value.(string); ok {
+		return strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, s)
+	}
+	return value
+}
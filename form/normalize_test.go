@@ -0,0 +1,63 @@
+package form
+
+import (
+	"errors"
+	"testing"
+)
+
+func formatPhone(value any) string {
+	digits, _ := value.(string)
+	if len(digits) != 10 {
+		return digits
+	}
+	return "(" + digits[:3] + ") " + digits[3:6] + "-" + digits[6:]
+}
+
+func TestNormalizeDigitsOnlyPhone(t *testing.T) {
+	var validated any
+	state := NewFromSchema([]FieldDef{{
+		Name:      "phone",
+		Normalize: []func(any) any{DigitsOnly},
+		Format:    formatPhone,
+		Validators: []Validator{func(value any) error {
+			validated = value
+			if len(value.(string)) != 10 {
+				return errors.New("phone must have 10 digits")
+			}
+			return nil
+		}},
+	}})
+
+	state.SetFieldValue("phone", "(555) 123-4567")
+	state.HandleFieldChange("phone")
+
+	if got := state.GetFieldValue("phone"); got != "5551234567" {
+		t.Errorf("stored value = %q, want digits only", got)
+	}
+	if validated != "5551234567" {
+		t.Errorf("validator saw %q, want the normalized value", validated)
+	}
+	if err := state.GetFieldError("phone"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := state.FormatFieldValue("phone"); got != "(555) 123-4567" {
+		t.Errorf("FormatFieldValue = %q, want formatted phone", got)
+	}
+}
+
+func TestNormalizePipelineRunsInOrder(t *testing.T) {
+	state := NewFromSchema([]FieldDef{
+		{Name: "postcode", Normalize: []func(any) any{TrimSpace, ToUpper}},
+		{Name: "plain"},
+	})
+
+	state.SetFieldValue("postcode", "  sw1a 1aa ")
+	if got := state.GetFieldValue("postcode"); got != "SW1A 1AA" {
+		t.Errorf("postcode = %q, want %q", got, "SW1A 1AA")
+	}
+
+	state.SetFieldValue("plain", "  as typed ")
+	if got := state.FormatFieldValue("plain"); got != "  as typed " {
+		t.Errorf("field without Normalize changed to %q", got)
+	}
+}
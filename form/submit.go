@@ -0,0 +1,121 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Response is the server's answer to SubmitAsFormData.
+type Response struct {
+	Status int
+	Body   []byte
+}
+
+// SubmitOption configures SubmitAsFormData.
+type SubmitOption func(*submitConfig)
+
+type submitConfig struct {
+	ctx        context.Context
+	method     string
+	headers    map[string]string
+	onProgress func(sentBytes, totalBytes int64)
+}
+
+// SubmitContext aborts the request when ctx is done; SubmitAsFormData then
+// returns ctx.Err().
+func SubmitContext(ctx context.Context) SubmitOption {
+	return func(c *submitConfig) { c.ctx = ctx }
+}
+
+// SubmitMethod sets the HTTP method; the default is POST.
+func SubmitMethod(method string) SubmitOption {
+	return func(c *submitConfig) { c.method = method }
+}
+
+// SubmitHeader adds a request header. The Content-Type is set by the
+// browser, including the multipart boundary, and must not be given.
+func SubmitHeader(key, value string) SubmitOption {
+	return func(c *submitConfig) {
+		if c.headers == nil {
+			c.headers = make(map[string]string)
+		}
+		c.headers[key] = value
+	}
+}
+
+// OnProgress reports the upload progress of the request body. totalBytes is
+// -1 when the browser cannot tell the size.
+func OnProgress(fn func(sentBytes, totalBytes int64)) SubmitOption {
+	return func(c *submitConfig) { c.onProgress = fn }
+}
+
+func newSubmitConfig(opts []SubmitOption) submitConfig {
+	c := submitConfig{ctx: context.Background(), method: "POST"}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// FieldErrors is the error returned for a rejected submission whose
+// response names the fields at fault, by field name.
+type FieldErrors map[string]string
+
+func (e FieldErrors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + ": " + e[name]
+	}
+	return "form submission rejected: " + strings.Join(parts, "; ")
+}
+
+// errorBody is the JSON error body of a rejected submission. Each field
+// error may be a message or a list of messages, of which the first is shown.
+type errorBody struct {
+	Message string                     `json:"message"`
+	Errors  map[string]json.RawMessage `json:"errors"`
+}
+
+// applyErrorResponse turns a non-2xx response into the error returned by
+// SubmitAsFormData. Field errors found in the body are set on the matching
+// fields of s and its message becomes the global error.
+func (s *State) applyErrorResponse(status int, body []byte) error {
+	var parsed errorBody
+	if err := json.Unmarshal(body, &parsed); err != nil || (len(parsed.Errors) == 0 && parsed.Message == "") {
+		return fmt.Errorf("form submission failed with status %d", status)
+	}
+	if parsed.Message != "" {
+		s.SetGlobalError(errors.New(parsed.Message))
+	}
+	if len(parsed.Errors) == 0 {
+		return fmt.Errorf("form submission failed with status %d: %s", status, parsed.Message)
+	}
+
+	fieldErrs := make(FieldErrors, len(parsed.Errors))
+	for name, raw := range parsed.Errors {
+		var msg string
+		if json.Unmarshal(raw, &msg) != nil {
+			var msgs []string
+			if json.Unmarshal(raw, &msgs) != nil || len(msgs) == 0 {
+				continue
+			}
+			msg = msgs[0]
+		}
+		fieldErrs[name] = msg
+		s.SetFieldError(name, errors.New(msg))
+		if s.errorShown == nil {
+			s.errorShown = make(map[string]bool)
+		}
+		s.errorShown[name] = true
+	}
+	return fieldErrs
+}
@@ -0,0 +1,158 @@
+//go:build js && wasm
+
+package form
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+)
+
+// SubmitAsFormData validates the form and sends its values to url as
+// multipart/form-data. Fields holding a browser File or Blob, a FileList or
+// a []js.Value of files are sent as files; other values as their text. The
+// request is made with XMLHttpRequest, which unlike fetch reports upload
+// progress to OnProgress. A non-2xx response with a JSON body of the form
+// {"message": "...", "errors": {"field": "message"}} sets those errors on
+// the fields and the global error and returns them as FieldErrors. It
+// blocks until the response arrives, so call it from a goroutine rather
+// than from an event handler.
+func SubmitAsFormData(state *State, url string, opts ...SubmitOption) (Response, error) {
+	if state.IsSubmitting() {
+		return Response{}, errors.New("form submission already in progress")
+	}
+	state.submissionError.Set(nil)
+	state.isSubmitting.Set(true)
+	defer state.isSubmitting.Set(false)
+
+	if !state.ValidateWithCrossField() {
+		err := errors.New("form validation failed")
+		state.submissionError.Set(err)
+		return Response{}, err
+	}
+
+	resp, err := sendFormData(url, state.formData(), newSubmitConfig(opts))
+	if err == nil && (resp.Status < 200 || resp.Status > 299) {
+		err = state.applyErrorResponse(resp.Status, resp.Body)
+	}
+	if err != nil {
+		state.submissionError.Set(err)
+	}
+	return resp, err
+}
+
+// formData builds a FormData of the form's values in schema order.
+func (s *State) formData() js.Value {
+	fd := js.Global().Get("FormData").New()
+	for _, field := range s.schema {
+		appendFormValue(fd, field.Name, s.fieldValues[field.Name].Peek())
+	}
+	return fd
+}
+
+func appendFormValue(fd js.Value, name string, value any) {
+	switch v := value.(type) {
+	case nil:
+	case string:
+		fd.Call("append", name, v)
+	case []js.Value:
+		for _, file := range v {
+			appendFormValue(fd, name, file)
+		}
+	case js.Value:
+		switch {
+		case v.IsUndefined() || v.IsNull():
+		case v.InstanceOf(js.Global().Get("Blob")):
+			fd.Call("append", name, v)
+		case v.InstanceOf(js.Global().Get("FileList")):
+			for i := 0; i < v.Length(); i++ {
+				fd.Call("append", name, v.Index(i))
+			}
+		default:
+			fd.Call("append", name, v.Call("toString").String())
+		}
+	default:
+		fd.Call("append", name, fmt.Sprint(v))
+	}
+}
+
+// sendFormData sends body with XMLHttpRequest and waits for the response,
+// aborting the request when the config's context is done.
+func sendFormData(url string, body js.Value, c submitConfig) (Response, error) {
+	if err := c.ctx.Err(); err != nil {
+		return Response{}, err
+	}
+	xhr := js.Global().Get("XMLHttpRequest").New()
+	xhr.Call("open", c.method, url)
+	for key, value := range c.headers {
+		xhr.Call("setRequestHeader", key, value)
+	}
+
+	type result struct {
+		resp Response
+		err  error
+	}
+	// Buffered: the browser may call back before send returns
+	done := make(chan result, 1)
+	finish := func(r result) {
+		select {
+		case done <- r:
+		default:
+		}
+	}
+	var funcs []js.Func
+	handle := func(fn func(event js.Value)) js.Func {
+		f := js.FuncOf(func(this js.Value, args []js.Value) any {
+			var event js.Value
+			if len(args) > 0 {
+				event = args[0]
+			}
+			fn(event)
+			return nil
+		})
+		funcs = append(funcs, f)
+		return f
+	}
+	defer func() {
+		for _, f := range funcs {
+			f.Release()
+		}
+	}()
+
+	if c.onProgress != nil {
+		if upload := xhr.Get("upload"); upload.Truthy() {
+			upload.Set("onprogress", handle(func(event js.Value) {
+				total := int64(-1)
+				if event.Get("lengthComputable").Truthy() {
+					total = int64(event.Get("total").Float())
+				}
+				c.onProgress(int64(event.Get("loaded").Float()), total)
+			}))
+		}
+	}
+	xhr.Set("onload", handle(func(js.Value) {
+		finish(result{resp: Response{
+			Status: xhr.Get("status").Int(),
+			Body:   []byte(xhr.Get("responseText").String()),
+		}})
+	}))
+	xhr.Set("onerror", handle(func(js.Value) {
+		finish(result{err: errors.New("failed to submit form: network error")})
+	}))
+	xhr.Set("onabort", handle(func(js.Value) {
+		err := c.ctx.Err()
+		if err == nil {
+			err = errors.New("form submission aborted")
+		}
+		finish(result{err: err})
+	}))
+	xhr.Call("send", body)
+
+	select {
+	case r := <-done:
+		return r.resp, r.err
+	case <-c.ctx.Done():
+		xhr.Call("abort")
+		return Response{}, c.ctx.Err()
+	}
+}
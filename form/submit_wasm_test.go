@@ -0,0 +1,188 @@
+//go:build js && wasm
+
+package form
+
+import (
+	"context"
+	"errors"
+	"syscall/js"
+	"testing"
+	"time"
+)
+
+// stubXHR replaces XMLHttpRequest with a fake that records the request and
+// answers it through respond.
+type stubXHR struct {
+	method, url string
+	headers     map[string]string
+	body        js.Value
+	aborted     bool
+	// respond runs when the request is sent; nil leaves it pending
+	respond func(xhr js.Value)
+	funcs   []js.Func
+}
+
+func installStubXHR(t *testing.T) *stubXHR {
+	s := &stubXHR{headers: map[string]string{}}
+	fn := func(f func(this js.Value, args []js.Value) any) js.Func {
+		jf := js.FuncOf(f)
+		s.funcs = append(s.funcs, jf)
+		return jf
+	}
+	ctor := fn(func(this js.Value, args []js.Value) any {
+		xhr := js.Global().Get("Object").New()
+		xhr.Set("upload", js.Global().Get("Object").New())
+		xhr.Set("open", fn(func(this js.Value, args []js.Value) any {
+			s.method, s.url = args[0].String(), args[1].String()
+			return nil
+		}))
+		xhr.Set("setRequestHeader", fn(func(this js.Value, args []js.Value) any {
+			s.headers[args[0].String()] = args[1].String()
+			return nil
+		}))
+		xhr.Set("send", fn(func(this js.Value, args []js.Value) any {
+			s.body = args[0]
+			if s.respond != nil {
+				s.respond(xhr)
+			}
+			return nil
+		}))
+		xhr.Set("abort", fn(func(this js.Value, args []js.Value) any {
+			s.aborted = true
+			if onabort := xhr.Get("onabort"); onabort.Truthy() {
+				onabort.Invoke()
+			}
+			return nil
+		}))
+		return xhr
+	})
+	original := js.Global().Get("XMLHttpRequest")
+	js.Global().Set("XMLHttpRequest", ctor)
+	t.Cleanup(func() {
+		js.Global().Set("XMLHttpRequest", original)
+		for _, f := range s.funcs {
+			f.Release()
+		}
+	})
+	return s
+}
+
+func progressEvent(loaded, total int) js.Value {
+	event := js.Global().Get("Object").New()
+	event.Set("loaded", loaded)
+	event.Set("total", total)
+	event.Set("lengthComputable", true)
+	return event
+}
+
+func newUploadState() *State {
+	state := NewFromSchema([]FieldDef{{Name: "title"}, {Name: "email"}, {Name: "attachments"}})
+	state.SetFieldValue("title", "Report")
+	state.SetFieldValue("email", "a@example.com")
+	state.SetFieldValue("attachments", []js.Value{
+		js.Global().Get("File").New([]any{"first"}, "a.txt"),
+		js.Global().Get("File").New([]any{"second"}, "b.txt"),
+	})
+	return state
+}
+
+func TestSubmitAsFormDataSendsFilesWithProgress(t *testing.T) {
+	stub := installStubXHR(t)
+	stub.respond = func(xhr js.Value) {
+		onprogress := xhr.Get("upload").Get("onprogress")
+		onprogress.Invoke(progressEvent(40, 100))
+		onprogress.Invoke(progressEvent(100, 100))
+		xhr.Set("status", 201)
+		xhr.Set("responseText", `{"id":7}`)
+		xhr.Get("onload").Invoke()
+	}
+
+	var progress [][2]int64
+	resp, err := SubmitAsFormData(newUploadState(), "/upload",
+		SubmitHeader("X-Token", "t"),
+		OnProgress(func(sent, total int64) { progress = append(progress, [2]int64{sent, total}) }),
+	)
+	if err != nil {
+		t.Fatalf("SubmitAsFormData: %v", err)
+	}
+	if resp.Status != 201 || string(resp.Body) != `{"id":7}` {
+		t.Errorf("response = %d %q", resp.Status, resp.Body)
+	}
+	if stub.method != "POST" || stub.url != "/upload" || stub.headers["X-Token"] != "t" {
+		t.Errorf("request = %s %s %v", stub.method, stub.url, stub.headers)
+	}
+	if got := stub.body.Call("get", "title").String(); got != "Report" {
+		t.Errorf("title = %q, want Report", got)
+	}
+	files := stub.body.Call("getAll", "attachments")
+	if files.Length() != 2 || files.Index(1).Get("name").String() != "b.txt" {
+		t.Errorf("attachments were not sent as two files")
+	}
+	if len(progress) != 2 || progress[0] != [2]int64{40, 100} || progress[1] != [2]int64{100, 100} {
+		t.Errorf("progress = %v, want [[40 100] [100 100]]", progress)
+	}
+}
+
+func TestSubmitAsFormDataMapsFieldErrors(t *testing.T) {
+	stub := installStubXHR(t)
+	stub.respond = func(xhr js.Value) {
+		xhr.Set("status", 422)
+		xhr.Set("responseText", `{"message":"Please fix the errors","errors":{"email":["already taken","invalid"],"title":"too short"}}`)
+		xhr.Get("onload").Invoke()
+	}
+
+	state := newUploadState()
+	resp, err := SubmitAsFormData(state, "/upload")
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) {
+		t.Fatalf("error = %v, want FieldErrors", err)
+	}
+	if resp.Status != 422 || fieldErrs["email"] != "already taken" || fieldErrs["title"] != "too short" {
+		t.Errorf("status %d, field errors %v", resp.Status, fieldErrs)
+	}
+	if got := state.GetFieldError("email"); got == nil || got.Error() != "already taken" {
+		t.Errorf("email error = %v, want already taken", got)
+	}
+	if got := state.GetGlobalError(); got == nil || got.Error() != "Please fix the errors" {
+		t.Errorf("global error = %v", got)
+	}
+	if sub := state.GetSubmissionError(); sub == nil || sub.Error() != err.Error() || state.IsSubmitting() {
+		t.Errorf("submission state not updated: error %v, submitting %v", state.GetSubmissionError(), state.IsSubmitting())
+	}
+
+	// A body without field errors gives a plain status error
+	stub.respond = func(xhr js.Value) {
+		xhr.Set("status", 500)
+		xhr.Set("responseText", "oops")
+		xhr.Get("onload").Invoke()
+	}
+	_, err = SubmitAsFormData(newUploadState(), "/upload")
+	if err == nil || errors.As(err, &fieldErrs) {
+		t.Errorf("error = %v, want a status error", err)
+	}
+}
+
+func TestSubmitAsFormDataAbortsWithContext(t *testing.T) {
+	stub := installStubXHR(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() {
+		_, err := SubmitAsFormData(newUploadState(), "/upload", SubmitContext(ctx))
+		result <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("SubmitAsFormData did not return after cancel")
+	}
+	if !stub.aborted {
+		t.Error("the request was not aborted")
+	}
+}
@@ -24,6 +24,32 @@ type CrossFieldValidator func(values map[string]any) error
 // It takes the form state, field name, and optional attributes and returns a gomponents Node.
 type Widget func(state *State, fieldName string, attrs ...Node) Node
 
+// ValidationMode selects which user interaction runs a field's validators.
+type ValidationMode int
+
+const (
+	// ValidateInherit uses the form-level default mode.
+	ValidateInherit ValidationMode = iota
+	// ValidateOnChange validates every time the field's value changes.
+	ValidateOnChange
+	// ValidateOnBlur validates when the field loses focus.
+	ValidateOnBlur
+	// ValidateOnSubmit validates only when the form is submitted.
+	ValidateOnSubmit
+)
+
+// NormalizeMode selects when a widget replaces the text it displays with
+// the field's normalized, formatted value.
+type NormalizeMode int
+
+const (
+	// NormalizeOnBlur updates the displayed text when the field loses focus,
+	// so normalization never moves the caret while the user is typing.
+	NormalizeOnBlur NormalizeMode = iota
+	// NormalizeOnChange updates the displayed text after every change.
+	NormalizeOnChange
+)
+
 // FieldDef defines the structure and behavior of a single form field.
 type FieldDef struct {
 	// Name is the programmatic name of the field (e.g., "user_email")
@@ -43,6 +69,23 @@ type FieldDef struct {
 	
 	// WidgetAttrs are optional attributes to pass to the widget
 	WidgetAttrs []Node
+
+	// Mode selects when the field is validated; ValidateInherit uses the
+	// form default set with SetValidationMode.
+	Mode ValidationMode
+
+	// Normalize transforms every value written to the field, in order,
+	// before it is stored and validated (e.g. TrimSpace, DigitsOnly).
+	Normalize []func(any) any
+
+	// Format renders the stored value for display in widgets, so a field
+	// can store digits but show a formatted phone number. Nil displays the
+	// stored string as is.
+	Format func(any) string
+
+	// NormalizeOn selects when widgets show the normalized value; the
+	// stored value is always normalized.
+	NormalizeOn NormalizeMode
 }
 
 // SubmissionHandler defines a function that handles form submission
@@ -81,6 +124,15 @@ type State struct {
 	
 	// submissionError tracks submission-specific errors
 	submissionError reactivity.Signal[error]
+
+	// validationMode is the default mode for fields that inherit it
+	validationMode ValidationMode
+
+	// revalidateMode replaces a field's mode once it has shown an error
+	revalidateMode ValidationMode
+
+	// errorShown records fields whose validation has failed at least once
+	errorShown map[string]bool
 }
 
 // Values returns a map of all current field values.
@@ -100,11 +152,36 @@ func (s *State) GetFieldValue(fieldName string) any {
 	return nil
 }
 
-// SetFieldValue sets the value of a specific field.
+// SetFieldValue sets the value of a specific field after running it
+// through the field's Normalize pipeline.
 func (s *State) SetFieldValue(fieldName string, value any) {
 	if signal, exists := s.fieldValues[fieldName]; exists {
-		signal.Set(value)
+		signal.Set(s.normalizeValue(fieldName, value))
+	}
+}
+
+// normalizeValue applies the field's Normalize functions to value.
+func (s *State) normalizeValue(fieldName string, value any) any {
+	if def := s.GetFieldDef(fieldName); def != nil {
+		for _, normalize := range def.Normalize {
+			value = normalize(value)
+		}
+	}
+	return value
+}
+
+// FormatFieldValue returns the text widgets display for a field: the
+// stored value passed through FieldDef.Format, or the stored string when
+// no Format is set.
+func (s *State) FormatFieldValue(fieldName string) string {
+	value := s.GetFieldValue(fieldName)
+	if def := s.GetFieldDef(fieldName); def != nil && def.Format != nil {
+		return def.Format(value)
+	}
+	if str, ok := value.(string); ok {
+		return str
 	}
+	return ""
 }
 
 // GetFieldError returns the current error for a specific field.
@@ -154,6 +231,10 @@ func (s *State) ValidateField(fieldName string) error {
 	for _, validator := range fieldDef.Validators {
 		if err := validator(value); err != nil {
 			s.SetFieldError(fieldName, err)
+			if s.errorShown == nil {
+				s.errorShown = make(map[string]bool)
+			}
+			s.errorShown[fieldName] = true
 			return err
 		}
 	}
@@ -163,6 +244,50 @@ func (s *State) ValidateField(fieldName string) error {
 	return nil
 }
 
+// SetValidationMode sets the mode used by fields whose FieldDef.Mode is
+// ValidateInherit. The default is ValidateOnChange.
+func (s *State) SetValidationMode(mode ValidationMode) {
+	s.validationMode = mode
+}
+
+// SetRevalidateMode sets the mode a field switches to once its validation
+// has failed, so a field validated on blur or submit can clear its error as
+// soon as it is corrected. The default is ValidateOnChange.
+func (s *State) SetRevalidateMode(mode ValidationMode) {
+	s.revalidateMode = mode
+}
+
+// FieldValidationMode returns the mode currently in effect for a field,
+// taking the form default and the revalidation upgrade into account.
+func (s *State) FieldValidationMode(fieldName string) ValidationMode {
+	if s.errorShown[fieldName] && s.revalidateMode != ValidateInherit {
+		return s.revalidateMode
+	}
+	if def := s.GetFieldDef(fieldName); def != nil && def.Mode != ValidateInherit {
+		return def.Mode
+	}
+	if s.validationMode == ValidateInherit {
+		return ValidateOnChange
+	}
+	return s.validationMode
+}
+
+// HandleFieldChange is called by widgets after a field's value changed. It
+// validates the field when its active mode is ValidateOnChange.
+func (s *State) HandleFieldChange(fieldName string) {
+	if s.FieldValidationMode(fieldName) == ValidateOnChange {
+		s.ValidateField(fieldName)
+	}
+}
+
+// HandleFieldBlur is called by widgets when a field loses focus. It
+// validates the field when its active mode is ValidateOnBlur.
+func (s *State) HandleFieldBlur(fieldName string) {
+	if s.FieldValidationMode(fieldName) == ValidateOnBlur {
+		s.ValidateField(fieldName)
+	}
+}
+
 // Validate validates all fields and runs cross-field validation
 func (s *State) Validate() bool {
 	isValid := true
@@ -213,6 +338,9 @@ func NewFromSchema(schema []FieldDef) *State {
 
 	state.isSubmitting = reactivity.CreateSignal[bool](false)
 	state.submissionError = reactivity.CreateSignal[error](nil)
+	state.validationMode = ValidateOnChange
+	state.revalidateMode = ValidateOnChange
+	state.errorShown = make(map[string]bool)
 	
 	return state
 }
@@ -355,6 +483,7 @@ func (s *State) Reset() {
 	}
 	s.SetGlobalError(nil)
 	s.submissionError.Set(nil)
+	s.errorShown = make(map[string]bool)
 }
 
 // NewState creates a new form state with the given schema
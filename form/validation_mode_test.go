@@ -0,0 +1,157 @@
+package form
+
+import (
+	"errors"
+	"testing"
+)
+
+var errRequired = errors.New("required")
+
+func required(value any) error {
+	if s, _ := value.(string); s == "" {
+		return errRequired
+	}
+	return nil
+}
+
+func modeSchema(mode ValidationMode) []FieldDef {
+	return []FieldDef{{Name: "username", Label: "Username", Validators: []Validator{required}, Mode: mode}}
+}
+
+func TestValidationModes(t *testing.T) {
+	t.Run("on change validates on every change", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnChange))
+
+		state.HandleFieldChange("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Fatalf("expected error after change, got %v", state.GetFieldError("username"))
+		}
+
+		state.SetFieldValue("username", "bob")
+		state.HandleFieldChange("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Errorf("expected error to clear after valid change, got %v", err)
+		}
+	})
+
+	t.Run("on blur ignores changes until blur", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnBlur))
+
+		state.HandleFieldChange("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Fatalf("change should not validate in blur mode, got %v", err)
+		}
+
+		state.HandleFieldBlur("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Errorf("expected error after blur, got %v", state.GetFieldError("username"))
+		}
+	})
+
+	t.Run("on submit ignores change and blur", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnSubmit))
+
+		state.HandleFieldChange("username")
+		state.HandleFieldBlur("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Fatalf("change/blur should not validate in submit mode, got %v", err)
+		}
+
+		if state.Validate() {
+			t.Fatal("expected submit validation to fail")
+		}
+		if state.GetFieldError("username") != errRequired {
+			t.Errorf("expected error after submit, got %v", state.GetFieldError("username"))
+		}
+	})
+
+	t.Run("fields inherit the form default", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateInherit))
+		if mode := state.FieldValidationMode("username"); mode != ValidateOnChange {
+			t.Errorf("expected default mode ValidateOnChange, got %v", mode)
+		}
+
+		state.SetValidationMode(ValidateOnBlur)
+		state.HandleFieldChange("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Fatalf("change should not validate with blur default, got %v", err)
+		}
+		state.HandleFieldBlur("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Errorf("expected error after blur, got %v", state.GetFieldError("username"))
+		}
+	})
+
+	t.Run("field mode overrides the form default", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnChange))
+		state.SetValidationMode(ValidateOnSubmit)
+
+		state.HandleFieldChange("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Errorf("expected field-level change mode to win, got %v", state.GetFieldError("username"))
+		}
+	})
+}
+
+func TestRevalidateMode(t *testing.T) {
+	t.Run("blur field revalidates on change after an error", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnBlur))
+
+		state.HandleFieldBlur("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Fatalf("expected error after blur, got %v", state.GetFieldError("username"))
+		}
+		if mode := state.FieldValidationMode("username"); mode != ValidateOnChange {
+			t.Fatalf("expected upgrade to ValidateOnChange, got %v", mode)
+		}
+
+		state.SetFieldValue("username", "bob")
+		state.HandleFieldChange("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Errorf("expected error to clear on change once shown, got %v", err)
+		}
+	})
+
+	t.Run("submit field revalidates on change after failed submit", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnSubmit))
+		state.Validate()
+
+		state.SetFieldValue("username", "bob")
+		state.HandleFieldChange("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Errorf("expected error to clear on change after submit, got %v", err)
+		}
+
+		state.SetFieldValue("username", "")
+		state.HandleFieldChange("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Errorf("expected error to return on change, got %v", state.GetFieldError("username"))
+		}
+	})
+
+	t.Run("custom revalidate mode", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnSubmit))
+		state.SetRevalidateMode(ValidateOnBlur)
+		state.Validate()
+
+		state.SetFieldValue("username", "bob")
+		state.HandleFieldChange("username")
+		if state.GetFieldError("username") != errRequired {
+			t.Fatalf("change should not revalidate in blur revalidate mode, got %v", state.GetFieldError("username"))
+		}
+		state.HandleFieldBlur("username")
+		if err := state.GetFieldError("username"); err != nil {
+			t.Errorf("expected error to clear on blur, got %v", err)
+		}
+	})
+
+	t.Run("reset restores the initial mode", func(t *testing.T) {
+		state := NewFromSchema(modeSchema(ValidateOnSubmit))
+		state.Validate()
+		state.Reset()
+
+		if mode := state.FieldValidationMode("username"); mode != ValidateOnSubmit {
+			t.Errorf("expected ValidateOnSubmit after reset, got %v", mode)
+		}
+	})
+}
@@ -52,7 +52,7 @@ func Checkbox(state *form.State, fieldName string, opts CheckboxOptions) Node {
 				state.SetFieldValue(fieldName, "false")
 			}
 			// Trigger validation on change
-			state.ValidateField(fieldName)
+			state.HandleFieldChange(fieldName)
 		}),
 	)
 
@@ -77,7 +77,7 @@ func Checkbox(state *form.State, fieldName string, opts CheckboxOptions) Node {
 				} else {
 					state.SetFieldValue(fieldName, "false")
 				}
-				state.ValidateField(fieldName)
+				state.HandleFieldChange(fieldName)
 			}),
 			Group(attrs),
 		)
@@ -183,7 +183,7 @@ func CheckboxGroup(state *form.State, fieldName string, opts CheckboxGroupOption
 						
 						state.SetFieldValue(fieldName, newSelected)
 						// Trigger validation on change
-						state.ValidateField(fieldName)
+						state.HandleFieldChange(fieldName)
 					}),
 				),
 				Text(" "+option.Label),
@@ -56,7 +56,7 @@ func RadioGroup(state *form.State, fieldName string, opts RadioGroupOptions) Nod
 				selectedValue := el.Underlying().Get("value").String()
 				state.SetFieldValue(fieldName, selectedValue)
 				// Trigger validation on change
-				state.ValidateField(fieldName)
+				state.HandleFieldChange(fieldName)
 			}),
 		)
 
@@ -155,7 +155,7 @@ func RadioButton(state *form.State, fieldName string, opts RadioButtonOptions) N
 				selectedValue := el.Underlying().Get("value").String()
 				state.SetFieldValue(fieldName, selectedValue)
 				// Trigger validation on change
-				state.ValidateField(fieldName)
+				state.HandleFieldChange(fieldName)
 			}),
 	)
 
@@ -177,7 +177,7 @@ func RadioButton(state *form.State, fieldName string, opts RadioButtonOptions) N
 			dom.OnChangeInline(func(el dom.Element) {
 				selectedValue := el.Underlying().Get("value").String()
 				state.SetFieldValue(fieldName, selectedValue)
-				state.ValidateField(fieldName)
+				state.HandleFieldChange(fieldName)
 			}),
 			Group(attrs),
 		)
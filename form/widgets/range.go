@@ -0,0 +1,366 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"syscall/js"
+
+	"github.com/ozanturksever/uiwgo/comps"
+	"github.com/ozanturksever/uiwgo/dom"
+	"github.com/ozanturksever/uiwgo/form"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	. "maragu.dev/gomponents"
+	"maragu.dev/gomponents/html"
+)
+
+// RangeOptions configures slider widget behavior
+type RangeOptions struct {
+	Min      float64
+	Max      float64
+	Step     float64 // Defaults to 1 when zero or negative
+	Class    string
+	Disabled bool
+}
+
+// normalized returns options with a positive step and Min <= Max
+func (o RangeOptions) normalized() RangeOptions {
+	if o.Step <= 0 {
+		o.Step = 1
+	}
+	if o.Max < o.Min {
+		o.Min, o.Max = o.Max, o.Min
+	}
+	return o
+}
+
+// clamp limits v to [Min, Max] and snaps it to the nearest step from Min
+func (o RangeOptions) clamp(v float64) float64 {
+	o = o.normalized()
+	if math.IsNaN(v) {
+		return o.Min
+	}
+	v = math.Round((v-o.Min)/o.Step)*o.Step + o.Min
+	return math.Max(o.Min, math.Min(o.Max, v))
+}
+
+// percent returns the position of v within the range as 0..100
+func (o RangeOptions) percent(v float64) float64 {
+	o = o.normalized()
+	if o.Max == o.Min {
+		return 0
+	}
+	return (v - o.Min) / (o.Max - o.Min) * 100
+}
+
+// clampDualRange clamps both values to the options and keeps low <= high
+func clampDualRange(v [2]float64, opts RangeOptions) [2]float64 {
+	low, high := opts.clamp(v[0]), opts.clamp(v[1])
+	if low > high {
+		low, high = high, low
+	}
+	return [2]float64{low, high}
+}
+
+// stepDualRange moves one handle (0 = low, 1 = high) by one step in the
+// given arrow-key direction. A handle never passes the other one.
+func stepDualRange(v [2]float64, handle int, direction string, opts RangeOptions) [2]float64 {
+	opts = opts.normalized()
+	v = clampDualRange(v, opts)
+	delta := 0.0
+	switch direction {
+	case "up", "right":
+		delta = opts.Step
+	case "down", "left":
+		delta = -opts.Step
+	default:
+		return v
+	}
+	next := opts.clamp(v[handle] + delta)
+	if handle == 0 {
+		v[0] = math.Min(next, v[1])
+	} else {
+		v[1] = math.Max(next, v[0])
+	}
+	return v
+}
+
+// rangeValueAt returns the value at ratio (0 = Min, 1 = Max) along the
+// range, clamped and snapped to Step
+func rangeValueAt(ratio float64, opts RangeOptions) float64 {
+	opts = opts.normalized()
+	ratio = math.Max(0, math.Min(1, ratio))
+	return opts.clamp(opts.Min + ratio*(opts.Max-opts.Min))
+}
+
+// nearestDualHandle returns the handle (0 = low, 1 = high) closest to x.
+// When both handles sit together, the one on x's side is picked so it can
+// move away from the other.
+func nearestDualHandle(v [2]float64, x float64) int {
+	if x < v[0] || (x-v[0] < v[1]-x) {
+		return 0
+	}
+	if x > v[1] || v[0] != v[1] {
+		return 1
+	}
+	return 0
+}
+
+// moveDualRange moves one handle (0 = low, 1 = high) to x. A handle never
+// passes the other one.
+func moveDualRange(v [2]float64, handle int, x float64) [2]float64 {
+	if handle == 0 {
+		v[0] = math.Min(x, v[1])
+	} else {
+		v[1] = math.Max(x, v[0])
+	}
+	return v
+}
+
+// rangeValue reads a float64 slider value from form state
+func rangeValue(value any, opts RangeOptions) float64 {
+	switch v := value.(type) {
+	case float64:
+		return opts.clamp(v)
+	case int:
+		return opts.clamp(float64(v))
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return opts.clamp(f)
+		}
+	}
+	return opts.normalized().Min
+}
+
+// dualRangeValue reads a [2]float64 range value from form state
+func dualRangeValue(value any, opts RangeOptions) [2]float64 {
+	if v, ok := value.([2]float64); ok {
+		return clampDualRange(v, opts)
+	}
+	opts = opts.normalized()
+	return [2]float64{opts.Min, opts.Max}
+}
+
+func formatRangeNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// normalizeRangeField stores the value a slider shows when it differs from
+// the field's value, e.g. because it is unset or the options changed since
+// it was stored. Sliders call it once mounted rather than while rendering.
+func normalizeRangeField(state *form.State, fieldName string, normalize func(any) any) {
+	current := state.GetFieldValue(fieldName)
+	if value := normalize(current); current != value {
+		state.SetFieldValue(fieldName, value)
+	}
+}
+
+// setRangeAttr sets an attribute on the element with the given ID, if present
+func setRangeAttr(id, name, value string) {
+	if el := dom.GetElementByID(id); el != nil {
+		el.SetAttribute(name, value)
+	}
+}
+
+// RangeSlider creates a slider widget bound to form state.
+// The field value is a float64 clamped to [Min, Max] and snapped to Step.
+func RangeSlider(state *form.State, fieldName string, opts RangeOptions) Node {
+	opts = opts.normalized()
+	value := rangeValue(state.GetFieldValue(fieldName), opts)
+
+	className := opts.Class
+	if className == "" {
+		className = "relative w-full"
+	}
+	fillID := fieldName + "_fill"
+
+	return html.Div(
+		html.Class(className),
+		html.Div(
+			html.ID(fillID),
+			html.Class("range-fill h-1 bg-blue-600 rounded"),
+			html.Style(fmt.Sprintf("width: %.2f%%", opts.percent(value))),
+		),
+		html.Input(
+			html.Type("range"),
+			html.Name(fieldName),
+			html.ID(fieldName),
+			html.Class("w-full"),
+			html.Min(formatRangeNumber(opts.Min)),
+			html.Max(formatRangeNumber(opts.Max)),
+			html.Step(formatRangeNumber(opts.Step)),
+			html.Value(formatRangeNumber(value)),
+			If(opts.Disabled, html.Disabled()),
+			dom.OnInputInline(func(el dom.Element) {
+				raw := el.Underlying().Get("value").String()
+				state.SetFieldValue(fieldName, rangeValue(raw, opts))
+				// Trigger validation on change
+				state.HandleFieldChange(fieldName)
+			}),
+		),
+		// Keep the fill and input in sync with the field value
+		comps.OnMount(func() {
+			normalizeRangeField(state, fieldName, func(v any) any { return rangeValue(v, opts) })
+			reactivity.CreateEffectWithOptions(func() {
+				v := rangeValue(state.GetFieldValue(fieldName), opts)
+				setRangeAttr(fillID, "style", fmt.Sprintf("width: %.2f%%", opts.percent(v)))
+				if el := dom.GetElementByID(fieldName); el != nil {
+					el.Underlying().Set("value", formatRangeNumber(v))
+				}
+			}, reactivity.EffectOptions{Priority: reactivity.PriorityHigh})
+		}),
+	)
+}
+
+// DualRangeSlider creates a two-handle range widget bound to form state.
+// The field value is a [2]float64 holding the low and high bounds; arrow keys
+// move the focused handle by Step, pointers drag a handle or press the
+// track to move the nearest one there, and the handles never cross.
+func DualRangeSlider(state *form.State, fieldName string, opts RangeOptions) Node {
+	opts = opts.normalized()
+	value := dualRangeValue(state.GetFieldValue(fieldName), opts)
+
+	className := opts.Class
+	if className == "" {
+		className = "relative w-full h-6"
+	}
+	fillID := fieldName + "_fill"
+	handleID := func(index int) string { return fmt.Sprintf("%s_%d", fieldName, index) }
+	fillStyle := func(v [2]float64) string {
+		low, high := opts.percent(v[0]), opts.percent(v[1])
+		return fmt.Sprintf("left: %.2f%%; width: %.2f%%", low, high-low)
+	}
+
+	handle := func(index int, label string) Node {
+		return html.Div(
+			html.ID(handleID(index)),
+			html.Class("range-handle absolute w-4 h-4 -mt-1.5 bg-white border-2 border-blue-600 rounded-full"),
+			html.Style(fmt.Sprintf("left: %.2f%%", opts.percent(value[index]))),
+			Attr("role", "slider"),
+			Attr("aria-label", label),
+			Attr("aria-valuemin", formatRangeNumber(opts.Min)),
+			Attr("aria-valuemax", formatRangeNumber(opts.Max)),
+			Attr("aria-valuenow", formatRangeNumber(value[index])),
+			If(opts.Disabled, Attr("aria-disabled", "true")),
+			If(!opts.Disabled, Attr("tabindex", "0")),
+			If(!opts.Disabled, dom.OnArrowKeysInline(func(el dom.Element, direction string) {
+				next := stepDualRange(dualRangeValue(state.GetFieldValue(fieldName), opts), index, direction, opts)
+				state.SetFieldValue(fieldName, next)
+				// Trigger validation on change
+				state.HandleFieldChange(fieldName)
+			})),
+		)
+	}
+
+	return html.Div(
+		html.Class(className),
+		html.ID(fieldName),
+		// Let touch drags move the handles rather than scroll the page
+		html.Style("touch-action: none"),
+		html.Div(
+			html.ID(fillID),
+			html.Class("range-fill absolute h-1 bg-blue-600 rounded"),
+			html.Style(fillStyle(value)),
+		),
+		handle(0, "Minimum"),
+		handle(1, "Maximum"),
+		// Keep the fill and handles in sync with the field value
+		comps.OnMount(func() {
+			normalizeRangeField(state, fieldName, func(v any) any { return dualRangeValue(v, opts) })
+			if !opts.Disabled {
+				dragDualRange(state, fieldName, opts, handleID)
+			}
+			reactivity.CreateEffectWithOptions(func() {
+				v := dualRangeValue(state.GetFieldValue(fieldName), opts)
+				setRangeAttr(fillID, "style", fillStyle(v))
+				for i := range v {
+					setRangeAttr(handleID(i), "style", fmt.Sprintf("left: %.2f%%", opts.percent(v[i])))
+					setRangeAttr(handleID(i), "aria-valuenow", formatRangeNumber(v[i]))
+				}
+			}, reactivity.EffectOptions{Priority: reactivity.PriorityHigh})
+		}),
+	)
+}
+
+// dragDualRange lets pointers move the handles of the DualRangeSlider
+// fieldName: pressing the track moves the nearest handle, or the pressed
+// one, to the pointer and the handle follows it until it is released.
+// Validation runs once, on release.
+func dragDualRange(state *form.State, fieldName string, opts RangeOptions, handleID func(int) string) {
+	document := js.Global().Get("document")
+	track := document.Call("getElementById", fieldName)
+	if !track.Truthy() {
+		return
+	}
+
+	valueAt := func(clientX float64) float64 {
+		rect := track.Call("getBoundingClientRect")
+		width := rect.Get("width").Float()
+		if width <= 0 {
+			return opts.Min
+		}
+		return rangeValueAt((clientX-rect.Get("left").Float())/width, opts)
+	}
+	dragging := -1
+	moveTo := func(clientX float64) {
+		v := dualRangeValue(state.GetFieldValue(fieldName), opts)
+		state.SetFieldValue(fieldName, moveDualRange(v, dragging, valueAt(clientX)))
+	}
+
+	var move, up, down js.Func
+	stop := func() {
+		document.Call("removeEventListener", "pointermove", move)
+		document.Call("removeEventListener", "pointerup", up)
+		document.Call("removeEventListener", "pointercancel", up)
+	}
+	move = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if dragging >= 0 && len(args) > 0 {
+			moveTo(args[0].Get("clientX").Float())
+		}
+		return nil
+	})
+	up = js.FuncOf(func(this js.Value, args []js.Value) any {
+		stop()
+		if dragging >= 0 {
+			dragging = -1
+			// Trigger validation on change
+			state.HandleFieldChange(fieldName)
+		}
+		return nil
+	})
+	down = js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 || args[0].Get("button").Int() != 0 {
+			return nil
+		}
+		evt := args[0]
+		// Keep the press from selecting text or stealing focus from the handle
+		evt.Call("preventDefault")
+		clientX := evt.Get("clientX").Float()
+		dragging = nearestDualHandle(dualRangeValue(state.GetFieldValue(fieldName), opts), valueAt(clientX))
+		if pressed := evt.Get("target").Call("closest", "[role=slider]"); pressed.Truthy() {
+			for i := 0; i < 2; i++ {
+				if pressed.Get("id").String() == handleID(i) {
+					dragging = i
+				}
+			}
+		}
+		if el := document.Call("getElementById", handleID(dragging)); el.Truthy() {
+			el.Call("focus")
+		}
+		moveTo(clientX)
+		stop()
+		document.Call("addEventListener", "pointermove", move)
+		document.Call("addEventListener", "pointerup", up)
+		document.Call("addEventListener", "pointercancel", up)
+		return nil
+	})
+	track.Call("addEventListener", "pointerdown", down)
+
+	reactivity.RegisterCleanup(func() {
+		track.Call("removeEventListener", "pointerdown", down)
+		stop()
+		down.Release()
+		move.Release()
+		up.Release()
+	})
+}
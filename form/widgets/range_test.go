@@ -0,0 +1,175 @@
+//go:build js && wasm
+
+package widgets
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/comps"
+	"github.com/ozanturksever/uiwgo/form"
+	g "maragu.dev/gomponents"
+)
+
+func TestStepDualRangeKeyboard(t *testing.T) {
+	opts := RangeOptions{Min: 0, Max: 100, Step: 5}
+
+	tests := []struct {
+		name      string
+		value     [2]float64
+		handle    int
+		direction string
+		want      [2]float64
+	}{
+		{"right moves low handle up", [2]float64{10, 50}, 0, "right", [2]float64{15, 50}},
+		{"down moves high handle down", [2]float64{10, 50}, 1, "down", [2]float64{10, 45}},
+		{"low handle stops at high handle", [2]float64{50, 50}, 0, "up", [2]float64{50, 50}},
+		{"high handle stops at low handle", [2]float64{50, 50}, 1, "left", [2]float64{50, 50}},
+		{"high handle clamps at max", [2]float64{10, 100}, 1, "right", [2]float64{10, 100}},
+		{"low handle clamps at min", [2]float64{0, 50}, 0, "left", [2]float64{0, 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stepDualRange(tt.value, tt.handle, tt.direction, opts)
+			if got != tt.want {
+				t.Errorf("stepDualRange(%v, %d, %q) = %v, want %v", tt.value, tt.handle, tt.direction, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeOptionsClamp(t *testing.T) {
+	opts := RangeOptions{Min: 10, Max: 20, Step: 2}
+	cases := map[float64]float64{5: 10, 25: 20, 13: 14, 16: 16}
+	for in, want := range cases {
+		if got := opts.clamp(in); got != want {
+			t.Errorf("clamp(%v) = %v, want %v", in, got, want)
+		}
+	}
+	if got := clampDualRange([2]float64{18, 12}, opts); got != [2]float64{12, 18} {
+		t.Errorf("clampDualRange should keep low <= high, got %v", got)
+	}
+}
+
+// mountRange mounts render into a fresh container and returns its disposer.
+func mountRange(t *testing.T, render func() g.Node) (js.Value, func()) {
+	t.Helper()
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-range")
+	document.Get("body").Call("appendChild", container)
+	disposer := comps.Mount("test-range", render)
+	return container, func() {
+		disposer()
+		document.Get("body").Call("removeChild", container)
+	}
+}
+
+func TestRangeSlidersClampWhenOptionsChange(t *testing.T) {
+	state := form.NewFromSchema([]form.FieldDef{{Name: "price"}, {Name: "priceRange"}})
+	state.SetFieldValue("price", float64(80))
+	state.SetFieldValue("priceRange", [2]float64{20, 80})
+
+	// Rendering alone does not write form state
+	RangeSlider(state, "price", RangeOptions{Min: 0, Max: 50, Step: 1})
+	DualRangeSlider(state, "priceRange", RangeOptions{Min: 30, Max: 60, Step: 1})
+	if got := state.GetFieldValue("price"); got != float64(80) {
+		t.Errorf("expected render to leave price at 80, got %v", got)
+	}
+
+	_, cleanup := mountRange(t, func() g.Node {
+		return g.El("div",
+			RangeSlider(state, "price", RangeOptions{Min: 0, Max: 50, Step: 1}),
+			DualRangeSlider(state, "priceRange", RangeOptions{Min: 30, Max: 60, Step: 1}),
+		)
+	})
+	defer cleanup()
+	if got := state.GetFieldValue("price"); got != float64(50) {
+		t.Errorf("expected price clamped to new Max 50, got %v", got)
+	}
+	if got := state.GetFieldValue("priceRange"); got != [2]float64{30, 60} {
+		t.Errorf("expected price range clamped to [30 60], got %v", got)
+	}
+}
+
+func TestDualRangePointerHelpers(t *testing.T) {
+	opts := RangeOptions{Min: 0, Max: 100, Step: 5}
+	if got := rangeValueAt(0.33, opts); got != 35 {
+		t.Errorf("rangeValueAt(0.33) = %v, want 35", got)
+	}
+	if got := rangeValueAt(1.5, opts); got != 100 {
+		t.Errorf("rangeValueAt(1.5) = %v, want 100", got)
+	}
+
+	handles := []struct {
+		value [2]float64
+		x     float64
+		want  int
+	}{
+		{[2]float64{20, 80}, 30, 0},
+		{[2]float64{20, 80}, 70, 1},
+		{[2]float64{20, 80}, 5, 0},
+		{[2]float64{50, 50}, 40, 0},
+		{[2]float64{50, 50}, 60, 1},
+	}
+	for _, h := range handles {
+		if got := nearestDualHandle(h.value, h.x); got != h.want {
+			t.Errorf("nearestDualHandle(%v, %v) = %d, want %d", h.value, h.x, got, h.want)
+		}
+	}
+
+	if got := moveDualRange([2]float64{20, 80}, 0, 90); got != [2]float64{80, 80} {
+		t.Errorf("low handle should stop at the high one, got %v", got)
+	}
+	if got := moveDualRange([2]float64{20, 80}, 1, 10); got != [2]float64{20, 20} {
+		t.Errorf("high handle should stop at the low one, got %v", got)
+	}
+}
+
+func TestDualRangeSliderPointerDrag(t *testing.T) {
+	state := form.NewFromSchema([]form.FieldDef{{Name: "span"}})
+	state.SetFieldValue("span", [2]float64{20, 80})
+	container, cleanup := mountRange(t, func() g.Node {
+		return g.El("div", g.Attr("style", "width: 200px"),
+			DualRangeSlider(state, "span", RangeOptions{Min: 0, Max: 100, Step: 1}),
+		)
+	})
+	defer cleanup()
+
+	track := container.Call("querySelector", "#span")
+	left := track.Call("getBoundingClientRect").Get("left").Float()
+	pointer := func(target js.Value, eventType string, percent float64) {
+		evt := js.Global().Get("PointerEvent").New(eventType, map[string]any{
+			"bubbles": true,
+			"button":  0,
+			"clientX": left + percent*2,
+		})
+		target.Call("dispatchEvent", evt)
+	}
+	document := js.Global().Get("document")
+
+	// Pressing the track moves the nearest handle there
+	pointer(track, "pointerdown", 30)
+	if got := state.GetFieldValue("span"); got != [2]float64{30, 80} {
+		t.Fatalf("after pressing the track at 30%%: %v, want [30 80]", got)
+	}
+	// The handle follows the pointer but never passes the other one
+	pointer(document, "pointermove", 90)
+	if got := state.GetFieldValue("span"); got != [2]float64{80, 80} {
+		t.Fatalf("after dragging past the high handle: %v, want [80 80]", got)
+	}
+	pointer(document, "pointerup", 90)
+	pointer(document, "pointermove", 10)
+	if got := state.GetFieldValue("span"); got != [2]float64{80, 80} {
+		t.Fatalf("pointer moves after release changed the value to %v", got)
+	}
+
+	// Pressing a handle drags that handle
+	pointer(container.Call("querySelector", "#span_1"), "pointerdown", 80)
+	pointer(document, "pointermove", 95)
+	pointer(document, "pointerup", 95)
+	if got := state.GetFieldValue("span"); got != [2]float64{80, 95} {
+		t.Fatalf("after dragging the high handle: %v, want [80 95]", got)
+	}
+}
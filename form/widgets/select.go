@@ -114,7 +114,10 @@ func SelectWidget(state *form.State, fieldName string, opts SelectOptions) Node
 			}
 			
 			// Trigger validation on change
-			state.ValidateField(fieldName)
+			state.HandleFieldChange(fieldName)
+		}),
+		dom.OnBlurInline(func(el dom.Element) {
+			state.HandleFieldBlur(fieldName)
 		}),
 		Group(options),
 	)
@@ -146,7 +149,10 @@ func SelectWidget(state *form.State, fieldName string, opts SelectOptions) Node
 					selectedValue := el.Underlying().Get("value").String()
 					state.SetFieldValue(fieldName, selectedValue)
 				}
-				state.ValidateField(fieldName)
+				state.HandleFieldChange(fieldName)
+			}),
+			dom.OnBlurInline(func(el dom.Element) {
+				state.HandleFieldBlur(fieldName)
 			}),
 			Group(options),
 			Group(attrs),
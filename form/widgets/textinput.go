@@ -1,6 +1,9 @@
 package widgets
 
 import (
+	"syscall/js"
+	"unicode/utf16"
+
 	"github.com/ozanturksever/uiwgo/dom"
 	"github.com/ozanturksever/uiwgo/form"
 	. "maragu.dev/gomponents"
@@ -9,13 +12,7 @@ import (
 
 // TextInput creates a text input widget bound to a form field
 func TextInput(state *form.State, fieldName string, attrs ...Node) Node {
-	value := state.GetFieldValue(fieldName)
-	strValue := ""
-	if value != nil {
-		if s, ok := value.(string); ok {
-			strValue = s
-		}
-	}
+	strValue := state.FormatFieldValue(fieldName)
 	
 	return Input(
 		append([]Node{
@@ -24,26 +21,13 @@ func TextInput(state *form.State, fieldName string, attrs ...Node) Node {
 			ID(fieldName),
 			Value(strValue),
 			Class("w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm placeholder-gray-400 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 transition-colors duration-200"),
-			dom.OnInputInline(func(el dom.Element) {
-			// Update form state when input changes
-			newValue := el.Underlying().Get("value").String()
-			state.SetFieldValue(fieldName, newValue)
-			// Trigger validation for this field
-			state.ValidateField(fieldName)
-		}),
-		}, attrs...)...,
+		}, append(textFieldHandlers(state, fieldName), attrs...)...)...,
 	)
 }
 
 // PasswordInput creates a password input widget bound to a form field
 func PasswordInput(state *form.State, fieldName string, attrs ...Node) Node {
-	value := state.GetFieldValue(fieldName)
-	strValue := ""
-	if value != nil {
-		if s, ok := value.(string); ok {
-			strValue = s
-		}
-	}
+	strValue := state.FormatFieldValue(fieldName)
 	
 	return Input(
 		append([]Node{
@@ -52,26 +36,13 @@ func PasswordInput(state *form.State, fieldName string, attrs ...Node) Node {
 			ID(fieldName),
 			Value(strValue),
 			Class("w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm placeholder-gray-400 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 transition-colors duration-200"),
-			dom.OnInputInline(func(el dom.Element) {
-				// Update form state when input changes
-				newValue := el.Underlying().Get("value").String()
-				state.SetFieldValue(fieldName, newValue)
-				// Trigger validation for this field
-				state.ValidateField(fieldName)
-			}),
-		}, attrs...)...,
+		}, append(textFieldHandlers(state, fieldName), attrs...)...)...,
 	)
 }
 
 // EmailInput creates an email input widget bound to a form field
 func EmailInput(state *form.State, fieldName string, attrs ...Node) Node {
-	value := state.GetFieldValue(fieldName)
-	strValue := ""
-	if value != nil {
-		if s, ok := value.(string); ok {
-			strValue = s
-		}
-	}
+	strValue := state.FormatFieldValue(fieldName)
 	
 	return Input(
 		append([]Node{
@@ -80,26 +51,13 @@ func EmailInput(state *form.State, fieldName string, attrs ...Node) Node {
 			ID(fieldName),
 			Value(strValue),
 			Class("w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm placeholder-gray-400 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 transition-colors duration-200"),
-			dom.OnInputInline(func(el dom.Element) {
-			// Update form state when input changes
-			newValue := el.Underlying().Get("value").String()
-			state.SetFieldValue(fieldName, newValue)
-			// Trigger validation for this field
-			state.ValidateField(fieldName)
-		}),
-		}, attrs...)...,
+		}, append(textFieldHandlers(state, fieldName), attrs...)...)...,
 	)
 	}
 	
 	// TextArea creates a textarea widget bound to a form field
 	func TextArea(state *form.State, fieldName string, attrs ...Node) Node {
-		value := state.GetFieldValue(fieldName)
-		strValue := ""
-		if value != nil {
-			if s, ok := value.(string); ok {
-				strValue = s
-			}
-		}
+		strValue := state.FormatFieldValue(fieldName)
 		
 		return Textarea(
 			append([]Node{
@@ -107,13 +65,56 @@ func EmailInput(state *form.State, fieldName string, attrs ...Node) Node {
 				ID(fieldName),
 				Text(strValue),
 				Class("w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm placeholder-gray-400 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 transition-colors duration-200 resize-vertical min-h-[100px]"),
-				dom.OnInputInline(func(el dom.Element) {
-					// Update form state when input changes
-					newValue := el.Underlying().Get("value").String()
-					state.SetFieldValue(fieldName, newValue)
-					// Trigger validation for this field
-					state.ValidateField(fieldName)
-				}),
-		}, attrs...)...,
+		}, append(textFieldHandlers(state, fieldName), attrs...)...)...,
 	)
-}
\ No newline at end of file
+}
+
+// textFieldHandlers binds a text control to a field. Input stores the
+// typed text, which SetFieldValue normalizes; the control shows the
+// formatted stored value on blur, or after every input when the field
+// uses NormalizeOnChange.
+func textFieldHandlers(state *form.State, fieldName string) []Node {
+	return []Node{
+		dom.OnInputInline(func(el dom.Element) {
+			// Update form state when input changes
+			state.SetFieldValue(fieldName, el.Underlying().Get("value").String())
+			if def := state.GetFieldDef(fieldName); def != nil && def.NormalizeOn == form.NormalizeOnChange {
+				showFormatted(el.Underlying(), state.FormatFieldValue(fieldName))
+			}
+			// Trigger validation for this field
+			state.HandleFieldChange(fieldName)
+		}),
+		dom.OnBlurInline(func(el dom.Element) {
+			showFormatted(el.Underlying(), state.FormatFieldValue(fieldName))
+			state.HandleFieldBlur(fieldName)
+		}),
+	}
+}
+
+// showFormatted replaces the control's text when it differs from text,
+// keeping the caret at the same distance from the end of the value.
+func showFormatted(el js.Value, text string) {
+	current := el.Get("value").String()
+	if current == text {
+		return
+	}
+	fromEnd := -1
+	if el.Equal(js.Global().Get("document").Get("activeElement")) {
+		if end := el.Get("selectionEnd"); end.Type() == js.TypeNumber {
+			fromEnd = utf16Len(current) - end.Int()
+		}
+	}
+	el.Set("value", text)
+	if fromEnd >= 0 {
+		pos := utf16Len(text) - fromEnd
+		if pos < 0 {
+			pos = 0
+		}
+		el.Call("setSelectionRange", pos, pos)
+	}
+}
+
+// utf16Len returns the length of s as the DOM counts it.
+func utf16Len(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
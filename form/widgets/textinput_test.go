@@ -0,0 +1,89 @@
+//go:build js && wasm
+
+package widgets
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/comps"
+	"github.com/ozanturksever/uiwgo/form"
+	g "maragu.dev/gomponents"
+)
+
+func mountTextInput(t *testing.T, def form.FieldDef) (*form.State, js.Value, func()) {
+	t.Helper()
+	document := js.Global().Get("document")
+	container := document.Call("createElement", "div")
+	container.Set("id", "test-textinput")
+	document.Get("body").Call("appendChild", container)
+
+	state := form.NewFromSchema([]form.FieldDef{def})
+	disposer := comps.Mount("test-textinput", func() g.Node {
+		return TextInput(state, def.Name)
+	})
+	input := container.Call("querySelector", "input")
+	return state, input, func() {
+		disposer()
+		document.Get("body").Call("removeChild", container)
+	}
+}
+
+func typeInto(input js.Value, text string) {
+	input.Call("focus")
+	input.Set("value", text)
+	input.Call("dispatchEvent", js.Global().Get("Event").New("input", map[string]any{"bubbles": true}))
+}
+
+func TestTextInputTrimsOnBlur(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	state, input, cleanup := mountTextInput(t, form.FieldDef{
+		Name:      "name",
+		Normalize: []func(any) any{form.TrimSpace},
+	})
+	defer cleanup()
+
+	typeInto(input, "  Ada ")
+	if got := state.GetFieldValue("name"); got != "Ada" {
+		t.Errorf("stored value = %q, want %q", got, "Ada")
+	}
+	// The text is left alone while typing so the caret does not jump
+	if got := input.Get("value").String(); got != "  Ada " {
+		t.Errorf("input text while focused = %q, want the typed text", got)
+	}
+
+	// Inline handlers are delegated to the mount root, so the blur must bubble
+	input.Call("dispatchEvent", js.Global().Get("FocusEvent").New("blur", map[string]any{"bubbles": true}))
+	if got := input.Get("value").String(); got != "Ada" {
+		t.Errorf("input text after blur = %q, want %q", got, "Ada")
+	}
+}
+
+func TestTextInputFormatsPhoneOnChange(t *testing.T) {
+	if js.Global().Get("document").IsUndefined() {
+		t.Skip("Skipping browser-specific test")
+	}
+	state, input, cleanup := mountTextInput(t, form.FieldDef{
+		Name:        "phone",
+		Normalize:   []func(any) any{form.DigitsOnly},
+		NormalizeOn: form.NormalizeOnChange,
+		Format: func(value any) string {
+			digits, _ := value.(string)
+			if len(digits) != 10 {
+				return digits
+			}
+			return digits[:3] + "-" + digits[3:6] + "-" + digits[6:]
+		},
+	})
+	defer cleanup()
+
+	typeInto(input, "555.123.4567")
+	if got := state.GetFieldValue("phone"); got != "5551234567" {
+		t.Errorf("stored value = %q, want digits only", got)
+	}
+	if got := input.Get("value").String(); got != "555-123-4567" {
+		t.Errorf("input text = %q, want formatted phone", got)
+	}
+}
@@ -0,0 +1,115 @@
+package reactivity
+
+import "sort"
+
+var (
+	// batchDepth counts the Batch calls currently on the stack.
+	batchDepth int
+	// pendingEffects holds effects queued while batching, without duplicates.
+	pendingEffects []*effect
+	// version counts the committed write sets; see Version
+	version uint64
+	// uncommitted is set by writes not yet counted in version
+	uncommitted bool
+	// deferredAfterBatch is set when a flush of deferred effects was held
+	// back by an open batch
+	deferredAfterBatch bool
+)
+
+// Batch runs fn and defers the effects triggered by signal writes inside it
+// until fn returns, so each affected effect runs at most once no matter how
+// many of its dependencies changed. Nested batches flush when the outermost
+// one completes. Memos read inside a batch are recomputed on demand and
+// always reflect the latest writes.
+//
+// Consistency: the writes of a batch are committed together. While a batch
+// is open no effect re-runs, including deferred effects whose flush comes
+// due, say while fn waits on a channel and other goroutines get to run;
+// they run once the outermost batch ends. Every effect re-run after it
+// therefore sees all of the batch's writes or, if it started before, none
+// of them. Code called from fn itself, such as effects created inside it,
+// sees the writes made so far. The graph is meant to be used from one
+// goroutine at a time; in the browser that is always the case, as
+// goroutines only switch where one blocks.
+func Batch(fn func()) {
+	batchDepth++
+	defer func() {
+		batchDepth--
+		if batchDepth == 0 {
+			flushPending()
+			if deferredAfterBatch {
+				deferredAfterBatch = false
+				flushDeferred()
+			}
+		}
+	}()
+	fn()
+}
+
+// Version returns the number of write sets committed so far: each signal
+// write outside a Batch is one, and the writes of an outermost Batch are one
+// together, as are the writes made by each round of effects it then runs.
+// Effects that record Version can check they never saw a batch half done.
+func Version() uint64 {
+	return version
+}
+
+// recordWrite counts a signal write in Version.
+func recordWrite() {
+	if batchDepth > 0 {
+		uncommitted = true
+		return
+	}
+	version++
+}
+
+// commitWrites counts the batched writes made since the last commit as one
+// write set.
+func commitWrites() {
+	if uncommitted {
+		uncommitted = false
+		version++
+	}
+}
+
+// schedule queues the effect for the current batch.
+func (e *effect) schedule() {
+	if e.deferred {
+		e.enqueueDeferred()
+		return
+	}
+	if e.pending || e.disposed {
+		return
+	}
+	e.pending = true
+	pendingEffects = append(pendingEffects, e)
+}
+
+// flushPending runs the effects queued by a batch. Memo trackers run first so
+// that effects reading a memo see its new value and are not queued twice;
+// the rest run in the usual priority order. Writes made by the flushed
+// effects are batched into a further round.
+func flushPending() {
+	batchDepth++
+	defer func() {
+		commitWrites()
+		batchDepth--
+	}()
+	for len(pendingEffects) > 0 {
+		commitWrites()
+		effects := pendingEffects
+		pendingEffects = nil
+		sortEffects(effects)
+		sort.SliceStable(effects, func(i, j int) bool {
+			return effects[i].memo && !effects[j].memo
+		})
+		for _, e := range effects {
+			if !e.pending {
+				// Already recomputed by a memo read
+				continue
+			}
+			e.pending = false
+			e.run()
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package reactivity
+
+import "testing"
+
+func TestBatchCoalescesEffectRuns(t *testing.T) {
+	a := CreateSignal(1)
+	b := CreateSignal("x")
+	runs := 0
+	CreateEffect(func() {
+		_ = a.Get()
+		_ = b.Get()
+		runs++
+	})
+
+	Batch(func() {
+		a.Set(2)
+		b.Set("y")
+		a.Set(3)
+		if runs != 1 {
+			t.Errorf("effects should not run inside a batch, runs = %d", runs)
+		}
+	})
+	if runs != 2 {
+		t.Fatalf("expected one run after the batch, total runs = %d", runs)
+	}
+}
+
+func TestBatchNestedFlushesAtOutermost(t *testing.T) {
+	s := CreateSignal(0)
+	var seen []int
+	CreateEffect(func() { seen = append(seen, s.Get()) })
+
+	Batch(func() {
+		s.Set(1)
+		Batch(func() {
+			s.Set(2)
+		})
+		if len(seen) != 1 {
+			t.Errorf("inner batch should not flush, seen = %v", seen)
+		}
+		s.Set(3)
+	})
+	if len(seen) != 2 || seen[1] != 3 {
+		t.Fatalf("expected a single flush with the final value, seen = %v", seen)
+	}
+}
+
+func TestBatchMemoSeesLatestValue(t *testing.T) {
+	s := CreateSignal(1)
+	calls := 0
+	double := CreateMemo(func() int {
+		calls++
+		return s.Get() * 2
+	})
+	_ = double.Get()
+
+	Batch(func() {
+		s.Set(5)
+		if v := double.Get(); v != 10 {
+			t.Errorf("memo inside batch = %d, want 10", v)
+		}
+	})
+	if calls != 2 {
+		t.Errorf("memo should recompute once, calls = %d", calls)
+	}
+}
+
+func TestBatchEffectReadingSignalAndMemoRunsOnce(t *testing.T) {
+	todos := CreateSignal([]string{"a"})
+	loading := CreateSignal(true)
+	remaining := CreateMemo(func() int { return len(todos.Get()) })
+
+	logs := 0
+	CreateEffect(func() {
+		_ = todos.Get()
+		_ = loading.Get()
+		_ = remaining.Get()
+		logs++
+	})
+
+	Batch(func() {
+		todos.Set([]string{"a", "b"})
+		loading.Set(false)
+	})
+	if logs != 2 {
+		t.Fatalf("expected the effect to run once for the batch, total runs = %d", logs)
+	}
+	if remaining.Get() != 2 {
+		t.Errorf("remaining = %d, want 2", remaining.Get())
+	}
+}
+
+func TestBatchWithoutChangesRunsNothing(t *testing.T) {
+	s := CreateSignal(1)
+	runs := 0
+	CreateEffect(func() {
+		_ = s.Get()
+		runs++
+	})
+	Batch(func() { s.Set(1) })
+	if runs != 1 {
+		t.Errorf("setting an equal value should not schedule effects, runs = %d", runs)
+	}
+}
+
+// TestBatchDeferredFlushWaitsForBatch lets the deferred flush come due
+// halfway through a batch, as the browser's microtask would while the batch
+// waits on a goroutine. Before batches held flushes back the deferred effect
+// saw the new items with the old selection.
+func TestBatchDeferredFlushWaitsForBatch(t *testing.T) {
+	items := CreateSignal(0)
+	selection := CreateSignal(0)
+	var seen [][2]int
+	e := CreateDeferredEffect(func() {
+		seen = append(seen, [2]int{items.Get(), selection.Get()})
+	})
+	defer e.Dispose()
+
+	Batch(func() {
+		items.Set(1)
+		FlushSync()
+		selection.Set(1)
+	})
+
+	if len(seen) != 2 || seen[1] != [2]int{1, 1} {
+		t.Fatalf("deferred effect saw %v, want [[0 0] [1 1]]", seen)
+	}
+}
+
+func TestVersionCountsBatchOnce(t *testing.T) {
+	items := CreateSignal(0)
+	selection := CreateSignal(0)
+	type observation struct {
+		version          uint64
+		items, selection int
+	}
+	var seen []observation
+	e := CreateEffect(func() {
+		seen = append(seen, observation{Version(), items.Get(), selection.Get()})
+	})
+	defer e.Dispose()
+
+	start := Version()
+	Batch(func() {
+		items.Set(1)
+		selection.Set(1)
+		items.Set(2)
+	})
+	if got := Version(); got != start+1 {
+		t.Errorf("Version advanced by %d for one batch, want 1", got-start)
+	}
+	if len(seen) != 2 || seen[1] != (observation{start + 1, 2, 1}) {
+		t.Fatalf("effect saw %+v, want one run at version %d with both writes", seen, start+1)
+	}
+
+	// Unbatched writes are a write set each, so the effect sees the mix
+	items.Set(3)
+	selection.Set(3)
+	if got := Version(); got != start+3 {
+		t.Errorf("Version advanced by %d for two writes, want 2", got-start-1)
+	}
+	if len(seen) != 4 || seen[2] != (observation{start + 2, 3, 1}) {
+		t.Errorf("effect saw %+v, want the first write alone at version %d", seen, start+2)
+	}
+
+	// A batch without changes commits nothing
+	Batch(func() { items.Set(3) })
+	if got := Version(); got != start+3 {
+		t.Errorf("Version advanced for a batch without changes")
+	}
+}
@@ -0,0 +1,112 @@
+package reactivity
+
+import "sync"
+
+// FromChannel returns a signal holding initial and then every value
+// received from ch. Values are received on their own goroutine, so a slow
+// or idle channel never blocks the caller, and set with the next flush:
+// in the browser in a microtask, elsewhere when FlushSync is called.
+// Receiving stops when ch is closed, leaving the last value in place, or
+// when the current cleanup scope is disposed; outside a cleanup scope it
+// runs until ch is closed.
+func FromChannel[T any](ch <-chan T, initial T) Signal[T] {
+	sig := CreateSignal(initial)
+	done := make(chan struct{})
+	// stopped is only used on the graph's goroutine
+	stopped := false
+	go func() {
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				post(func() {
+					if !stopped {
+						sig.Set(v)
+					}
+				})
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	RegisterCleanup(func() {
+		if !stopped {
+			stopped = true
+			close(done)
+		}
+	})
+	return sig
+}
+
+// ToChannel returns a channel with the given buffer size that receives
+// every new value of s. The current value is not sent. Sending never
+// blocks the writer of s: values the reader has not taken yet are queued
+// and delivered in order on a separate goroutine. The channel is closed
+// when the current cleanup scope is disposed; values still queued then are
+// dropped. Outside a cleanup scope it stays open for the life of s.
+func ToChannel[T any](s Signal[T], buffer int) <-chan T {
+	if buffer < 0 {
+		buffer = 0
+	}
+	out := make(chan T, buffer)
+	var (
+		mu      sync.Mutex
+		pending []T
+	)
+	wake := make(chan struct{}, 1)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-wake:
+			case <-done:
+				return
+			}
+			for {
+				mu.Lock()
+				if len(pending) == 0 {
+					mu.Unlock()
+					break
+				}
+				v := pending[0]
+				pending = pending[1:]
+				mu.Unlock()
+				select {
+				case out <- v:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	first := true
+	CreateEffect(func() {
+		v := s.Get()
+		if first {
+			first = false
+			return
+		}
+		mu.Lock()
+		pending = append(pending, v)
+		mu.Unlock()
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+
+	stopped := false
+	RegisterCleanup(func() {
+		if !stopped {
+			stopped = true
+			close(done)
+		}
+	})
+	return out
+}
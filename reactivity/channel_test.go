@@ -0,0 +1,115 @@
+package reactivity
+
+import (
+	"testing"
+	"time"
+)
+
+// waitUntil flushes the results goroutines handed back until cond holds or
+// a second has passed.
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for FlushSync(); !cond(); FlushSync() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// receive flushes the results goroutines handed back until ch delivers a
+// value, or until timeout has passed, in which case ok is false.
+func receive[T any](ch <-chan T, timeout time.Duration) (v T, ok bool) {
+	deadline := time.Now().Add(timeout)
+	for {
+		FlushSync()
+		select {
+		case v := <-ch:
+			return v, true
+		default:
+		}
+		if time.Now().After(deadline) {
+			return v, false
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int)
+	sig := FromChannel(ch, -1)
+	if got := sig.Peek(); got != -1 {
+		t.Fatalf("initial value = %d, want -1", got)
+	}
+	ch <- 1
+	ch <- 2
+	waitUntil(t, func() bool { return sig.Peek() == 2 })
+	close(ch)
+	time.Sleep(5 * time.Millisecond)
+	FlushSync()
+	if got := sig.Peek(); got != 2 {
+		t.Errorf("closing the channel changed the value to %d", got)
+	}
+}
+
+func TestFromChannelStopsOnDispose(t *testing.T) {
+	scope := NewCleanupScope(nil)
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(scope)
+	ch := make(chan string, 1)
+	sig := FromChannel(ch, "")
+	SetCurrentCleanupScope(prev)
+
+	ch <- "a"
+	waitUntil(t, func() bool { return sig.Peek() == "a" })
+	scope.Dispose()
+	time.Sleep(5 * time.Millisecond)
+
+	select {
+	case ch <- "b":
+	default:
+		t.Fatal("channel should have room for one more value")
+	}
+	time.Sleep(5 * time.Millisecond)
+	FlushSync()
+	if got := sig.Peek(); got != "a" {
+		t.Errorf("received %q after the scope was disposed", got)
+	}
+}
+
+func TestToChannel(t *testing.T) {
+	scope := NewCleanupScope(nil)
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(scope)
+	s := CreateSignal(0)
+	out := ToChannel(s, 0)
+	SetCurrentCleanupScope(prev)
+
+	// Unbuffered and unread: Set must not block
+	s.Set(1)
+	s.Set(1)
+	s.Set(2)
+	s.Set(3)
+	for _, want := range []int{1, 2, 3} {
+		select {
+		case got := <-out:
+			if got != want {
+				t.Fatalf("received %d, want %d", got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %d", want)
+		}
+	}
+
+	scope.Dispose()
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Error("received a value after the scope was disposed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed on dispose")
+	}
+	s.Set(4)
+}
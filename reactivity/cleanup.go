@@ -1,8 +1,10 @@
 package reactivity
 
 // OnCleanup registers a function to be called before the current effect
-// re-executes and when it is disposed. If called outside of an effect,
-// it is ignored in this MVP implementation.
+// re-executes and when it is disposed. Inside a CreateMemo computation it
+// runs before the memo recomputes and when the cleanup scope the memo was
+// created in is disposed. If called outside of an effect or memo, it is
+// ignored in this MVP implementation.
 func OnCleanup(fn func()) {
 	if currentEffect == nil || currentEffect.disposed {
 		return
@@ -1,6 +1,10 @@
 package reactivity
 
-import "testing"
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
 
 func TestOnCleanupCalledOnRerun(t *testing.T) {
 	s := CreateSignal(0)
@@ -38,3 +42,32 @@ func TestOnCleanupCalledOnDispose(t *testing.T) {
 		t.Fatalf("cleanup after dispose=%d, want 1", cleanupCalls)
 	}
 }
+
+func TestOnCleanupInsideMemo(t *testing.T) {
+	s := CreateSignal(1)
+	var log []string
+
+	scope := NewCleanupScope(nil)
+	SetCurrentCleanupScope(scope)
+	memo := CreateMemo(func() int {
+		v := s.Get()
+		log = append(log, "compute "+strconv.Itoa(v))
+		OnCleanup(func() { log = append(log, "cleanup "+strconv.Itoa(v)) })
+		return v * 10
+	})
+	SetCurrentCleanupScope(nil)
+
+	memo.Get()
+	s.Set(2)
+	memo.Get()
+	want := []string{"compute 1", "cleanup 1", "compute 2"}
+	if !reflect.DeepEqual(log, want) {
+		t.Fatalf("log after recompute = %v, want %v", log, want)
+	}
+
+	scope.Dispose()
+	want = append(want, "cleanup 2")
+	if !reflect.DeepEqual(log, want) {
+		t.Errorf("log after scope disposal = %v, want %v", log, want)
+	}
+}
@@ -0,0 +1,204 @@
+package reactivity
+
+// MapSignal is a reactive map. Unlike a Signal holding a whole map, reading
+// one key only subscribes to that key, so writing another key re-runs none
+// of its readers.
+type MapSignal[K comparable, V any] interface {
+	// Get returns a signal for the value stored under k, or the zero value
+	// while k is absent. Setting it is the same as calling Set(k, v).
+	Get(k K) Signal[V]
+	// Set stores v under k.
+	Set(k K, v V)
+	// Delete removes k; its readers see the zero value.
+	Delete(k K)
+	// Len returns a signal holding the number of keys. Setting it has no
+	// effect.
+	Len() Signal[int]
+	// Keys returns a signal holding the keys in insertion order. Setting it
+	// has no effect.
+	Keys() Signal[[]K]
+}
+
+// SetSignal is a reactive set; see MapSignal.
+type SetSignal[T comparable] interface {
+	// Has returns a signal reporting whether v is in the set. Setting it
+	// adds or removes v.
+	Has(v T) Signal[bool]
+	Add(v T)
+	Delete(v T)
+	// Toggle adds v when absent and removes it otherwise.
+	Toggle(v T)
+	// Len returns a signal holding the number of values. Setting it has no
+	// effect.
+	Len() Signal[int]
+	// Values returns a signal holding the values in insertion order.
+	// Setting it has no effect.
+	Values() Signal[[]T]
+}
+
+// mapSignal backs CreateMapSignal with one signal per observed or stored
+// key.
+type mapSignal[K comparable, V any] struct {
+	entries map[K]*mapEntry[V]
+	keys    *baseSignal[[]K]
+	length  *baseSignal[int]
+}
+
+// mapEntry is the signal of one key; present is false for keys that are
+// only observed.
+type mapEntry[V any] struct {
+	sig     *baseSignal[V]
+	present bool
+}
+
+// CreateMapSignal returns an empty MapSignal.
+func CreateMapSignal[K comparable, V any]() MapSignal[K, V] {
+	return &mapSignal[K, V]{
+		entries: make(map[K]*mapEntry[V]),
+		keys:    &baseSignal[[]K]{value: []K{}, deps: make(map[*effect]struct{})},
+		length:  &baseSignal[int]{deps: make(map[*effect]struct{})},
+	}
+}
+
+func (m *mapSignal[K, V]) entry(k K) *mapEntry[V] {
+	e, ok := m.entries[k]
+	if !ok {
+		e = &mapEntry[V]{sig: &baseSignal[V]{deps: make(map[*effect]struct{})}}
+		m.entries[k] = e
+	}
+	return e
+}
+
+func (m *mapSignal[K, V]) Get(k K) Signal[V] {
+	return &mapKey[K, V]{m: m, k: k}
+}
+
+// read returns the value under k, subscribing the current effect to k only.
+// Untracked reads do not allocate a signal for an absent key.
+func (m *mapSignal[K, V]) read(k K) V {
+	if currentEffect == nil || currentEffect.disposed || untracking {
+		return m.peek(k)
+	}
+	return m.entry(k).sig.Get()
+}
+
+func (m *mapSignal[K, V]) peek(k K) V {
+	if e, ok := m.entries[k]; ok {
+		return e.sig.value
+	}
+	var zero V
+	return zero
+}
+
+func (m *mapSignal[K, V]) Set(k K, v V) {
+	e := m.entry(k)
+	Batch(func() {
+		if !e.present {
+			e.present = true
+			keys := append(append(make([]K, 0, len(m.keys.value)+1), m.keys.value...), k)
+			m.keys.Set(keys)
+			m.length.Set(len(keys))
+		}
+		e.sig.Set(v)
+	})
+}
+
+func (m *mapSignal[K, V]) Delete(k K) {
+	e, ok := m.entries[k]
+	if !ok || !e.present {
+		return
+	}
+	e.present = false
+	keys := make([]K, 0, len(m.keys.value))
+	for _, key := range m.keys.value {
+		if key != k {
+			keys = append(keys, key)
+		}
+	}
+	Batch(func() {
+		var zero V
+		e.sig.Set(zero)
+		m.keys.Set(keys)
+		m.length.Set(len(keys))
+	})
+	if len(e.sig.deps) == 0 {
+		delete(m.entries, k)
+	}
+}
+
+func (m *mapSignal[K, V]) Len() Signal[int] { return readOnlyView[int]{m.length} }
+
+func (m *mapSignal[K, V]) Keys() Signal[[]K] { return readOnlyView[[]K]{m.keys} }
+
+// mapKey is the Signal handed out by MapSignal.Get.
+type mapKey[K comparable, V any] struct {
+	m *mapSignal[K, V]
+	k K
+}
+
+func (s *mapKey[K, V]) Get() V  { return s.m.read(s.k) }
+func (s *mapKey[K, V]) Peek() V { return s.m.peek(s.k) }
+func (s *mapKey[K, V]) Set(v V) { s.m.Set(s.k, v) }
+
+func (s *mapKey[K, V]) OnChange(fn func(old, new V)) func() { return onChange[V](s, nil, fn) }
+
+// readOnlyView is the Signal handed out for derived collection state such
+// as Len and Keys. Setting it has no effect.
+type readOnlyView[T any] struct {
+	sig *baseSignal[T]
+}
+
+func (v readOnlyView[T]) Get() T  { return v.sig.Get() }
+func (v readOnlyView[T]) Peek() T { return v.sig.Peek() }
+func (v readOnlyView[T]) Set(T)   {}
+
+func (v readOnlyView[T]) OnChange(fn func(old, new T)) func() { return v.sig.OnChange(fn) }
+
+// setSignal backs CreateSetSignal with a MapSignal of presence flags.
+type setSignal[T comparable] struct {
+	m *mapSignal[T, bool]
+}
+
+// CreateSetSignal returns an empty SetSignal.
+func CreateSetSignal[T comparable]() SetSignal[T] {
+	return &setSignal[T]{m: CreateMapSignal[T, bool]().(*mapSignal[T, bool])}
+}
+
+func (s *setSignal[T]) Has(v T) Signal[bool] {
+	return &setMember[T]{s: s, v: v}
+}
+
+func (s *setSignal[T]) Add(v T) { s.m.Set(v, true) }
+
+func (s *setSignal[T]) Delete(v T) { s.m.Delete(v) }
+
+func (s *setSignal[T]) Toggle(v T) {
+	if e, ok := s.m.entries[v]; ok && e.present {
+		s.Delete(v)
+	} else {
+		s.Add(v)
+	}
+}
+
+func (s *setSignal[T]) Len() Signal[int] { return s.m.Len() }
+
+func (s *setSignal[T]) Values() Signal[[]T] { return s.m.Keys() }
+
+// setMember is the Signal handed out by SetSignal.Has.
+type setMember[T comparable] struct {
+	s *setSignal[T]
+	v T
+}
+
+func (m *setMember[T]) Get() bool  { return m.s.m.read(m.v) }
+func (m *setMember[T]) Peek() bool { return m.s.m.peek(m.v) }
+
+func (m *setMember[T]) OnChange(fn func(old, new bool)) func() { return onChange[bool](m, nil, fn) }
+
+func (m *setMember[T]) Set(present bool) {
+	if present {
+		m.s.Add(m.v)
+	} else {
+		m.s.Delete(m.v)
+	}
+}
@@ -0,0 +1,120 @@
+package reactivity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapSignalPerKeyTracking(t *testing.T) {
+	m := CreateMapSignal[string, int]()
+	runs := 0
+	var seen int
+	CreateEffect(func() {
+		runs++
+		seen = m.Get("a").Get()
+	})
+
+	m.Set("b", 2)
+	if runs != 1 {
+		t.Fatalf("writing another key re-ran the reader: runs = %d", runs)
+	}
+	m.Set("a", 1)
+	if runs != 2 || seen != 1 {
+		t.Fatalf("runs = %d, seen = %d; want 2, 1", runs, seen)
+	}
+	m.Set("a", 1)
+	if runs != 2 {
+		t.Fatalf("setting an equal value re-ran the reader: runs = %d", runs)
+	}
+
+	m.Get("a").Set(5)
+	if seen != 5 {
+		t.Fatalf("write through Get gave %d, want 5", seen)
+	}
+	m.Delete("a")
+	if seen != 0 {
+		t.Fatalf("after Delete seen = %d, want the zero value", seen)
+	}
+}
+
+func TestMapSignalLenAndKeys(t *testing.T) {
+	m := CreateMapSignal[string, int]()
+	var keys []string
+	var n int
+	CreateEffect(func() {
+		keys = m.Keys().Get()
+		n = m.Len().Get()
+	})
+
+	m.Set("x", 1)
+	m.Set("y", 2)
+	m.Set("x", 3)
+	if want := []string{"x", "y"}; !reflect.DeepEqual(keys, want) || n != 2 {
+		t.Fatalf("keys = %v, len = %d; want %v, 2", keys, n, want)
+	}
+
+	m.Delete("x")
+	m.Delete("missing")
+	if want := []string{"y"}; !reflect.DeepEqual(keys, want) || n != 1 {
+		t.Fatalf("after Delete keys = %v, len = %d; want %v, 1", keys, n, want)
+	}
+
+	m.Len().Set(10)
+	if got := m.Len().Get(); got != 1 {
+		t.Errorf("Len().Set changed the length to %d", got)
+	}
+}
+
+func TestMapSignalUntrackedReadsDoNotRetainKeys(t *testing.T) {
+	m := CreateMapSignal[int, string]().(*mapSignal[int, string])
+	for i := 0; i < 10; i++ {
+		if got := m.Get(i).Get(); got != "" {
+			t.Fatalf("absent key %d read %q", i, got)
+		}
+	}
+	if len(m.entries) != 0 {
+		t.Errorf("untracked reads retained %d entries", len(m.entries))
+	}
+
+	m.Set(1, "one")
+	m.Delete(1)
+	if len(m.entries) != 0 {
+		t.Errorf("deleted key without readers was retained")
+	}
+}
+
+func TestSetSignal(t *testing.T) {
+	s := CreateSetSignal[int]()
+	hasOne, hasTwo := 0, 0
+	var one bool
+	CreateEffect(func() {
+		hasOne++
+		one = s.Has(1).Get()
+	})
+	CreateEffect(func() {
+		hasTwo++
+		s.Has(2).Get()
+	})
+
+	s.Add(1)
+	if !one || hasOne != 2 || hasTwo != 1 {
+		t.Fatalf("one = %v, runs = %d/%d; want true, 2/1", one, hasOne, hasTwo)
+	}
+	s.Toggle(1)
+	if one {
+		t.Fatal("Toggle should remove a present value")
+	}
+	s.Toggle(1)
+	if !one {
+		t.Fatal("Toggle should add an absent value")
+	}
+
+	s.Has(3).Set(true)
+	s.Has(1).Set(false)
+	if one {
+		t.Fatal("Has(1).Set(false) should remove 1")
+	}
+	if got, want := s.Values().Get(), []int{3}; !reflect.DeepEqual(got, want) || s.Len().Get() != 1 {
+		t.Errorf("values = %v, len = %d; want %v, 1", got, s.Len().Get(), want)
+	}
+}
@@ -1,18 +1,73 @@
 package reactivity
 
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+
+	"github.com/ozanturksever/logutil"
+)
+
+// Priority orders effects triggered by the same signal change.
+type Priority int
+
+const (
+	// PriorityLow is for effects that can run last, such as logging or analytics.
+	PriorityLow Priority = -1
+	// PriorityNormal is the default priority.
+	PriorityNormal Priority = 0
+	// PriorityHigh is for layout-critical effects such as DOM writes.
+	PriorityHigh Priority = 1
+)
+
+// EffectOptions configures CreateEffectWithOptions.
+type EffectOptions struct {
+	// Priority controls the order in which effects triggered by the same
+	// signal change run: High before Normal before Low. Within a priority,
+	// effects run in creation order.
+	Priority Priority
+}
+
+// effectSeq numbers effects in creation order.
+var effectSeq uint64
+
 // effect is the internal implementation of a reactive effect.
 // Not concurrency-safe; designed for single-threaded JS/WASM and tests.
 type effect struct {
 	fn       func()
+	priority Priority
+	seq      uint64
 	disposed bool
 	// deps holds the set of signals this effect currently depends on
 	deps map[depNode]struct{}
 	// cleanups are run before re-execution and at dispose
 	cleanups []func()
+	// memo marks the tracker effect of a memo; see flushPending
+	memo bool
+	// pending is set while the effect is queued by a batch
+	pending bool
+	// deferred marks effects created by CreateDeferredEffect, which re-run
+	// on the next flush instead of synchronously; queued is set while one
+	// waits for it
+	deferred bool
+	queued   bool
+	// scope is the cleanup scope the effect was created in; panics of its
+	// re-runs go to that scope's error handler
+	scope *CleanupScope
+	ran   bool
+	// paused is set while the effect waits for its suspended scope to
+	// resume
+	paused bool
+	// site counts the effect for the leak detector; nil while it is off
+	site *leakSite
 }
 
 // Effect represents a running reactive computation that can be disposed.
 type Effect interface {
+	// Dispose stops the effect: it runs its cleanups, unsubscribes from every
+	// signal it read and drops any run still queued by a Batch. Further
+	// calls are no-ops.
 	Dispose()
 }
 
@@ -27,8 +82,27 @@ var currentEffect *effect
 // re-runs whenever any of its dependent signals change.
 // If there's a current cleanup scope, the effect will be automatically
 // disposed when the scope is disposed.
+//
+// A panic in the first run propagates to the caller. A panic in a later
+// run is recovered and passed as an error to the nearest handler set with
+// CleanupScope.OnError on the effect's scope or its parents; without one it
+// is logged.
 func CreateEffect(fn func()) Effect {
-	e := &effect{fn: fn, deps: make(map[depNode]struct{})}
+	return CreateEffectWithOptions(fn, EffectOptions{})
+}
+
+// CreateEffectWithOptions is like CreateEffect but accepts options such as
+// the effect's Priority.
+func CreateEffectWithOptions(fn func(), opts EffectOptions) Effect {
+	return newEffect(fn, opts.Priority, false)
+}
+
+// newEffect creates an effect in the current cleanup scope, which disposes
+// it and handles the panics of its re-runs, and runs it.
+func newEffect(fn func(), priority Priority, deferred bool) *effect {
+	effectSeq++
+	e := &effect{fn: fn, priority: priority, seq: effectSeq, deferred: deferred, deps: make(map[depNode]struct{}), scope: currentCleanupScope}
+	trackEffect(e)
 	
 	// Register with current cleanup scope if available
 	RegisterCleanup(func() {
@@ -39,10 +113,30 @@ func CreateEffect(fn func()) Effect {
 	return e
 }
 
+// sortEffects orders effects by descending priority, then creation order.
+func sortEffects(effects []*effect) {
+	sort.Slice(effects, func(i, j int) bool {
+		if effects[i].priority != effects[j].priority {
+			return effects[i].priority > effects[j].priority
+		}
+		return effects[i].seq < effects[j].seq
+	})
+}
+
 func (e *effect) run() {
 	if e.disposed {
 		return
 	}
+	// Re-runs wait for a suspended scope; memos always compute
+	if e.ran && !e.memo {
+		if s := suspendedScope(e.scope); s != nil {
+			if !e.paused {
+				e.paused = true
+				s.paused = append(s.paused, e)
+			}
+			return
+		}
+	}
 	// Cleanup previous run
 	for _, c := range e.cleanups {
 		c()
@@ -53,12 +147,56 @@ func (e *effect) run() {
 		d.removeEffect(e)
 	}
 	e.deps = make(map[depNode]struct{})
-	// Run with this effect set as current
-	prev := currentEffect
-	currentEffect = e
+	// Run with this effect set as current and tracking enabled, even when
+	// created or re-run inside an Untrack callback
+	prev, prevUntracking := currentEffect, untracking
+	currentEffect, untracking = e, false
+	first := !e.ran
+	e.ran = true
+	defer func() {
+		currentEffect, untracking = prev, prevUntracking
+		if r := recover(); r != nil {
+			if first {
+				panic(r)
+			}
+			e.fail(r)
+		}
+	}()
 	e.fn()
+}
+
+// fail reports a panic recovered from a re-run of the effect.
+func (e *effect) fail(r any) {
+	var err error
+	if rerr, ok := r.(error); ok {
+		err = fmt.Errorf("effect panicked: %w", rerr)
+	} else {
+		err = fmt.Errorf("effect panicked: %v", r)
+	}
+	err = &panicError{err: err, stack: string(debug.Stack())}
+	if !reportError(e.scope, err) {
+		logutil.Logf("reactivity: %v", err)
+	}
+}
+
+// panicError is the error a recovered effect panic is reported as; it
+// keeps the stack of the goroutine at the panic.
+type panicError struct {
+	err   error
+	stack string
+}
 
-	currentEffect = prev
+func (p *panicError) Error() string { return p.err.Error() }
+func (p *panicError) Unwrap() error { return p.err }
+
+// PanicStack returns the stack trace captured when the effect panic
+// reported as err was recovered, or "" when err is not such a panic.
+func PanicStack(err error) string {
+	var p *panicError
+	if errors.As(err, &p) {
+		return p.stack
+	}
+	return ""
 }
 
 // Dispose stops the effect: runs final cleanups and detaches from dependencies.
@@ -67,6 +205,11 @@ func (e *effect) Dispose() {
 		return
 	}
 	e.disposed = true
+	if e.site != nil {
+		leakCount(&e.site.effects, -1)
+	}
+	e.pending = false
+	e.queued = false
 	for _, c := range e.cleanups {
 		c()
 	}
@@ -1,6 +1,10 @@
 package reactivity
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+)
 
 func TestEffectDependencyAndDispose(t *testing.T) {
 	s1 := CreateSignal(1)
@@ -35,3 +39,158 @@ func TestEffectDependencyAndDispose(t *testing.T) {
 		t.Fatalf("runs after dispose = %d, want 2", runs)
 	}
 }
+
+func TestEffectDisposeCancelsPendingRun(t *testing.T) {
+	s := CreateSignal(0)
+	runs := 0
+	e := CreateEffect(func() {
+		_ = s.Get()
+		runs++
+	})
+
+	// A run queued by the batch is dropped when the effect is disposed first
+	Batch(func() {
+		s.Set(1)
+		e.Dispose()
+	})
+	if runs != 1 {
+		t.Fatalf("runs after batched dispose = %d, want 1", runs)
+	}
+
+	// Disposing again is a no-op and the effect stays unsubscribed
+	e.Dispose()
+	s.Set(2)
+	if runs != 1 {
+		t.Fatalf("runs after second dispose = %d, want 1", runs)
+	}
+}
+
+func TestEffectPrioritiesOrderExecution(t *testing.T) {
+	s := CreateSignal(0)
+	var order []string
+	track := func(name string, p Priority) {
+		CreateEffectWithOptions(func() {
+			if s.Get() > 0 {
+				order = append(order, name)
+			}
+		}, EffectOptions{Priority: p})
+	}
+
+	track("low-1", PriorityLow)
+	track("normal-1", PriorityNormal)
+	track("high-1", PriorityHigh)
+	track("low-2", PriorityLow)
+	track("normal-2", PriorityNormal)
+	track("high-2", PriorityHigh)
+	CreateEffect(func() {
+		if s.Get() > 0 {
+			order = append(order, "default")
+		}
+	})
+
+	s.Set(1)
+
+	want := []string{"high-1", "high-2", "normal-1", "normal-2", "default", "low-1", "low-2"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestEffectPanicGoesToNearestScopeHandler(t *testing.T) {
+	boom := errors.New("boom")
+	s := CreateSignal(0)
+	var outerErrs, innerErrs []error
+
+	outer := NewCleanupScope(nil)
+	outer.OnError(func(err error) { outerErrs = append(outerErrs, err) })
+	inner := NewCleanupScope(outer)
+	inner.OnError(func(err error) { innerErrs = append(innerErrs, err) })
+	nested := NewCleanupScope(inner)
+	defer outer.Dispose()
+
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(nested)
+	CreateEffect(func() {
+		if s.Get() == 1 {
+			panic(boom)
+		}
+	})
+	SetCurrentCleanupScope(prev)
+
+	s.Set(1)
+	if len(innerErrs) != 1 || !errors.Is(innerErrs[0], boom) {
+		t.Fatalf("inner handler got %v, want the panic wrapped once", innerErrs)
+	}
+	if stack := PanicStack(innerErrs[0]); !strings.Contains(stack, "effect_test.go") {
+		t.Errorf("PanicStack = %q, want the stack of the panic", stack)
+	}
+	if len(outerErrs) != 0 {
+		t.Fatalf("outer handler got %v, want nothing", outerErrs)
+	}
+
+	// The effect keeps its dependencies and recovers with the next change
+	runs := 0
+	CreateEffect(func() {
+		_ = s.Get()
+		runs++
+	})
+	s.Set(2)
+	if runs != 2 {
+		t.Fatalf("runs after recovered panic = %d, want 2", runs)
+	}
+	if currentEffect != nil || untracking {
+		t.Fatal("a recovered panic left the tracking state behind")
+	}
+}
+
+func TestEffectPanicWithoutHandlerIsLogged(t *testing.T) {
+	s := CreateSignal(0)
+	CreateEffect(func() {
+		if s.Get() == 1 {
+			panic("no handler")
+		}
+	})
+
+	var errs []error
+	scope := NewCleanupScope(nil)
+	scope.OnError(func(err error) { errs = append(errs, err) })
+	scope.Dispose()
+
+	// Neither crashes nor reaches the handler of an unrelated scope
+	s.Set(1)
+	if len(errs) != 0 {
+		t.Fatalf("unrelated handler got %v", errs)
+	}
+}
+
+func TestEffectFirstRunPanicPropagates(t *testing.T) {
+	scope := NewCleanupScope(nil)
+	defer scope.Dispose()
+	handled := false
+	scope.OnError(func(error) { handled = true })
+
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(scope)
+	defer SetCurrentCleanupScope(prev)
+
+	func() {
+		defer func() {
+			r := recover()
+			if r == nil || !strings.Contains(r.(string), "first") {
+				t.Fatalf("recovered %v, want the first-run panic", r)
+			}
+		}()
+		CreateEffect(func() { panic("first") })
+	}()
+	if handled {
+		t.Error("a first-run panic reached the scope handler")
+	}
+	if currentEffect != nil {
+		t.Error("a first-run panic left currentEffect set")
+	}
+}
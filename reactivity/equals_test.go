@@ -0,0 +1,69 @@
+package reactivity
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCreateSignalWithEqualsSliceComparator(t *testing.T) {
+	products := CreateSignalWithEquals([]string{"a", "b"}, slices.Equal[[]string])
+
+	runs := 0
+	CreateEffect(func() {
+		_ = products.Get()
+		runs++
+	})
+
+	products.Set([]string{"a", "b"})
+	if runs != 1 {
+		t.Fatalf("equal slice should not notify: runs = %d, want 1", runs)
+	}
+	products.Set([]string{"a", "b", "c"})
+	if runs != 2 {
+		t.Fatalf("changed slice should notify: runs = %d, want 2", runs)
+	}
+}
+
+func TestCreateSignalWithEqualsPointerIdentity(t *testing.T) {
+	type item struct{ name string }
+	first := &item{"x"}
+	sig := CreateSignalWithEquals(first, func(a, b *item) bool { return a == b })
+
+	runs := 0
+	CreateEffect(func() {
+		_ = sig.Get()
+		runs++
+	})
+
+	sig.Set(first)
+	if runs != 1 {
+		t.Fatalf("same pointer should not notify: runs = %d, want 1", runs)
+	}
+	// A distinct pointer with identical contents is a change under identity,
+	// even though reflect.DeepEqual would consider it equal.
+	sig.Set(&item{"x"})
+	if runs != 2 {
+		t.Fatalf("new pointer should notify: runs = %d, want 2", runs)
+	}
+}
+
+func TestCreateMemoWithEquals(t *testing.T) {
+	n := CreateSignal(1)
+	// Only the parity of n matters to dependents
+	parity := CreateMemoWithEquals(func() int { return n.Get() }, func(a, b int) bool { return a%2 == b%2 })
+
+	runs := 0
+	CreateEffect(func() {
+		_ = parity.Get()
+		runs++
+	})
+
+	n.Set(3)
+	if runs != 1 {
+		t.Fatalf("memo value equal under comparator should not notify: runs = %d, want 1", runs)
+	}
+	n.Set(4)
+	if runs != 2 {
+		t.Fatalf("memo value change should notify: runs = %d, want 2", runs)
+	}
+}
@@ -0,0 +1,118 @@
+package reactivity
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FilteredView is the live set of elements of a Store slice that match a
+// predicate. It is meant to drive list rendering: render over Indices and
+// read each row's fields with Select(path, index, ...), so that editing a
+// field re-renders only that row, and the list itself changes only when an
+// element starts or stops matching.
+type FilteredView interface {
+	// Indices returns a signal holding the slice indices of the matching
+	// elements in ascending order.
+	Indices() ReadonlySignal[[]int]
+	// Len returns a signal holding the number of matching elements.
+	Len() ReadonlySignal[int]
+}
+
+// filteredView backs CreateFilteredView with one watcher effect per
+// element, each tracking only the values its predicate call read.
+type filteredView struct {
+	indices  Signal[[]int]
+	length   Signal[int]
+	matches  []bool
+	watchers []Effect
+}
+
+// CreateFilteredView returns the elements of the slice field path of st for
+// which predicate returns true. Every element is checked by its own effect,
+// so a write re-checks only the element it touched and Indices changes only
+// when that element's result flips. predicate may read other signals, such
+// as a search query; changing them re-checks every element. The view stops
+// updating when the current cleanup scope is disposed. It panics if path
+// does not name a slice field or T is not its element type.
+func CreateFilteredView[T any, S any](st Store[S], path string, predicate func(T) bool) FilteredView {
+	s, ok := st.(*store[S])
+	if !ok {
+		panic(fmt.Sprintf("CreateFilteredView: unsupported store type %T", st))
+	}
+	n := s.sliceNode("CreateFilteredView", []any{path})
+	elemType := reflect.TypeOf((*T)(nil)).Elem()
+	if n.typ.Elem() != elemType {
+		panic(fmt.Sprintf("CreateFilteredView: %s holds %v, not %v", path, n.typ.Elem(), elemType))
+	}
+
+	v := &filteredView{indices: CreateSignal([]int{}), length: CreateSignal(0)}
+	watch := func(i int) Effect {
+		node := n.elems[i]
+		var e Effect
+		// Watchers belong to the view rather than to whatever scope is
+		// current when the slice grows
+		runOwned(nil, func() {
+			e = CreateEffect(func() {
+				item := reflect.New(elemType).Elem()
+				buildSnapshot(node, item)
+				match := predicate(item.Interface().(T))
+				UntrackVoid(func() { v.setMatch(i, match) })
+			})
+		})
+		return e
+	}
+
+	lengthEffect := CreateEffect(func() {
+		l := n.slen.Get()
+		UntrackVoid(func() {
+			Batch(func() {
+				for len(v.watchers) > l {
+					last := len(v.watchers) - 1
+					v.watchers[last].Dispose()
+					v.setMatch(last, false)
+					v.watchers = v.watchers[:last]
+					v.matches = v.matches[:last]
+				}
+				for i := len(v.watchers); i < l; i++ {
+					v.matches = append(v.matches, false)
+					v.watchers = append(v.watchers, watch(i))
+				}
+			})
+		})
+	})
+	RegisterCleanup(func() {
+		lengthEffect.Dispose()
+		for _, w := range v.watchers {
+			w.Dispose()
+		}
+	})
+	return v
+}
+
+// setMatch records whether element i matches, updating Indices only when
+// that changes.
+func (v *filteredView) setMatch(i int, match bool) {
+	if v.matches[i] == match {
+		return
+	}
+	v.matches[i] = match
+	prev := v.indices.Peek()
+	at := sort.SearchInts(prev, i)
+	next := make([]int, 0, len(prev)+1)
+	next = append(next, prev[:at]...)
+	if match {
+		next = append(next, i)
+		next = append(next, prev[at:]...)
+	} else {
+		next = append(next, prev[at+1:]...)
+	}
+	Batch(func() {
+		v.indices.Set(next)
+		v.length.Set(len(next))
+	})
+}
+
+func (v *filteredView) Indices() ReadonlySignal[[]int] { return v.indices }
+
+func (v *filteredView) Len() ReadonlySignal[int] { return v.length }
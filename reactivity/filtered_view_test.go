@@ -0,0 +1,107 @@
+package reactivity
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testTask struct {
+	Title string
+	Done  bool
+}
+
+type testBoard struct {
+	Tasks []testTask
+}
+
+func TestFilteredViewTracksPredicateFlips(t *testing.T) {
+	store, setState := CreateStore(testBoard{Tasks: []testTask{
+		{Title: "a"}, {Title: "b", Done: true}, {Title: "c"},
+	}})
+	open := CreateFilteredView(store, "Tasks", func(task testTask) bool { return !task.Done })
+
+	listRuns := 0
+	var indices []int
+	CreateEffect(func() {
+		listRuns++
+		indices = open.Indices().Get()
+	})
+	if want := []int{0, 2}; !reflect.DeepEqual(indices, want) || open.Len().Get() != 2 {
+		t.Fatalf("indices = %v, len = %d; want %v, 2", indices, open.Len().Get(), want)
+	}
+
+	// Editing a field that does not flip the predicate leaves the list alone
+	setState("Tasks", 0, "Title", "a2")
+	if listRuns != 1 {
+		t.Fatalf("a title edit re-ran the list: runs = %d", listRuns)
+	}
+
+	setState("Tasks", 1, "Done", false)
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(indices, want) {
+		t.Fatalf("after reopening b indices = %v, want %v", indices, want)
+	}
+	setState("Tasks", 2, "Done", true)
+	if want := []int{0, 1}; !reflect.DeepEqual(indices, want) {
+		t.Fatalf("after closing c indices = %v, want %v", indices, want)
+	}
+}
+
+func TestFilteredViewFollowsSliceOperations(t *testing.T) {
+	store, _ := CreateStore(testBoard{Tasks: []testTask{{Title: "a"}, {Title: "b", Done: true}}})
+	open := CreateFilteredView(store, "Tasks", func(task testTask) bool { return !task.Done })
+
+	store.Push("Tasks", testTask{Title: "c"})
+	if got, want := open.Indices().Get(), []int{0, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("after Push indices = %v, want %v", got, want)
+	}
+	store.RemoveAt("Tasks", 0)
+	if got, want := open.Indices().Get(), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("after RemoveAt indices = %v, want %v", got, want)
+	}
+	store.Swap("Tasks", 0, 1)
+	if got, want := open.Indices().Get(), []int{0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("after Swap indices = %v, want %v", got, want)
+	}
+}
+
+func TestFilteredViewRechecksOnPredicateSignals(t *testing.T) {
+	store, _ := CreateStore(testBoard{Tasks: []testTask{{Title: "go"}, {Title: "rust"}, {Title: "gleam"}}})
+	prefix := CreateSignal("g")
+	view := CreateFilteredView(store, "Tasks", func(task testTask) bool {
+		p := prefix.Get()
+		return len(task.Title) >= len(p) && task.Title[:len(p)] == p
+	})
+	if got, want := view.Indices().Get(), []int{0, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("indices = %v, want %v", got, want)
+	}
+	prefix.Set("r")
+	if got, want := view.Indices().Get(), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("after changing the prefix indices = %v, want %v", got, want)
+	}
+}
+
+func TestFilteredViewStopsOnDispose(t *testing.T) {
+	store, setState := CreateStore(testBoard{Tasks: []testTask{{Title: "a"}}})
+	scope := NewCleanupScope(nil)
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(scope)
+	view := CreateFilteredView(store, "Tasks", func(task testTask) bool { return !task.Done })
+	SetCurrentCleanupScope(prev)
+
+	scope.Dispose()
+	setState("Tasks", 0, "Done", true)
+	store.Push("Tasks", testTask{Title: "b"})
+	if got, want := view.Indices().Get(), []int{0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("disposed view changed to %v", got)
+	}
+}
+
+func TestFilteredViewRejectsWrongElementType(t *testing.T) {
+	store, _ := CreateStore(testBoard{})
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a mismatched element type")
+		}
+	}()
+	CreateFilteredView(store, "Tasks", func(s string) bool { return true })
+}
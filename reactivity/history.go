@@ -0,0 +1,94 @@
+package reactivity
+
+// HistorySignal is a Signal that records every change made through Set so
+// it can be undone and redone.
+type HistorySignal[T any] interface {
+	Signal[T]
+	// Undo restores the value from before the most recent recorded Set. It is
+	// a no-op when there is nothing to undo.
+	Undo()
+	// Redo reapplies the most recently undone value. Any Set after an Undo
+	// discards the redo entries.
+	Redo()
+	// CanUndo reports whether Undo would change the value.
+	CanUndo() Signal[bool]
+	// CanRedo reports whether Redo would change the value.
+	CanRedo() Signal[bool]
+}
+
+// historySignal backs CreateHistorySignal. past and future hold the values
+// Undo and Redo return to, most recent last.
+type historySignal[T any] struct {
+	sig      *baseSignal[T]
+	past     []T
+	future   []T
+	maxDepth int
+	canUndo  *baseSignal[bool]
+	canRedo  *baseSignal[bool]
+}
+
+// CreateHistorySignal returns a signal holding initial that keeps up to
+// maxDepth undo entries; once full, the oldest entry is dropped. A maxDepth
+// of zero or less keeps every entry. Sets that leave the value unchanged are
+// not recorded, and the values restored by Undo and Redo are never recorded
+// as new entries.
+func CreateHistorySignal[T any](initial T, maxDepth int) HistorySignal[T] {
+	h := &historySignal[T]{
+		sig:      &baseSignal[T]{value: initial, deps: make(map[*effect]struct{})},
+		maxDepth: maxDepth,
+		canUndo:  &baseSignal[bool]{deps: make(map[*effect]struct{})},
+		canRedo:  &baseSignal[bool]{deps: make(map[*effect]struct{})},
+	}
+	trackSignal(h.sig)
+	return h
+}
+
+func (h *historySignal[T]) Get() T  { return h.sig.Get() }
+func (h *historySignal[T]) Peek() T { return h.sig.Peek() }
+
+func (h *historySignal[T]) OnChange(fn func(old, new T)) func() { return h.sig.OnChange(fn) }
+
+func (h *historySignal[T]) Set(v T) {
+	if h.sig.equal(h.sig.value, v) {
+		return
+	}
+	h.past = append(h.past, h.sig.value)
+	if h.maxDepth > 0 && len(h.past) > h.maxDepth {
+		h.past = append(h.past[:0], h.past[len(h.past)-h.maxDepth:]...)
+	}
+	h.future = nil
+	h.apply(v)
+}
+
+func (h *historySignal[T]) Undo() {
+	if len(h.past) == 0 {
+		return
+	}
+	prev := h.past[len(h.past)-1]
+	h.past = h.past[:len(h.past)-1]
+	h.future = append(h.future, h.sig.value)
+	h.apply(prev)
+}
+
+func (h *historySignal[T]) Redo() {
+	if len(h.future) == 0 {
+		return
+	}
+	next := h.future[len(h.future)-1]
+	h.future = h.future[:len(h.future)-1]
+	h.past = append(h.past, h.sig.value)
+	h.apply(next)
+}
+
+func (h *historySignal[T]) CanUndo() Signal[bool] { return h.canUndo }
+func (h *historySignal[T]) CanRedo() Signal[bool] { return h.canRedo }
+
+// apply stores v and refreshes CanUndo/CanRedo in one batch, so effects
+// never see the value and the flags out of step.
+func (h *historySignal[T]) apply(v T) {
+	Batch(func() {
+		h.sig.Set(v)
+		h.canUndo.Set(len(h.past) > 0)
+		h.canRedo.Set(len(h.future) > 0)
+	})
+}
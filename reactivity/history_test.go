@@ -0,0 +1,141 @@
+package reactivity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHistorySignalUndoRedo(t *testing.T) {
+	h := CreateHistorySignal("a", 0)
+	if h.CanUndo().Get() || h.CanRedo().Get() {
+		t.Fatal("a new history signal should have nothing to undo or redo")
+	}
+
+	h.Set("b")
+	h.Set("c")
+	h.Undo()
+	if got := h.Get(); got != "b" {
+		t.Fatalf("after undo got %q, want b", got)
+	}
+	if !h.CanUndo().Get() || !h.CanRedo().Get() {
+		t.Fatal("expected both undo and redo to be available")
+	}
+
+	h.Undo()
+	if got := h.Get(); got != "a" {
+		t.Fatalf("after second undo got %q, want a", got)
+	}
+	if h.CanUndo().Get() {
+		t.Fatal("undo should be exhausted")
+	}
+	h.Undo()
+	if got := h.Get(); got != "a" {
+		t.Fatalf("undo with an empty stack changed the value to %q", got)
+	}
+
+	h.Redo()
+	h.Redo()
+	if got := h.Get(); got != "c" {
+		t.Fatalf("after two redos got %q, want c", got)
+	}
+	if h.CanRedo().Get() {
+		t.Fatal("redo should be exhausted")
+	}
+}
+
+func TestHistorySignalSetAfterUndoClearsRedo(t *testing.T) {
+	h := CreateHistorySignal(1, 0)
+	h.Set(2)
+	h.Set(3)
+	h.Undo()
+	h.Set(4)
+	if h.CanRedo().Get() {
+		t.Fatal("a Set after Undo should discard the redo entries")
+	}
+	h.Redo()
+	if got := h.Get(); got != 4 {
+		t.Fatalf("redo with an empty stack changed the value to %d", got)
+	}
+
+	var seen []int
+	for h.CanUndo().Peek() {
+		h.Undo()
+		seen = append(seen, h.Get())
+	}
+	if want := []int{2, 1}; !reflect.DeepEqual(seen, want) {
+		t.Fatalf("undo sequence = %v, want %v", seen, want)
+	}
+
+	h.Redo()
+	h.Set(5)
+	h.Undo()
+	h.Undo()
+	if got := h.Get(); got != 1 {
+		t.Fatalf("after interleaved redo/set/undo got %d, want 1", got)
+	}
+}
+
+func TestHistorySignalUndoRedoAreNotRecorded(t *testing.T) {
+	h := CreateHistorySignal(0, 0)
+	h.Set(1)
+	for i := 0; i < 3; i++ {
+		h.Undo()
+		h.Redo()
+	}
+	h.Undo()
+	if got := h.Get(); got != 0 {
+		t.Fatalf("got %d, want 0", got)
+	}
+	if h.CanUndo().Get() {
+		t.Fatal("undo/redo round trips should not add undo entries")
+	}
+}
+
+func TestHistorySignalMaxDepthDropsOldest(t *testing.T) {
+	h := CreateHistorySignal(0, 2)
+	for i := 1; i <= 4; i++ {
+		h.Set(i)
+	}
+	h.Undo()
+	h.Undo()
+	if got := h.Get(); got != 2 {
+		t.Fatalf("got %d, want 2", got)
+	}
+	if h.CanUndo().Get() {
+		t.Fatal("entries beyond maxDepth should have been dropped")
+	}
+}
+
+func TestHistorySignalSkipsUnchangedSet(t *testing.T) {
+	h := CreateHistorySignal("a", 0)
+	h.Set("a")
+	if h.CanUndo().Get() {
+		t.Fatal("setting the current value should not be recorded")
+	}
+}
+
+func TestHistorySignalEffectsSeeConsistentFlags(t *testing.T) {
+	h := CreateHistorySignal(0, 0)
+	type snapshot struct {
+		value   int
+		canUndo bool
+		canRedo bool
+	}
+	var runs []snapshot
+	CreateEffect(func() {
+		runs = append(runs, snapshot{h.Get(), h.CanUndo().Get(), h.CanRedo().Get()})
+	})
+
+	h.Set(1)
+	h.Undo()
+	h.Redo()
+	want := []snapshot{
+		{0, false, false},
+		{1, true, false},
+		{0, false, true},
+		{1, true, false},
+	}
+	if !reflect.DeepEqual(runs, want) {
+		t.Fatalf("effect runs = %v, want %v", runs, want)
+	}
+}
@@ -0,0 +1,220 @@
+package reactivity
+
+import (
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ozanturksever/logutil"
+)
+
+// LeakSite is the live count of the signals, effects and subscriptions
+// created at one site of the program, as sampled by LeakReport.
+type LeakSite struct {
+	// Site is the file:line of the call creating them, outside this package.
+	Site    string
+	Signals int // signals and memos
+	Effects int // effects, including those memos track their dependencies with
+	// Subscriptions counts the effects currently depending on the signals
+	// created at Site.
+	Subscriptions int
+	// Reads counts the Get calls on the signals created at Site.
+	Reads int
+	// Growth is the change of Signals+Effects+Subscriptions since the
+	// previous LeakReport, or since EnableLeakDetection for the first.
+	Growth int
+}
+
+// Live returns the number of signals, effects and subscriptions of the site.
+func (s LeakSite) Live() int {
+	return s.Signals + s.Effects + s.Subscriptions
+}
+
+type leakOptions struct {
+	threshold int
+}
+
+// LeakOption configures EnableLeakDetection.
+type LeakOption func(*leakOptions)
+
+// WithLeakWarning makes LeakReport log a warning for each site whose live
+// count exceeds threshold while none of its signals was ever read, which is
+// typical of signals created again on every render.
+func WithLeakWarning(threshold int) LeakOption {
+	return func(o *leakOptions) { o.threshold = threshold }
+}
+
+// leakSite holds the counts of one creation site.
+type leakSite struct {
+	name                          string
+	signals, effects, subs, reads int
+	sampled                       int // live count at the previous report
+	warned                        bool
+}
+
+func (s *leakSite) live() int {
+	return s.signals + s.effects + s.subs
+}
+
+var (
+	// leakDetection is checked before taking the lock, so the detector costs
+	// a branch per creation until it is enabled
+	leakDetection bool
+	leakMu        sync.Mutex
+	leakSites     map[string]*leakSite
+	leakOpts      leakOptions
+	// leakSiteOverride attributes the next effect created to a site other
+	// than its caller, e.g. a memo's tracker to the memo; trackEffect
+	// clears it
+	leakSiteOverride *leakSite
+)
+
+// leakWarn logs the warning of WithLeakWarning; tests replace it.
+var leakWarn = func(site LeakSite) {
+	logutil.Logf("reactivity: possible leak at %s: %d live signals, effects and subscriptions, never read", site.Site, site.Live())
+}
+
+// EnableLeakDetection starts counting, per creation site, the signals,
+// effects and subscriptions created from now on that are still alive. It
+// is meant for development builds: every creation then records its caller.
+// Call LeakReport periodically to see which sites keep growing.
+func EnableLeakDetection(opts ...LeakOption) {
+	leakMu.Lock()
+	defer leakMu.Unlock()
+	leakOpts = leakOptions{}
+	for _, opt := range opts {
+		opt(&leakOpts)
+	}
+	leakSites = make(map[string]*leakSite)
+	leakDetection = true
+}
+
+// DisableLeakDetection stops counting and forgets the sites counted.
+func DisableLeakDetection() {
+	leakMu.Lock()
+	defer leakMu.Unlock()
+	leakDetection = false
+	leakSites = nil
+}
+
+// LeakReport samples the live counts of every site, sorted by growth since
+// the previous report, largest first. It returns nil while leak detection
+// is off. Signals and effects no longer referenced are only uncounted once
+// the garbage collector has finalized them.
+func LeakReport() []LeakSite {
+	leakMu.Lock()
+	if !leakDetection {
+		leakMu.Unlock()
+		return nil
+	}
+	report := make([]LeakSite, 0, len(leakSites))
+	var warnings []LeakSite
+	for _, s := range leakSites {
+		live := s.live()
+		site := LeakSite{
+			Site:          s.name,
+			Signals:       s.signals,
+			Effects:       s.effects,
+			Subscriptions: s.subs,
+			Reads:         s.reads,
+			Growth:        live - s.sampled,
+		}
+		s.sampled = live
+		report = append(report, site)
+		if leakOpts.threshold > 0 && live > leakOpts.threshold && s.reads == 0 && !s.warned {
+			s.warned = true
+			warnings = append(warnings, site)
+		}
+	}
+	leakMu.Unlock()
+
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Growth != report[j].Growth {
+			return report[i].Growth > report[j].Growth
+		}
+		return report[i].Site < report[j].Site
+	})
+	for _, site := range warnings {
+		leakWarn(site)
+	}
+	return report
+}
+
+// packageDir is the directory of this package's sources, whose frames are
+// skipped when looking for a creation site.
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callerSite returns the counts of the first caller outside this package,
+// or of a test file of it.
+func callerSite() *leakSite {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	name := "unknown"
+	for {
+		frame, more := frames.Next()
+		if filepath.Dir(frame.File) != packageDir || strings.HasSuffix(frame.File, "_test.go") {
+			name = filepath.Join(filepath.Base(filepath.Dir(frame.File)), filepath.Base(frame.File)) + ":" + strconv.Itoa(frame.Line)
+			break
+		}
+		if !more {
+			break
+		}
+	}
+	site := leakSites[name]
+	if site == nil {
+		site = &leakSite{name: name}
+		leakSites[name] = site
+	}
+	return site
+}
+
+// trackSignal counts s at its creation site until it is finalized.
+func trackSignal[T any](s *baseSignal[T]) {
+	if !leakDetection {
+		return
+	}
+	leakMu.Lock()
+	defer leakMu.Unlock()
+	if !leakDetection {
+		return
+	}
+	site := callerSite()
+	site.signals++
+	s.site = site
+	runtime.SetFinalizer(s, func(*baseSignal[T]) {
+		leakMu.Lock()
+		site.signals--
+		leakMu.Unlock()
+	})
+}
+
+// trackEffect counts e at its creation site until it is disposed.
+func trackEffect(e *effect) {
+	if !leakDetection {
+		return
+	}
+	leakMu.Lock()
+	defer leakMu.Unlock()
+	if !leakDetection {
+		return
+	}
+	e.site = leakSiteOverride
+	leakSiteOverride = nil
+	if e.site == nil {
+		e.site = callerSite()
+	}
+	e.site.effects++
+}
+
+// leakCount adds delta to one of a site's counts under the detector's lock.
+func leakCount(count *int, delta int) {
+	leakMu.Lock()
+	*count += delta
+	leakMu.Unlock()
+}
@@ -0,0 +1,87 @@
+package reactivity
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLeakReportIdentifiesLeakingMemoSite(t *testing.T) {
+	EnableLeakDetection()
+	defer DisableLeakDetection()
+
+	source := CreateSignal(1)
+	var leaked []Signal[int]
+	var effects []Effect
+	for i := 0; i < 50; i++ {
+		// A memo and the effect reading it, never disposed
+		memo := CreateMemo(func() int { return source.Get() * 2 })
+		leaked = append(leaked, memo)
+		effects = append(effects, CreateEffect(func() { memo.Get() }))
+	}
+
+	report := LeakReport()
+	if len(report) == 0 {
+		t.Fatal("LeakReport() is empty")
+	}
+	top := report[0]
+	if !strings.HasPrefix(top.Site, "reactivity/leak_test.go:") {
+		t.Fatalf("top site = %q, want the memo's line in leak_test.go", top.Site)
+	}
+	// Each memo: itself, its tracker and the effect subscribed to it
+	if top.Signals != 50 || top.Effects != 50 || top.Subscriptions != 50 {
+		t.Fatalf("top site = %+v, want 50 signals, effects and subscriptions", top)
+	}
+	if top.Growth != 150 {
+		t.Fatalf("top growth = %d, want 150", top.Growth)
+	}
+
+	// Disposing the readers drops their subscriptions; growth is relative
+	for _, e := range effects {
+		e.Dispose()
+	}
+	for _, site := range LeakReport() {
+		if site.Site == top.Site {
+			if site.Subscriptions != 0 || site.Growth != -50 {
+				t.Fatalf("after dispose site = %+v, want no subscriptions and growth -50", site)
+			}
+		}
+	}
+	_ = leaked
+}
+
+func TestLeakReportWarnsAboutUnreadSites(t *testing.T) {
+	EnableLeakDetection(WithLeakWarning(10))
+	defer DisableLeakDetection()
+	var warned []LeakSite
+	prev := leakWarn
+	leakWarn = func(site LeakSite) { warned = append(warned, site) }
+	defer func() { leakWarn = prev }()
+
+	var unread, read []Signal[int]
+	for i := 0; i < 20; i++ {
+		unread = append(unread, CreateSignal(i))
+	}
+	for i := 0; i < 20; i++ {
+		s := CreateSignal(i)
+		s.Get()
+		read = append(read, s)
+	}
+
+	LeakReport()
+	if len(warned) != 1 || warned[0].Signals != 20 || warned[0].Reads != 0 {
+		t.Fatalf("warnings = %+v, want one for the unread signals", warned)
+	}
+	// A site is reported once
+	LeakReport()
+	if len(warned) != 1 {
+		t.Fatalf("warnings after second report = %d, want 1", len(warned))
+	}
+	_, _ = unread, read
+}
+
+func TestLeakReportIsNilWhenDisabled(t *testing.T) {
+	CreateSignal(0)
+	if report := LeakReport(); report != nil {
+		t.Fatalf("LeakReport() = %+v, want nil while disabled", report)
+	}
+}
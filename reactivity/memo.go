@@ -1,30 +1,90 @@
 package reactivity
 
-import "reflect"
-
 // memoSignal is a lazily computed derived signal.
 type memoSignal[T any] struct {
 	base        *baseSignal[T]
 	calc        func() T
 	initialized bool
 	tracker     *effect
+	// scope is the cleanup scope current at creation; the tracker created
+	// on first read belongs to it rather than to the reader's scope
+	scope *CleanupScope
+	// lazy memos mark themselves dirty instead of recomputing while nothing
+	// observes them; refresh forces the recomputation done by Get
+	lazy    bool
+	dirty   bool
+	refresh bool
+}
+
+// MemoOptions configures CreateMemoWithOptions.
+type MemoOptions[T any] struct {
+	// Lazy defers recomputation while no effect depends on the memo: a
+	// dependency change only marks it dirty and the next Get recomputes.
+	// A memo read only in a rarely shown branch then costs nothing while
+	// the branch is hidden.
+	Lazy bool
+	// Equals decides whether a recomputed value is a change that should
+	// notify dependents; nil means reflect.DeepEqual.
+	Equals func(a, b T) bool
 }
 
 // CreateMemo creates a derived, cached signal. It defers the initial
-// computation until the memo is first read with Get().
+// computation until the memo is first read with Get(), so a memo that is
+// never read never computes; dependencies are tracked from that first read
+// on. The memo stops tracking when the cleanup scope it was created in is
+// disposed, whichever scope first read it.
 func CreateMemo[T any](fn func() T) Signal[T] {
-	return &memoSignal[T]{
-		base: &baseSignal[T]{deps: make(map[*effect]struct{})},
-		calc: fn,
+	m := &memoSignal[T]{
+		base:  &baseSignal[T]{deps: make(map[*effect]struct{})},
+		calc:  fn,
+		scope: currentCleanupScope,
+	}
+	trackSignal(m.base)
+	return m
+}
+
+// CreateMemoWithEquals is like CreateMemo but uses equals instead of
+// reflect.DeepEqual to decide whether a recomputed value is a change that
+// should notify dependents.
+func CreateMemoWithEquals[T any](fn func() T, equals func(a, b T) bool) Signal[T] {
+	m := &memoSignal[T]{
+		base:  &baseSignal[T]{deps: make(map[*effect]struct{}), equals: equals},
+		calc:  fn,
+		scope: currentCleanupScope,
+	}
+	trackSignal(m.base)
+	return m
+}
+
+// CreateMemoWithOptions is like CreateMemo but accepts options such as Lazy
+// and a custom Equals.
+func CreateMemoWithOptions[T any](fn func() T, opts MemoOptions[T]) Signal[T] {
+	m := &memoSignal[T]{
+		base:  &baseSignal[T]{deps: make(map[*effect]struct{}), equals: opts.Equals},
+		calc:  fn,
+		scope: currentCleanupScope,
+		lazy:  opts.Lazy,
 	}
+	trackSignal(m.base)
+	return m
 }
 
 func (m *memoSignal[T]) ensureTracker() {
 	if m.tracker != nil {
 		return
 	}
+	prevScope := currentCleanupScope
+	currentCleanupScope = m.scope
+	defer func() { currentCleanupScope = prevScope }()
+	// The tracker counts towards the memo's site, not the first reader's
+	leakSiteOverride = m.base.site
 	// tracker effect re-evaluates dependencies and updates value on changes
 	m.tracker = CreateEffect(func() {
+		if m.lazy && m.initialized && !m.refresh && len(m.base.deps) == 0 {
+			// Unobserved: leave the dependencies untracked until the next Get
+			m.dirty = true
+			return
+		}
 		newVal := m.calc()
 		if !m.initialized {
 			// First computation should not trigger dependents re-run immediately.
@@ -32,10 +92,15 @@ func (m *memoSignal[T]) ensureTracker() {
 			m.initialized = true
 			return
 		}
-		if !reflect.DeepEqual(m.base.value, newVal) {
+		if !m.base.equal(m.base.value, newVal) {
 			m.base.Set(newVal)
 		}
 	}).(*effect)
+	m.tracker.memo = true
+	if m.scope != nil && m.scope.disposed {
+		// The owner is gone: keep the computed value but stop tracking
+		m.tracker.Dispose()
+	}
 }
 
 func (m *memoSignal[T]) Get() T {
@@ -44,11 +109,28 @@ func (m *memoSignal[T]) Get() T {
 	if !m.initialized {
 		m.ensureTracker()
 	}
+	// Inside a batch, recompute now if a dependency changed so reads see
+	// the latest written values
+	if m.tracker.pending || m.dirty {
+		m.tracker.pending = false
+		m.dirty = false
+		m.refresh = true
+		m.tracker.run()
+		m.refresh = false
+	}
 	// Now normal dependency registration
 	return m.base.Get()
 }
 
+// Peek returns the memo's value, computing it if needed, without registering
+// the current effect as a dependent.
+func (m *memoSignal[T]) Peek() T { return Untrack(m.Get) }
+
 func (m *memoSignal[T]) Set(v T) { m.base.Set(v) }
 
+func (m *memoSignal[T]) OnChange(fn func(old, new T)) func() {
+	return onChange[T](m, m.base.equal, fn)
+}
+
 // removeEffect satisfies depNode via the embedded base behavior.
 func (m *memoSignal[T]) removeEffect(eff *effect) { m.base.removeEffect(eff) }
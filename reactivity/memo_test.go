@@ -117,3 +117,162 @@ func TestChainedMemos(t *testing.T) {
 		t.Fatalf("effect runs after base change = %d, want 2", runs)
 	}
 }
+
+func TestMemoLazyUntilFirstReadAfterDepChanges(t *testing.T) {
+	a := CreateSignal(1)
+	b := CreateSignal(2)
+	calls := 0
+	sum := CreateMemo(func() int {
+		calls++
+		return a.Get() + b.Get()
+	})
+
+	a.Set(10)
+	b.Set(20)
+	if calls != 0 {
+		t.Fatalf("calc calls before first read = %d, want 0", calls)
+	}
+	if v := sum.Get(); v != 30 || calls != 1 {
+		t.Fatalf("first read = %d after %d calls, want 30 after 1", v, calls)
+	}
+
+	a.Set(100)
+	if v := sum.Get(); v != 120 || calls != 2 {
+		t.Errorf("read after change = %d after %d calls, want 120 after 2", v, calls)
+	}
+}
+
+func TestMemoBelongsToCreationScope(t *testing.T) {
+	s := CreateSignal(1)
+	owner := NewCleanupScope(nil)
+	SetCurrentCleanupScope(owner)
+	double := CreateMemo(func() int { return s.Get() * 2 })
+	SetCurrentCleanupScope(nil)
+
+	// First read from a short-lived scope must not tie the memo to it
+	reader := NewCleanupScope(nil)
+	SetCurrentCleanupScope(reader)
+	if v := double.Get(); v != 2 {
+		t.Fatalf("first read = %d, want 2", v)
+	}
+	SetCurrentCleanupScope(nil)
+	reader.Dispose()
+
+	s.Set(2)
+	if v := double.Get(); v != 4 {
+		t.Errorf("memo stopped tracking when its reader's scope was disposed: got %d, want 4", v)
+	}
+
+	owner.Dispose()
+	s.Set(3)
+	if v := double.Get(); v != 4 {
+		t.Errorf("memo kept tracking after its own scope was disposed: got %d, want 4", v)
+	}
+}
+
+func TestLazyMemoInHiddenBranch(t *testing.T) {
+	visible := CreateSignal(false)
+	source := CreateSignal(1)
+	computes := 0
+	expensive := CreateMemoWithOptions(func() int {
+		computes++
+		return source.Get() * 10
+	}, MemoOptions[int]{Lazy: true})
+
+	// A Show-like branch that only reads the memo while visible
+	var shown int
+	eff := CreateEffect(func() {
+		if visible.Get() {
+			shown = expensive.Get()
+		}
+	})
+	defer eff.Dispose()
+
+	source.Set(2)
+	if computes != 0 {
+		t.Fatalf("memo computed %d times while its branch was hidden", computes)
+	}
+
+	visible.Set(true)
+	if computes != 1 || shown != 20 {
+		t.Fatalf("after reveal computes = %d, shown = %d; want 1, 20", computes, shown)
+	}
+	source.Set(3)
+	if computes != 2 || shown != 30 {
+		t.Fatalf("observed memo should recompute eagerly: computes = %d, shown = %d", computes, shown)
+	}
+
+	visible.Set(false)
+	source.Set(4)
+	source.Set(5)
+	if computes != 2 {
+		t.Errorf("hidden lazy memo recomputed: computes = %d, want 2", computes)
+	}
+	visible.Set(true)
+	if computes != 3 || shown != 50 {
+		t.Errorf("after second reveal computes = %d, shown = %d; want 3, 50", computes, shown)
+	}
+}
+
+func TestEagerMemoRecomputesWhileUnobserved(t *testing.T) {
+	source := CreateSignal(1)
+	computes := 0
+	memo := CreateMemoWithOptions(func() int {
+		computes++
+		return source.Get()
+	}, MemoOptions[int]{})
+	memo.Get()
+	source.Set(2)
+	source.Set(3)
+	if computes != 3 {
+		t.Errorf("computes = %d, want 3", computes)
+	}
+}
+
+func TestLazyMemoGetAfterDirtyInBatch(t *testing.T) {
+	source := CreateSignal(1)
+	computes := 0
+	memo := CreateMemoWithOptions(func() int {
+		computes++
+		return source.Get() + 1
+	}, MemoOptions[int]{Lazy: true})
+	if memo.Get() != 2 {
+		t.Fatal("initial value")
+	}
+
+	Batch(func() {
+		source.Set(5)
+		if got := memo.Get(); got != 6 {
+			t.Errorf("read inside batch = %d, want 6", got)
+		}
+	})
+	source.Set(7)
+	if got := memo.Peek(); got != 8 {
+		t.Errorf("Peek after dirty = %d, want 8", got)
+	}
+	if computes != 3 {
+		t.Errorf("computes = %d, want 3", computes)
+	}
+}
+
+func TestMemoOptionsEquals(t *testing.T) {
+	source := CreateSignal(1)
+	parity := CreateMemoWithOptions(func() int { return source.Get() }, MemoOptions[int]{
+		Equals: func(a, b int) bool { return a%2 == b%2 },
+	})
+	runs := 0
+	eff := CreateEffect(func() {
+		parity.Get()
+		runs++
+	})
+	defer eff.Dispose()
+
+	source.Set(3)
+	if runs != 1 {
+		t.Errorf("same parity should not notify: runs = %d", runs)
+	}
+	source.Set(4)
+	if runs != 2 {
+		t.Errorf("parity change should notify: runs = %d", runs)
+	}
+}
@@ -0,0 +1,65 @@
+package reactivity
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AnySignal is a signal of any value type, as listed in the deps of On.
+// Every Signal and ReadonlySignal, including memos, is one.
+type AnySignal interface{}
+
+type onOptions struct {
+	deferred bool
+}
+
+// OnOption configures On.
+type OnOption func(*onOptions)
+
+// Defer makes On skip fn on the initial run; fn first runs when one of the
+// deps changes.
+func Defer() OnOption {
+	return func(o *onOptions) { o.deferred = true }
+}
+
+// On creates an effect that depends on deps only: it re-runs when one of
+// them changes, and fn runs untracked, so the signals fn reads are used at
+// their current value without subscribing the effect. With no deps fn runs
+// once. It panics if a dep has no Get method returning a value.
+func On(deps []AnySignal, fn func(), opts ...OnOption) Effect {
+	var o onOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	reads := make([]func(), len(deps))
+	for i, dep := range deps {
+		reads[i] = signalReader(dep)
+	}
+
+	first := true
+	return CreateEffect(func() {
+		for _, read := range reads {
+			read()
+		}
+		if first {
+			first = false
+			if o.deferred {
+				return
+			}
+		}
+		UntrackVoid(fn)
+	})
+}
+
+// signalReader returns a function calling dep's Get method.
+func signalReader(dep AnySignal) func() {
+	v := reflect.ValueOf(dep)
+	if !v.IsValid() {
+		panic("On: nil dependency")
+	}
+	get := v.MethodByName("Get")
+	if !get.IsValid() || get.Type().NumIn() != 0 || get.Type().NumOut() != 1 {
+		panic(fmt.Sprintf("On: %T is not a signal", dep))
+	}
+	return func() { get.Call(nil) }
+}
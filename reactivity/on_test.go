@@ -0,0 +1,70 @@
+package reactivity
+
+import "testing"
+
+func TestOnTracksOnlyDeps(t *testing.T) {
+	a := CreateSignal(1)
+	b := CreateSignal(10)
+
+	var seen []int
+	e := On([]AnySignal{a}, func() {
+		seen = append(seen, a.Get()+b.Get())
+	})
+	defer e.Dispose()
+
+	if len(seen) != 1 || seen[0] != 11 {
+		t.Fatalf("initial runs = %v, want [11]", seen)
+	}
+
+	// b is read by fn but is not a dependency
+	b.Set(20)
+	if len(seen) != 1 {
+		t.Fatalf("runs after untracked change = %v, want one run", seen)
+	}
+
+	a.Set(2)
+	if len(seen) != 2 || seen[1] != 22 {
+		t.Fatalf("runs after dep change = %v, want [11 22]", seen)
+	}
+}
+
+func TestOnDefer(t *testing.T) {
+	a := CreateSignal("x")
+	doubled := CreateMemo(func() string { return a.Get() + a.Get() })
+
+	runs := 0
+	e := On([]AnySignal{ReadOnly(a), doubled}, func() { runs++ }, Defer())
+	defer e.Dispose()
+
+	if runs != 0 {
+		t.Fatalf("deferred On ran %d times initially, want 0", runs)
+	}
+	a.Set("y")
+	if runs == 0 {
+		t.Fatal("deferred On did not run after a dep changed")
+	}
+}
+
+func TestOnWithoutDepsRunsOnce(t *testing.T) {
+	s := CreateSignal(0)
+	runs := 0
+	e := On(nil, func() {
+		runs++
+		s.Set(s.Get() + 1)
+	})
+	defer e.Dispose()
+
+	s.Set(5)
+	if runs != 1 {
+		t.Fatalf("runs = %d, want 1", runs)
+	}
+}
+
+func TestOnPanicsOnNonSignal(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("On accepted a dependency without Get")
+		}
+	}()
+	On([]AnySignal{42}, func() {})
+}
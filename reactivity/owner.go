@@ -0,0 +1,54 @@
+package reactivity
+
+// Owner is a cleanup scope captured with GetOwner so that reactive work
+// started later, e.g. from a goroutine or a callback, can be attached to it
+// with RunWithOwner. The zero Owner owns nothing.
+type Owner struct {
+	scope *CleanupScope
+}
+
+// GetOwner returns the owner of the code running now: the current cleanup
+// scope.
+func GetOwner() Owner {
+	return Owner{scope: currentCleanupScope}
+}
+
+// Scope returns the cleanup scope behind the owner, or nil.
+func (o Owner) Scope() *CleanupScope {
+	return o.scope
+}
+
+// CreateRoot runs fn in a new cleanup scope without a parent, so effects and
+// cleanups created in it outlive the component that created the root, e.g.
+// signals and effects of a service layer. They are disposed only when the
+// dispose function passed to fn is called. fn runs outside the current
+// effect, so its reads do not subscribe it.
+func CreateRoot(fn func(dispose func())) {
+	scope := NewCleanupScope(nil)
+	runOwned(scope, func() { fn(scope.Dispose) })
+}
+
+// RunWithOwner runs fn with owner's scope as the current cleanup scope, so
+// effects created and cleanups registered in fn are disposed with it. Code
+// running after the owner was captured, such as a fetch goroutine, would
+// otherwise attach them to nothing. When the owner has been disposed
+// already, whatever fn creates is disposed as soon as fn returns. Like
+// CreateRoot, fn runs outside the current effect.
+func RunWithOwner(owner Owner, fn func()) {
+	scope := owner.scope
+	if scope != nil && scope.disposed {
+		scope = NewCleanupScope(nil)
+		defer scope.Dispose()
+	}
+	runOwned(scope, fn)
+}
+
+// runOwned runs fn with scope current and no current effect.
+func runOwned(scope *CleanupScope, fn func()) {
+	prevScope, prevEffect := currentCleanupScope, currentEffect
+	currentCleanupScope, currentEffect = scope, nil
+	defer func() {
+		currentCleanupScope, currentEffect = prevScope, prevEffect
+	}()
+	fn()
+}
@@ -0,0 +1,131 @@
+package reactivity
+
+import "testing"
+
+func TestCreateRootDisposesItsEffects(t *testing.T) {
+	count := CreateSignal(0)
+	runs := 0
+	var dispose func()
+	CreateRoot(func(d func()) {
+		dispose = d
+		CreateEffect(func() {
+			count.Get()
+			runs++
+		})
+	})
+
+	count.Set(1)
+	if runs != 2 {
+		t.Fatalf("runs = %d, want 2", runs)
+	}
+	dispose()
+	count.Set(2)
+	if runs != 2 {
+		t.Errorf("effect ran after its root was disposed: runs = %d", runs)
+	}
+}
+
+func TestCreateRootOutlivesCurrentScope(t *testing.T) {
+	count := CreateSignal(0)
+	runs := 0
+	var disposeRoot func()
+
+	component := NewCleanupScope(nil)
+	SetCurrentCleanupScope(component)
+	CreateRoot(func(dispose func()) {
+		disposeRoot = dispose
+		CreateEffect(func() {
+			count.Get()
+			runs++
+		})
+	})
+	SetCurrentCleanupScope(nil)
+	component.Dispose()
+
+	count.Set(1)
+	if runs != 2 {
+		t.Errorf("root effect was disposed with the surrounding scope: runs = %d", runs)
+	}
+	disposeRoot()
+}
+
+func TestCreateRootRunsOutsideCurrentEffect(t *testing.T) {
+	trigger := CreateSignal(0)
+	outerRuns := 0
+	eff := CreateEffect(func() {
+		outerRuns++
+		CreateRoot(func(dispose func()) {
+			trigger.Get()
+			dispose()
+		})
+	})
+	defer eff.Dispose()
+
+	trigger.Set(1)
+	if outerRuns != 1 {
+		t.Errorf("reads inside CreateRoot subscribed the outer effect: runs = %d", outerRuns)
+	}
+}
+
+func TestRunWithOwnerAttachesToCapturedRoot(t *testing.T) {
+	count := CreateSignal(0)
+	runs := 0
+	cleanups := 0
+	var owner Owner
+	var dispose func()
+	CreateRoot(func(d func()) {
+		dispose = d
+		owner = GetOwner()
+	})
+
+	// Later, e.g. when a fetch goroutine completes, with no scope current
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		RunWithOwner(owner, func() {
+			CreateEffect(func() {
+				count.Get()
+				runs++
+			})
+			RegisterCleanup(func() { cleanups++ })
+		})
+	}()
+	<-done
+	if GetCurrentCleanupScope() != nil {
+		t.Fatal("RunWithOwner should restore the previous scope")
+	}
+
+	count.Set(1)
+	if runs != 2 {
+		t.Fatalf("runs = %d, want 2", runs)
+	}
+	dispose()
+	count.Set(2)
+	if runs != 2 {
+		t.Errorf("effect created in RunWithOwner outlived its owner: runs = %d", runs)
+	}
+	if cleanups != 1 {
+		t.Errorf("cleanups = %d, want 1", cleanups)
+	}
+}
+
+func TestRunWithOwnerAfterDispose(t *testing.T) {
+	count := CreateSignal(0)
+	runs := 0
+	var owner Owner
+	CreateRoot(func(dispose func()) {
+		owner = GetOwner()
+		dispose()
+	})
+
+	RunWithOwner(owner, func() {
+		CreateEffect(func() {
+			count.Get()
+			runs++
+		})
+	})
+	count.Set(1)
+	if runs != 1 {
+		t.Errorf("effect created for a disposed owner kept running: runs = %d", runs)
+	}
+}
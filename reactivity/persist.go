@@ -0,0 +1,99 @@
+package reactivity
+
+import (
+	"encoding/json"
+
+	"github.com/ozanturksever/logutil"
+)
+
+// Codec converts persisted values to and from their stored form.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// jsonCodec is the default Codec.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// persistOptions controls where and how CreatePersistedSignal stores values.
+type persistOptions struct {
+	session bool
+	codec   Codec
+}
+
+// PersistOption configures CreatePersistedSignal.
+type PersistOption func(*persistOptions)
+
+// PersistInSession stores the value in sessionStorage instead of
+// localStorage, so it survives reloads but not closing the tab.
+func PersistInSession() PersistOption {
+	return func(o *persistOptions) {
+		o.session = true
+	}
+}
+
+// PersistWithCodec stores values encoded by codec instead of JSON.
+func PersistWithCodec(codec Codec) PersistOption {
+	return func(o *persistOptions) {
+		o.codec = codec
+	}
+}
+
+// persistedSignal writes every Set through to storage.
+type persistedSignal[T any] struct {
+	Signal[T]
+	key  string
+	opts persistOptions
+}
+
+// CreatePersistedSignal returns a signal whose value survives reloads. It
+// starts from the value stored under key in localStorage, or initial when
+// the key is missing or cannot be decoded, and every Set writes the encoded
+// value back. Changes made to the key by other tabs update the signal, and
+// removing the key there resets it to initial; the listener is removed when
+// the current cleanup scope is disposed. Outside the browser values are kept
+// in memory for the lifetime of the process.
+func CreatePersistedSignal[T any](key string, initial T, opts ...PersistOption) Signal[T] {
+	o := persistOptions{codec: jsonCodec{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	s := &persistedSignal[T]{Signal: CreateSignal(initial), key: key, opts: o}
+	if raw, ok := persistRead(o.session, key); ok {
+		if v, err := s.decode(raw); err == nil {
+			s.Signal.Set(v)
+		} else {
+			logutil.Logf("reactivity: ignoring stored value of %q: %v", key, err)
+		}
+	}
+
+	stop := persistWatch(o.session, key, func(raw string, ok bool) {
+		if !ok {
+			s.Signal.Set(initial)
+			return
+		}
+		if v, err := s.decode(raw); err == nil {
+			s.Signal.Set(v)
+		}
+	})
+	RegisterCleanup(stop)
+	return s
+}
+
+func (s *persistedSignal[T]) Set(v T) {
+	if data, err := s.opts.codec.Marshal(v); err != nil {
+		logutil.Logf("reactivity: not persisting %q: %v", s.key, err)
+	} else {
+		persistWrite(s.opts.session, s.key, string(data))
+	}
+	s.Signal.Set(v)
+}
+
+func (s *persistedSignal[T]) decode(raw string) (T, error) {
+	var v T
+	err := s.opts.codec.Unmarshal([]byte(raw), &v)
+	return v, err
+}
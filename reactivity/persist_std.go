@@ -0,0 +1,38 @@
+//go:build !js && !wasm
+
+package reactivity
+
+import "sync"
+
+// persistStore stands in for Web Storage outside the browser.
+var persistStore = struct {
+	sync.Mutex
+	local, session map[string]string
+}{local: map[string]string{}, session: map[string]string{}}
+
+func persistArea(session bool) map[string]string {
+	if session {
+		return persistStore.session
+	}
+	return persistStore.local
+}
+
+// persistRead returns the value stored under key and whether it exists.
+func persistRead(session bool, key string) (string, bool) {
+	persistStore.Lock()
+	defer persistStore.Unlock()
+	value, ok := persistArea(session)[key]
+	return value, ok
+}
+
+// persistWrite stores value under key.
+func persistWrite(session bool, key, value string) {
+	persistStore.Lock()
+	defer persistStore.Unlock()
+	persistArea(session)[key] = value
+}
+
+// persistWatch does nothing outside the browser: there are no other tabs.
+func persistWatch(session bool, key string, fn func(value string, ok bool)) func() {
+	return func() {}
+}
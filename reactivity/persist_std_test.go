@@ -0,0 +1,74 @@
+//go:build !js && !wasm
+
+package reactivity
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type prefs struct {
+	Theme         string
+	ShowCompleted bool
+}
+
+func TestPersistedSignalHydratesAndWritesBack(t *testing.T) {
+	s := CreatePersistedSignal("test-prefs", prefs{Theme: "light"})
+	if got := s.Get(); got.Theme != "light" {
+		t.Fatalf("missing key should start from initial, got %+v", got)
+	}
+	s.Set(prefs{Theme: "dark", ShowCompleted: true})
+	if raw, _ := persistRead(false, "test-prefs"); raw != `{"Theme":"dark","ShowCompleted":true}` {
+		t.Fatalf("stored %q", raw)
+	}
+
+	reloaded := CreatePersistedSignal("test-prefs", prefs{Theme: "light"})
+	if got := reloaded.Get(); got != (prefs{Theme: "dark", ShowCompleted: true}) {
+		t.Errorf("hydrated %+v", got)
+	}
+}
+
+func TestPersistedSignalFallsBackOnBadData(t *testing.T) {
+	persistWrite(false, "test-count", "not json")
+	s := CreatePersistedSignal("test-count", 7)
+	if got := s.Get(); got != 7 {
+		t.Errorf("undecodable value should fall back to initial, got %d", got)
+	}
+}
+
+func TestPersistedSignalSessionArea(t *testing.T) {
+	s := CreatePersistedSignal("test-tab", "a", PersistInSession())
+	s.Set("b")
+	if _, ok := persistRead(false, "test-tab"); ok {
+		t.Error("session value leaked into local storage")
+	}
+	if raw, _ := persistRead(true, "test-tab"); raw != `"b"` {
+		t.Errorf("session storage holds %q", raw)
+	}
+}
+
+// intCodec stores ints as plain decimal strings.
+type intCodec struct{}
+
+func (intCodec) Marshal(v any) ([]byte, error) { return []byte(strconv.Itoa(v.(int))), nil }
+func (intCodec) Unmarshal(data []byte, v any) error {
+	n, err := strconv.Atoi(string(data))
+	if err != nil {
+		return errors.New("not a number")
+	}
+	*v.(*int) = n
+	return nil
+}
+
+func TestPersistedSignalCustomCodec(t *testing.T) {
+	persistWrite(false, "test-codec", "41")
+	s := CreatePersistedSignal("test-codec", 0, PersistWithCodec(intCodec{}))
+	if got := s.Get(); got != 41 {
+		t.Fatalf("hydrated %d, want 41", got)
+	}
+	s.Set(42)
+	if raw, _ := persistRead(false, "test-codec"); raw != "42" {
+		t.Errorf("stored %q, want 42", raw)
+	}
+}
@@ -0,0 +1,81 @@
+//go:build js && wasm
+
+package reactivity
+
+import "syscall/js"
+
+// webStorage returns localStorage or sessionStorage, or undefined when
+// storage is disabled (accessing it throws in some privacy modes).
+func webStorage(session bool) (storage js.Value) {
+	defer func() {
+		if r := recover(); r != nil {
+			storage = js.Undefined()
+		}
+	}()
+	if session {
+		return js.Global().Get("sessionStorage")
+	}
+	return js.Global().Get("localStorage")
+}
+
+// persistRead returns the value stored under key and whether it exists.
+func persistRead(session bool, key string) (string, bool) {
+	storage := webStorage(session)
+	if !storage.Truthy() {
+		return "", false
+	}
+	raw := storage.Call("getItem", key)
+	if raw.Type() != js.TypeString {
+		return "", false
+	}
+	return raw.String(), true
+}
+
+// persistWrite stores value under key. Quota errors are ignored: the value
+// then only lives in memory.
+func persistWrite(session bool, key, value string) {
+	storage := webStorage(session)
+	if !storage.Truthy() {
+		return
+	}
+	defer func() { recover() }()
+	storage.Call("setItem", key, value)
+}
+
+// persistWatch calls fn whenever another tab changes key, with ok false when
+// the key was removed. The returned function stops watching.
+func persistWatch(session bool, key string, fn func(value string, ok bool)) func() {
+	storage := webStorage(session)
+	if !storage.Truthy() {
+		return func() {}
+	}
+	onStorage := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return nil
+		}
+		evt := args[0]
+		if area := evt.Get("storageArea"); area.Truthy() && !area.Equal(storage) {
+			return nil
+		}
+		k := evt.Get("key")
+		// A null key means the other tab called clear()
+		if k.Type() != js.TypeString {
+			fn("", false)
+			return nil
+		}
+		if k.String() != key {
+			return nil
+		}
+		if raw := evt.Get("newValue"); raw.Type() == js.TypeString {
+			fn(raw.String(), true)
+		} else {
+			fn("", false)
+		}
+		return nil
+	})
+	js.Global().Call("addEventListener", "storage", onStorage)
+	return func() {
+		js.Global().Call("removeEventListener", "storage", onStorage)
+		onStorage.Release()
+	}
+}
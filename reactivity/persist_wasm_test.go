@@ -0,0 +1,64 @@
+//go:build js && wasm
+
+package reactivity
+
+import (
+	"syscall/js"
+	"testing"
+)
+
+// dispatchStorageEvent simulates a write to localStorage made by another tab.
+func dispatchStorageEvent(key string, newValue any) {
+	js.Global().Call("dispatchEvent", js.Global().Get("StorageEvent").New("storage", map[string]any{
+		"key":         key,
+		"newValue":    newValue,
+		"storageArea": js.Global().Get("localStorage"),
+	}))
+}
+
+func TestPersistedSignalLocalStorage(t *testing.T) {
+	if !js.Global().Get("localStorage").Truthy() {
+		t.Skip("Skipping browser-specific test")
+	}
+	storage := js.Global().Get("localStorage")
+	storage.Call("setItem", "uiwgo-test-persisted", `{"n":1}`)
+	defer storage.Call("removeItem", "uiwgo-test-persisted")
+
+	type counter struct {
+		N int `json:"n"`
+	}
+	scope := NewCleanupScope(nil)
+	defer scope.Dispose()
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(scope)
+	s := CreatePersistedSignal("uiwgo-test-persisted", counter{})
+	SetCurrentCleanupScope(prev)
+
+	if got := s.Get().N; got != 1 {
+		t.Fatalf("hydrated %d, want 1", got)
+	}
+	s.Set(counter{N: 2})
+	if got := storage.Call("getItem", "uiwgo-test-persisted").String(); got != `{"n":2}` {
+		t.Errorf("stored %q", got)
+	}
+
+	// Another tab writes the key, then removes it
+	dispatchStorageEvent("uiwgo-test-persisted", `{"n":5}`)
+	if got := s.Get().N; got != 5 {
+		t.Errorf("after storage event got %d, want 5", got)
+	}
+	dispatchStorageEvent("uiwgo-test-persisted", "garbage")
+	if got := s.Get().N; got != 5 {
+		t.Errorf("undecodable storage event changed the value to %d", got)
+	}
+	dispatchStorageEvent("uiwgo-test-persisted", nil)
+	if got := s.Get().N; got != 0 {
+		t.Errorf("removing the key should reset to initial, got %d", got)
+	}
+
+	scope.Dispose()
+	dispatchStorageEvent("uiwgo-test-persisted", `{"n":9}`)
+	if got := s.Get().N; got != 0 {
+		t.Errorf("storage events should be ignored after dispose, got %d", got)
+	}
+}
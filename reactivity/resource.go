@@ -1,5 +1,10 @@
 package reactivity
 
+import (
+	"context"
+	"time"
+)
+
 // Resource provides reactive access to an asynchronously loaded value.
 // It exposes three reactive getters backed by Signals:
 //   - Data(): the loaded value (zero value until first success)
@@ -7,8 +12,10 @@ package reactivity
 //   - Error(): last error, or nil
 //
 // Notes:
-// - This implementation is designed for JS/WASM single-threaded runtime.
-// - Concurrent (stale) requests are ignored using a monotonically increasing token.
+// - Fetchers run on goroutines; their results are applied with the next
+//   flush (see FlushSync), on the goroutine running the reactive graph.
+// - Concurrent (stale) requests are ignored using a monotonically increasing token
+//   and their contexts are cancelled (see CreateResourceWithContext).
 // - On error, the previous Data value is preserved.
 //
 // Inspired by SolidJS's createResource.
@@ -17,6 +24,76 @@ type Resource[T any] interface {
 	Data() T
 	Loading() bool
 	Error() error
+	// Invalidate drops the data cached for the source value key, e.g.
+	// after a mutation changed it. When key is the current source value
+	// the data is fetched again. It does nothing for CreateAsyncMemo.
+	Invalidate(key any)
+}
+
+// resourceOptions controls how CreateResource caches fetched data.
+type resourceOptions struct {
+	cacheSize  int
+	cacheTTL   time.Duration
+	revalidate bool
+}
+
+// ResourceOption configures CreateResource.
+type ResourceOption func(*resourceOptions)
+
+// WithResourceCache keeps the data fetched for the last size source values.
+// When the source changes back to a cached value its data is served right
+// away, without a fetch and with Loading staying false. Entries older than
+// ttl are fetched again; a zero ttl keeps them until they are the least
+// recently used one of a full cache. The source value must be comparable.
+func WithResourceCache(size int, ttl time.Duration) ResourceOption {
+	return func(o *resourceOptions) {
+		o.cacheSize, o.cacheTTL = size, ttl
+	}
+}
+
+// WithRevalidation makes a cached resource fetch cached data again in the
+// background after serving it (stale-while-revalidate). Loading stays false
+// and Data is updated when the fetch succeeds.
+func WithRevalidation() ResourceOption {
+	return func(o *resourceOptions) {
+		o.revalidate = true
+	}
+}
+
+// AnyResource is the part of a Resource that does not depend on its data
+// type, so resources of different types can be handled together.
+type AnyResource interface {
+	Loading() bool
+	Error() error
+}
+
+// resourceReads collects the resources read while CollectResources runs.
+var resourceReads *[]AnyResource
+
+// CollectResources runs fn and returns the resources created by this
+// package whose getters it called, each once, e.g. to wait for the data a
+// render function uses. A nested CollectResources keeps the reads of its
+// own fn.
+func CollectResources(fn func()) []AnyResource {
+	outer := resourceReads
+	var reads []AnyResource
+	resourceReads = &reads
+	defer func() { resourceReads = outer }()
+	fn()
+	return reads
+}
+
+// noteResourceRead records a read of r for CollectResources.
+func noteResourceRead(r AnyResource) {
+	if resourceReads == nil {
+		return
+	}
+	for _, read := range *resourceReads {
+		if read == r {
+			return
+		}
+	}
+	*resourceReads = append(*resourceReads, r)
 }
 
 type resourceImpl[T any] struct {
@@ -26,43 +103,175 @@ type resourceImpl[T any] struct {
 
 	// latestReq increments on each (re)fetch; completions check against it
 	latestReq int
+
+	// Set by CreateResource: the cache, the source value being shown and
+	// a signal the fetching effect reads to fetch again
+	cache      *resourceCache[T]
+	key        any
+	invalidate Signal[int]
 }
 
-func (r *resourceImpl[T]) Data() T       { return r.data.Get() }
-func (r *resourceImpl[T]) Loading() bool { return r.loading.Get() }
-func (r *resourceImpl[T]) Error() error  { return r.err.Get() }
+func (r *resourceImpl[T]) Data() T {
+	noteResourceRead(r)
+	return r.data.Get()
+}
+
+func (r *resourceImpl[T]) Loading() bool {
+	noteResourceRead(r)
+	return r.loading.Get()
+}
+
+func (r *resourceImpl[T]) Error() error {
+	noteResourceRead(r)
+	return r.err.Get()
+}
+
+func (r *resourceImpl[T]) Invalidate(key any) {
+	if r.invalidate == nil {
+		return
+	}
+	if r.cache != nil {
+		r.cache.remove(key)
+	}
+	if cacheable(key) && cacheable(r.key) && key == r.key {
+		r.invalidate.Set(r.invalidate.Peek() + 1)
+	}
+}
 
 // CreateResource wires an asynchronous fetcher to a source signal.
 // Whenever the source value changes, the fetcher is invoked in a goroutine
-// and the resulting Data/Loading/Error signals are updated upon completion.
+// and the resulting Data/Loading/Error signals are updated with the first
+// flush after its completion: in the browser in a microtask, elsewhere
+// when FlushSync is called.
 //
 // Behavior:
 // - Sets Loading(true) and clears Error before invoking fetcher.
 // - Runs fetcher on a goroutine to avoid blocking the UI.
 // - Only the latest request may update the signals; stale completions are ignored.
 // - On error, Data remains as last successful value.
-func CreateResource[S any, T any](source Signal[S], fetcher func(S) (T, error)) Resource[T] {
-	r := &resourceImpl[T]{
-		data:    CreateSignal(*new(T)), // zero T
-		loading: CreateSignal(false),
-		err:     CreateSignal(error(nil)),
+//
+// Options such as WithResourceCache control caching of the fetched data.
+func CreateResource[S any, T any](source Signal[S], fetcher func(S) (T, error), opts ...ResourceOption) Resource[T] {
+	return CreateResourceWithContext(source, func(_ context.Context, s S) (T, error) {
+		return fetcher(s)
+	}, opts...)
+}
+
+// CreateResourceWithContext is like CreateResource but passes the fetcher a
+// context that is cancelled as soon as a newer fetch starts or the resource
+// effect is disposed. Fetchers can use it to abort slow requests; whatever
+// a superseded fetch returns is dropped, so a slow old response never
+// overwrites the data of a newer one.
+func CreateResourceWithContext[S any, T any](source Signal[S], fetcher func(ctx context.Context, s S) (T, error), opts ...ResourceOption) Resource[T] {
+	var o resourceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	r := newResource[T]()
+	r.invalidate = CreateSignal(0)
+	if o.cacheSize > 0 {
+		r.cache = newResourceCache[T](o.cacheSize, o.cacheTTL)
 	}
 
 	// Track source changes and trigger fetches
 	CreateEffect(func() {
 		s := source.Get() // track dependency
+		r.invalidate.Get()
+		r.key = s
+		fetch := func(ctx context.Context) (T, error) {
+			data, err := fetcher(ctx, s)
+			if err == nil && ctx.Err() == nil && r.cache != nil {
+				r.cache.put(s, data)
+			}
+			return data, err
+		}
+		if r.cache != nil {
+			if data, ok := r.cache.get(s); ok {
+				r.serve(data)
+				if o.revalidate {
+					r.run(fetch, false)
+				}
+				return
+			}
+		}
+		r.start(fetch)
+	})
+
+	return r
+}
+
+// CreateAsyncMemo derives a value on a goroutine from any number of
+// signals. fn runs synchronously inside an effect, so every signal it reads
+// is tracked; it returns the (possibly slow) computation, which runs on a
+// goroutine. Whenever a tracked signal changes fn runs again and the new
+// computation supersedes the old one: the latest computation wins and the
+// results of stale ones are discarded. The result is exposed through the
+// Resource getters; on error Data keeps its last successful value.
+//
+//	digest := reactivity.CreateAsyncMemo(func() func() (string, error) {
+//		data := content.Get() // tracked
+//		return func() (string, error) { return hash(data), nil }
+//	})
+func CreateAsyncMemo[T any](fn func() func() (T, error)) Resource[T] {
+	r := newResource[T]()
+
+	CreateEffect(func() {
+		compute := fn() // tracks the signals read before the goroutine starts
+		r.start(func(context.Context) (T, error) {
+			return compute()
+		})
+	})
 
-		// Prepare for a new request
-		r.latestReq++
-		reqID := r.latestReq
+	return r
+}
+
+func newResource[T any]() *resourceImpl[T] {
+	return &resourceImpl[T]{
+		data:    CreateSignal(*new(T)), // zero T
+		loading: CreateSignal(false),
+		err:     CreateSignal(error(nil)),
+	}
+}
+
+// start begins a new request from inside the owning effect and runs it on
+// a goroutine. The request's context is cancelled when the effect re-runs
+// or is disposed, and its result is applied only if no newer request has
+// started by then.
+func (r *resourceImpl[T]) start(run func(ctx context.Context) (T, error)) {
+	r.run(run, true)
+}
+
+// serve shows cached data, dropping the result of any request in flight.
+func (r *resourceImpl[T]) serve(data T) {
+	r.latestReq++
+	r.data.Set(data)
+	r.err.Set(nil)
+	r.loading.Set(false)
+}
+
+// run is start, with loading telling whether Loading is set while the
+// request runs; background revalidations leave it false.
+func (r *resourceImpl[T]) run(run func(ctx context.Context) (T, error), loading bool) {
+	// Prepare for a new request
+	ctx, cancel := context.WithCancel(context.Background())
+	r.latestReq++
+	reqID := r.latestReq
+	// Runs before the next request starts and when the effect is disposed
+	OnCleanup(cancel)
+	if loading {
 		r.loading.Set(true)
 		r.err.Set(nil)
+	}
 
-		// Fire the fetch in a goroutine; ignore results if stale
-		go func(val S, id int) {
-			data, e := fetcher(val)
-			// Only apply if this is the latest request
-			if id != r.latestReq {
+	// Fire the request in a goroutine and hand its result back to the
+	// graph's goroutine; ignore results if stale
+	go func(id int) {
+		data, e := run(ctx)
+		post(func() {
+			// Only apply if this is the latest request and the effect is live
+			stale := id != r.latestReq || ctx.Err() != nil
+			cancel()
+			if stale {
 				return
 			}
 			if e != nil {
@@ -71,8 +280,6 @@ func CreateResource[S any, T any](source Signal[S], fetcher func(S) (T, error))
 				r.data.Set(data)
 			}
 			r.loading.Set(false)
-		}(s, reqID)
-	})
-
-	return r
+		})
+	}(reqID)
 }
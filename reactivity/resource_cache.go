@@ -0,0 +1,95 @@
+package reactivity
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// resourceCache keeps the latest data fetched for the most recently used
+// source values of a resource.
+type resourceCache[T any] struct {
+	size int
+	ttl  time.Duration // zero keeps entries until they are evicted
+
+	mu      sync.Mutex // guards entries and order against fetch goroutines
+	entries map[any]*list.Element
+	order   *list.List // of *cacheEntry[T], most recently used first
+}
+
+type cacheEntry[T any] struct {
+	key    any
+	data   T
+	stored time.Time
+}
+
+func newResourceCache[T any](size int, ttl time.Duration) *resourceCache[T] {
+	return &resourceCache[T]{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[any]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// cacheable reports whether key can be used as a cache key.
+func cacheable(key any) bool {
+	return key != nil && reflect.TypeOf(key).Comparable()
+}
+
+// get returns the data cached for key, dropping it once it has expired.
+func (c *resourceCache[T]) get(key any) (T, bool) {
+	var zero T
+	if !cacheable(key) {
+		return zero, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := el.Value.(*cacheEntry[T])
+	if c.ttl > 0 && time.Since(entry.stored) > c.ttl {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return zero, false
+	}
+	c.order.MoveToFront(el)
+	return entry.data, true
+}
+
+// put caches data for key, evicting the least recently used entry when the
+// cache is full.
+func (c *resourceCache[T]) put(key any, data T) {
+	if !cacheable(key) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value = &cacheEntry[T]{key: key, data: data, stored: time.Now()}
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry[T]{key: key, data: data, stored: time.Now()})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry[T]).key)
+	}
+}
+
+// remove drops the data cached for key.
+func (c *resourceCache[T]) remove(key any) {
+	if !cacheable(key) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+}
@@ -0,0 +1,249 @@
+package reactivity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResourceWithContextDropsSupersededFetch(t *testing.T) {
+	id := CreateSignal(1)
+	slowStarted := make(chan struct{})
+	slowCancelled := make(chan struct{})
+	release := make(chan struct{})
+
+	res := CreateResourceWithContext(id, func(ctx context.Context, n int) (string, error) {
+		if n == 1 {
+			close(slowStarted)
+			<-ctx.Done()
+			close(slowCancelled)
+			// Finish anyway, as a fetcher that ignores cancellation would
+			<-release
+			return "old", nil
+		}
+		return "new", nil
+	})
+
+	loaded := make(chan string, 4)
+	eff := CreateEffect(func() {
+		if d := res.Data(); d != "" {
+			loaded <- d
+		}
+	})
+	defer eff.Dispose()
+
+	<-slowStarted
+	id.Set(2)
+
+	select {
+	case <-slowCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("superseded fetch context was not cancelled")
+	}
+	d, ok := receive(loaded, time.Second)
+	if !ok {
+		t.Fatal("newer fetch did not load")
+	}
+	if d != "new" {
+		t.Fatalf("first loaded value = %q, want \"new\"", d)
+	}
+
+	close(release)
+	if d, ok := receive(loaded, 50*time.Millisecond); ok {
+		t.Fatalf("stale response overwrote data with %q", d)
+	}
+}
+
+func TestResourceKeepsDataOnError(t *testing.T) {
+	id := CreateSignal(1)
+	res := CreateResource(id, func(n int) (int, error) {
+		if n < 0 {
+			return 0, errors.New("negative id")
+		}
+		return n * 10, nil
+	})
+
+	settled := make(chan struct{}, 4)
+	eff := CreateEffect(func() {
+		if !res.Loading() {
+			settled <- struct{}{}
+		}
+	})
+	defer eff.Dispose()
+
+	wait := func() {
+		t.Helper()
+		if _, ok := receive(settled, time.Second); !ok {
+			t.Fatal("resource did not settle")
+		}
+	}
+
+	wait()
+	if res.Data() != 10 || res.Error() != nil {
+		t.Fatalf("Data = %d, Error = %v; want 10, nil", res.Data(), res.Error())
+	}
+
+	id.Set(-1)
+	wait()
+	if res.Data() != 10 || res.Error() == nil {
+		t.Fatalf("Data = %d, Error = %v; want 10 and an error", res.Data(), res.Error())
+	}
+}
+
+func TestAsyncMemoTracksSignalsAndDropsStaleResults(t *testing.T) {
+	a := CreateSignal(1)
+	b := CreateSignal(10)
+	release := make(chan struct{})
+	firstDone := make(chan struct{})
+
+	sum := CreateAsyncMemo(func() func() (int, error) {
+		x, y := a.Get(), b.Get()
+		return func() (int, error) {
+			if y == 10 {
+				// The first computation is slow and finishes after the second
+				<-release
+				defer close(firstDone)
+			}
+			return x + y, nil
+		}
+	})
+	if !sum.Loading() {
+		t.Fatal("expected Loading while the first computation runs")
+	}
+
+	results := make(chan int, 4)
+	eff := CreateEffect(func() {
+		if v := sum.Data(); v != 0 {
+			results <- v
+		}
+	})
+	defer eff.Dispose()
+
+	b.Set(20)
+	v, ok := receive(results, time.Second)
+	if !ok {
+		t.Fatal("async memo did not recompute after a tracked signal changed")
+	}
+	if v != 21 {
+		t.Fatalf("Data = %d, want 21", v)
+	}
+
+	close(release)
+	<-firstDone
+	if v, ok := receive(results, 50*time.Millisecond); ok {
+		t.Fatalf("stale computation overwrote data with %d", v)
+	}
+	if sum.Loading() {
+		t.Error("expected Loading to be false after the latest computation finished")
+	}
+}
+
+func TestCollectResourcesReturnsResourcesRead(t *testing.T) {
+	id := CreateSignal(1)
+	user := CreateResource(id, func(n int) (string, error) { return "user", nil })
+	posts := CreateResource(id, func(n int) (int, error) { return 3, nil })
+	unread := CreateResource(id, func(n int) (bool, error) { return true, nil })
+
+	var inner []AnyResource
+	reads := CollectResources(func() {
+		_ = user.Data()
+		_ = user.Loading()
+		inner = CollectResources(func() { _ = unread.Error() })
+		_ = posts.Error()
+	})
+	if len(reads) != 2 || reads[0] != AnyResource(user) || reads[1] != AnyResource(posts) {
+		t.Errorf("CollectResources = %v, want user and posts once each", reads)
+	}
+	if len(inner) != 1 || inner[0] != AnyResource(unread) {
+		t.Errorf("nested CollectResources = %v, want only the resource it read", inner)
+	}
+	if got := CollectResources(func() {}); len(got) != 0 {
+		t.Errorf("CollectResources without reads = %v", got)
+	}
+}
+
+func TestResourceCacheServesRepeatedSource(t *testing.T) {
+	id := CreateSignal(1)
+	var mu sync.Mutex
+	fetches := map[int]int{}
+	res := CreateResource(id, func(n int) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches[n]++
+		return fmt.Sprintf("user%d v%d", n, fetches[n]), nil
+	}, WithResourceCache(1, 0), WithRevalidation())
+	fetchCount := func(n int) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches[n]
+	}
+
+	waitUntil(t, func() bool { return res.Data() == "user1 v1" })
+	id.Set(3)
+	waitUntil(t, func() bool { return res.Data() == "user3 v1" })
+
+	// Back to user 3's cached data right away, revalidated in the background
+	id.Set(1)
+	id.Set(3)
+	if res.Data() != "user3 v1" || res.Loading() {
+		t.Fatalf("Data = %q, Loading = %v; want the cached user3 v1 without loading", res.Data(), res.Loading())
+	}
+	waitUntil(t, func() bool { return res.Data() == "user3 v2" })
+	if res.Loading() {
+		t.Error("background revalidation set Loading")
+	}
+
+	// The cache holds one entry: user 1 was evicted by user 3
+	id.Set(1)
+	waitUntil(t, func() bool { return fetchCount(1) == 3 && !res.Loading() })
+}
+
+func TestResourceCacheInvalidate(t *testing.T) {
+	id := CreateSignal(1)
+	var mu sync.Mutex
+	fetches := 0
+	res := CreateResource(id, func(n int) (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches++
+		return n*100 + fetches, nil
+	}, WithResourceCache(8, time.Minute))
+
+	waitUntil(t, func() bool { return res.Data() == 101 })
+	id.Set(2)
+	waitUntil(t, func() bool { return res.Data() == 202 })
+	id.Set(1)
+	if res.Data() != 101 || res.Loading() {
+		t.Fatalf("Data = %d, Loading = %v; want the cached 101", res.Data(), res.Loading())
+	}
+
+	// Invalidating the current value fetches it again
+	res.Invalidate(1)
+	waitUntil(t, func() bool { return res.Data() == 103 })
+
+	// Invalidating another value drops it from the cache only
+	res.Invalidate(2)
+	id.Set(2)
+	if !res.Loading() {
+		t.Error("invalidated value was served from the cache")
+	}
+	waitUntil(t, func() bool { return res.Data() == 204 })
+}
+
+func TestResourceCacheExpires(t *testing.T) {
+	id := CreateSignal(1)
+	res := CreateResource(id, func(n int) (int, error) { return n, nil }, WithResourceCache(8, 10*time.Millisecond))
+	waitUntil(t, func() bool { return res.Data() == 1 })
+	id.Set(2)
+	waitUntil(t, func() bool { return res.Data() == 2 })
+
+	time.Sleep(20 * time.Millisecond)
+	id.Set(1)
+	if !res.Loading() {
+		t.Error("expired entry was served from the cache")
+	}
+	waitUntil(t, func() bool { return res.Data() == 1 && !res.Loading() })
+}
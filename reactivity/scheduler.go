@@ -0,0 +1,115 @@
+package reactivity
+
+import "sync"
+
+// deferredEffects holds the deferred effects waiting for the next flush.
+var deferredEffects []*effect
+
+// flushScheduled is set while a flush of deferredEffects is scheduled.
+var flushScheduled bool
+
+// CreateRenderEffect creates an effect that re-runs synchronously, at high
+// priority, as soon as a signal it read changes (or when the surrounding
+// Batch ends). Bindings that write to the DOM use it so the page never
+// shows a stale value.
+func CreateRenderEffect(fn func()) Effect {
+	return CreateEffectWithOptions(fn, EffectOptions{Priority: PriorityHigh})
+}
+
+// CreateDeferredEffect creates an effect that runs immediately and then,
+// instead of re-running inside every Set, is queued and re-run once on the
+// next flush however many of its signals changed in the meantime. In the
+// browser the flush runs in a microtask, after the current event handler
+// and its synchronous render effects have finished; elsewhere it runs only
+// when FlushSync is called.
+func CreateDeferredEffect(fn func()) Effect {
+	return newEffect(fn, PriorityNormal, true)
+}
+
+// enqueueDeferred queues a deferred effect for the next flush.
+func (e *effect) enqueueDeferred() {
+	if e.queued || e.disposed {
+		return
+	}
+	e.queued = true
+	deferredEffects = append(deferredEffects, e)
+	if !flushScheduled {
+		flushScheduled = true
+		scheduleFlush(flushDeferred)
+	}
+}
+
+// posted holds the functions other goroutines handed to the next flush;
+// it is the only state of the graph they may touch.
+var (
+	postedMu sync.Mutex
+	posted   []func()
+)
+
+// post hands fn, called from a goroutine other than the one running the
+// graph, to the next flush, which runs it on that goroutine. Goroutines
+// started by this package apply their results through it: in the browser
+// the flush runs in a microtask, elsewhere when FlushSync is called.
+func post(fn func()) {
+	postedMu.Lock()
+	posted = append(posted, fn)
+	first := len(posted) == 1
+	postedMu.Unlock()
+	if first {
+		scheduleFlush(flushDeferred)
+	}
+}
+
+// takePosted removes and returns the functions handed to post.
+func takePosted() []func() {
+	postedMu.Lock()
+	defer postedMu.Unlock()
+	fns := posted
+	posted = nil
+	return fns
+}
+
+// FlushSync runs every queued deferred effect now, after applying the
+// results that goroutines such as those of FromChannel and CreateResource
+// handed back. Tests use it to observe them without waiting for the
+// scheduler. Inside a Batch they run when the batch ends instead.
+func FlushSync() {
+	flushDeferred()
+}
+
+// flushDeferred applies the posted results and runs the queued deferred
+// effects in creation order, batching their writes. Effects queued by those
+// writes run in the same flush. While a batch is open the flush waits for
+// it to end.
+func flushDeferred() {
+	flushScheduled = false
+	if batchDepth > 0 {
+		deferredAfterBatch = true
+		return
+	}
+	for {
+		if fns := takePosted(); len(fns) > 0 {
+			Batch(func() {
+				for _, fn := range fns {
+					fn()
+				}
+			})
+			continue
+		}
+		if len(deferredEffects) == 0 {
+			return
+		}
+		effects := deferredEffects
+		deferredEffects = nil
+		sortEffects(effects)
+		Batch(func() {
+			for _, e := range effects {
+				if !e.queued {
+					continue
+				}
+				e.queued = false
+				e.run()
+			}
+		})
+	}
+}
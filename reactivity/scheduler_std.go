@@ -0,0 +1,7 @@
+//go:build !js && !wasm
+
+package reactivity
+
+// scheduleFlush does nothing outside the browser: deferred effects run when
+// FlushSync is called.
+func scheduleFlush(flush func()) {}
@@ -0,0 +1,122 @@
+package reactivity
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeferredEffectRunsOncePerFlush(t *testing.T) {
+	a := CreateSignal(1)
+	b := CreateSignal(10)
+
+	runs := 0
+	sum := 0
+	eff := CreateDeferredEffect(func() {
+		runs++
+		sum = a.Get() + b.Get()
+	})
+	defer eff.Dispose()
+	if runs != 1 || sum != 11 {
+		t.Fatalf("initial run: runs = %d, sum = %d", runs, sum)
+	}
+
+	a.Set(2)
+	b.Set(20)
+	if runs != 1 {
+		t.Fatalf("deferred effect ran %d times before the flush", runs)
+	}
+
+	FlushSync()
+	if runs != 2 || sum != 22 {
+		t.Errorf("after flush: runs = %d, sum = %d; want 2, 22", runs, sum)
+	}
+
+	FlushSync()
+	if runs != 2 {
+		t.Errorf("an empty flush re-ran the effect")
+	}
+}
+
+func TestRenderEffectsRunBeforeDeferredEffects(t *testing.T) {
+	count := CreateSignal(0)
+
+	var order []string
+	deferred := CreateDeferredEffect(func() {
+		order = append(order, "deferred")
+		count.Get()
+	})
+	defer deferred.Dispose()
+	render := CreateRenderEffect(func() {
+		order = append(order, "render")
+		count.Get()
+	})
+	defer render.Dispose()
+	order = nil
+
+	Batch(func() {
+		count.Set(1)
+		count.Set(2)
+	})
+	if len(order) != 1 || order[0] != "render" {
+		t.Fatalf("order before flush = %v, want [render]", order)
+	}
+	FlushSync()
+	if len(order) != 2 || order[1] != "deferred" {
+		t.Errorf("order after flush = %v, want [render deferred]", order)
+	}
+}
+
+func TestDisposedDeferredEffectIsDropped(t *testing.T) {
+	s := CreateSignal(0)
+	runs := 0
+	eff := CreateDeferredEffect(func() {
+		s.Get()
+		runs++
+	})
+
+	s.Set(1)
+	eff.Dispose()
+	FlushSync()
+	if runs != 1 {
+		t.Errorf("disposed deferred effect ran after the flush (runs = %d)", runs)
+	}
+}
+
+func TestDeferredEffectBelongsToItsScope(t *testing.T) {
+	boom := errors.New("boom")
+	s := CreateSignal(0)
+	var errs []error
+	var seen []int
+
+	scope := NewCleanupScope(nil)
+	scope.OnError(func(err error) { errs = append(errs, err) })
+	defer scope.Dispose()
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(scope)
+	CreateDeferredEffect(func() {
+		seen = append(seen, s.Get())
+		if s.Peek() == 1 {
+			panic(boom)
+		}
+	})
+	SetCurrentCleanupScope(prev)
+
+	// A panicking re-run goes to the scope's handler
+	s.Set(1)
+	FlushSync()
+	if len(errs) != 1 || !errors.Is(errs[0], boom) {
+		t.Fatalf("scope handler got %v, want the panic", errs)
+	}
+
+	// A suspended scope holds the effect back until it resumes
+	scope.Suspend()
+	s.Set(2)
+	FlushSync()
+	if len(seen) != 2 {
+		t.Fatalf("effect ran while its scope was suspended: %v", seen)
+	}
+	scope.Resume()
+	if len(seen) != 3 || seen[2] != 2 {
+		t.Errorf("after resume the effect saw %v, want [0 1 2]", seen)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build js && wasm
+
+package reactivity
+
+import "syscall/js"
+
+// scheduleFlush runs flush in a microtask.
+func scheduleFlush(flush func()) {
+	var cb js.Func
+	cb = js.FuncOf(func(this js.Value, args []js.Value) any {
+		cb.Release()
+		flush()
+		return nil
+	})
+	js.Global().Call("queueMicrotask", cb)
+}
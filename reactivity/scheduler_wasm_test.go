@@ -0,0 +1,28 @@
+//go:build js && wasm
+
+package reactivity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeferredEffectFlushesInMicrotask(t *testing.T) {
+	a := CreateSignal("a")
+	b := CreateSignal("b")
+	runs := 0
+	eff := CreateDeferredEffect(func() {
+		a.Get()
+		b.Get()
+		runs++
+	})
+	defer eff.Dispose()
+
+	a.Set("a2")
+	b.Set("b2")
+	// Yield to the event loop so the microtask can run
+	time.Sleep(10 * time.Millisecond)
+	if runs != 2 {
+		t.Errorf("runs = %d, want 2 (initial run plus one flush)", runs)
+	}
+}
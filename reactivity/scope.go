@@ -7,6 +7,13 @@ type CleanupScope struct {
 	children  []*CleanupScope
 	disposers []func()
 	disposed  bool
+	onError   func(error)
+	// suspended holds back re-runs of the effects in the scope; paused
+	// lists the effects triggered meanwhile
+	suspended bool
+	paused    []*effect
+	// values holds what SetValue stored in the scope
+	values map[any]any
 }
 
 // currentCleanupScope holds the currently active cleanup scope
@@ -39,6 +46,87 @@ func (s *CleanupScope) RegisterDisposer(disposer func()) {
 	s.disposers = append(s.disposers, disposer)
 }
 
+// OnError sets the handler that receives the panics recovered from effects
+// created in this scope or its descendants, unless a scope closer to the
+// effect has a handler of its own. The handler runs outside any effect.
+func (s *CleanupScope) OnError(handler func(error)) {
+	s.onError = handler
+}
+
+// reportError passes err to the nearest live error handler of scope and its
+// parents. It reports whether one was found.
+func reportError(scope *CleanupScope, err error) bool {
+	for s := scope; s != nil; s = s.parent {
+		if s.onError != nil && !s.disposed {
+			handler := s.onError
+			runOwned(currentCleanupScope, func() { handler(err) })
+			return true
+		}
+	}
+	return false
+}
+
+// Suspend pauses the effects created in this scope and its descendants:
+// signal changes no longer re-run them. Each effect triggered while the
+// scope is suspended runs once on Resume. Memos keep computing, so reads
+// of them stay current.
+func (s *CleanupScope) Suspend() {
+	s.suspended = true
+}
+
+// Resume ends a Suspend and runs the effects triggered while the scope was
+// suspended, unless an enclosing scope is suspended still.
+func (s *CleanupScope) Resume() {
+	if !s.suspended {
+		return
+	}
+	s.suspended = false
+	paused := s.paused
+	s.paused = nil
+	sortEffects(paused)
+	for _, e := range paused {
+		e.paused = false
+		e.run()
+	}
+}
+
+// Suspended reports whether Suspend was called without a matching Resume.
+func (s *CleanupScope) Suspended() bool {
+	return s.suspended
+}
+
+// suspendedScope returns the nearest live suspended scope of scope and its
+// parents, or nil.
+func suspendedScope(scope *CleanupScope) *CleanupScope {
+	for s := scope; s != nil; s = s.parent {
+		if s.suspended && !s.disposed {
+			return s
+		}
+	}
+	return nil
+}
+
+// SetValue stores value under key in the scope, where Value finds it for
+// the scope and its descendants, e.g. to provide a value to the components
+// mounted in a subtree.
+func (s *CleanupScope) SetValue(key, value any) {
+	if s.values == nil {
+		s.values = make(map[any]any)
+	}
+	s.values[key] = value
+}
+
+// Value returns the value stored under key in the scope or the nearest of
+// its parents that has one. It can be called on a nil scope.
+func (s *CleanupScope) Value(key any) (any, bool) {
+	for sc := s; sc != nil; sc = sc.parent {
+		if v, ok := sc.values[key]; ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
 // GetParent returns the parent scope of this cleanup scope.
 func (s *CleanupScope) GetParent() *CleanupScope {
 	return s.parent
@@ -76,6 +164,16 @@ func (s *CleanupScope) Dispose() {
 	}
 	
 	s.disposed = true
+
+	// Leave a live parent, which may outlive many children such as list rows
+	if s.parent != nil && !s.parent.disposed {
+		for i, child := range s.parent.children {
+			if child == s {
+				s.parent.children = append(s.parent.children[:i], s.parent.children[i+1:]...)
+				break
+			}
+		}
+	}
 	
 	// Dispose all children first
 	for _, child := range s.children {
@@ -310,4 +310,103 @@ func TestCleanupScopeWithCleanupScope(t *testing.T) {
 	if cleanupCalls != 1 {
 		t.Errorf("Expected 1 cleanup call after WithCleanupScope, got %d", cleanupCalls)
 	}
-}
\ No newline at end of file
+}
+func TestCleanupScopeSuspendPausesEffects(t *testing.T) {
+	parent := NewCleanupScope(nil)
+	child := NewCleanupScope(parent)
+	s := CreateSignal(0)
+	doubled := CreateMemo(func() int { return s.Get() * 2 })
+
+	var seen []int
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(child)
+	CreateEffect(func() { seen = append(seen, s.Get()) })
+	SetCurrentCleanupScope(prev)
+
+	parent.Suspend()
+	s.Set(1)
+	s.Set(2)
+	if len(seen) != 1 {
+		t.Fatalf("effect ran while suspended: %v", seen)
+	}
+	if doubled.Get() != 4 {
+		t.Errorf("memo = %d while suspended, want 4", doubled.Get())
+	}
+
+	// Resuming runs the effect once with the latest value
+	parent.Resume()
+	if len(seen) != 2 || seen[1] != 2 {
+		t.Fatalf("after resume the effect saw %v, want [0 2]", seen)
+	}
+	s.Set(3)
+	if len(seen) != 3 || seen[2] != 3 {
+		t.Errorf("effect after resume saw %v, want [0 2 3]", seen)
+	}
+
+	// Effects that were not triggered do not run on resume
+	parent.Suspend()
+	parent.Resume()
+	if len(seen) != 3 {
+		t.Errorf("untriggered effect ran on resume: %v", seen)
+	}
+}
+
+func TestCleanupScopeResumeInsideSuspendedParent(t *testing.T) {
+	outer := NewCleanupScope(nil)
+	inner := NewCleanupScope(outer)
+	s := CreateSignal(0)
+	runs := 0
+	prev := GetCurrentCleanupScope()
+	SetCurrentCleanupScope(inner)
+	CreateEffect(func() {
+		s.Get()
+		runs++
+	})
+	SetCurrentCleanupScope(prev)
+
+	outer.Suspend()
+	inner.Suspend()
+	s.Set(1)
+	inner.Resume()
+	if runs != 1 {
+		t.Fatalf("effect ran while the outer scope is suspended: %d runs", runs)
+	}
+	outer.Resume()
+	if runs != 2 {
+		t.Errorf("effect runs after resuming both scopes = %d, want 2", runs)
+	}
+}
+
+func TestCleanupScopeValueNearestWins(t *testing.T) {
+	type key struct{}
+	root := NewCleanupScope(nil)
+	defer root.Dispose()
+	root.SetValue(key{}, "light")
+	inner := NewCleanupScope(root)
+	leaf := NewCleanupScope(NewCleanupScope(inner))
+
+	if v, ok := leaf.Value(key{}); !ok || v != "light" {
+		t.Errorf("leaf.Value = %v, %v; want the root value", v, ok)
+	}
+	inner.SetValue(key{}, "dark")
+	if v, _ := leaf.Value(key{}); v != "dark" {
+		t.Errorf("leaf.Value = %v after the inner scope set one, want dark", v)
+	}
+	if v, _ := root.Value(key{}); v != "light" {
+		t.Errorf("root.Value = %v, want its own value", v)
+	}
+	var none *CleanupScope
+	if _, ok := none.Value(key{}); ok {
+		t.Error("a nil scope found a value")
+	}
+}
+
+func TestCleanupScopeDisposeLeavesParent(t *testing.T) {
+	parent := NewCleanupScope(nil)
+	for i := 0; i < 3; i++ {
+		NewCleanupScope(parent).Dispose()
+	}
+	if len(parent.children) != 0 {
+		t.Errorf("parent keeps %d disposed children", len(parent.children))
+	}
+}
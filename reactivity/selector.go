@@ -0,0 +1,109 @@
+package reactivity
+
+// selector backs CreateSelector with one boolean signal per observed key.
+type selector[K comparable] struct {
+	source  Signal[K]
+	current K
+	keys    map[K]*selectorKey[K]
+}
+
+// selectorKey is the signal the effects observing one key depend on. It is
+// dropped from its selector as soon as the last of them is disposed or
+// stops reading it, so the selector only holds keys someone observes.
+type selectorKey[K comparable] struct {
+	s   *selector[K]
+	key K
+	sig *baseSignal[bool]
+}
+
+// CreateSelector returns a function giving the signal of whether key equals
+// the current value of source. When source changes only the signals of the
+// previously and the newly selected key change, so effects reading them in
+// a list re-run for those two rows instead of every row. Setting a key's
+// signal to true selects the key; setting it to false while the key is
+// selected sets source to the zero value. The effect tracking source is
+// disposed with the current cleanup scope.
+func CreateSelector[K comparable](source Signal[K]) func(key K) Signal[bool] {
+	s := newSelector(source)
+	return func(key K) Signal[bool] {
+		return &selectorSignal[K]{s: s, key: key}
+	}
+}
+
+// newSelector creates the selector following source.
+func newSelector[K comparable](source Signal[K]) *selector[K] {
+	s := &selector[K]{source: source, keys: make(map[K]*selectorKey[K])}
+	initialized := false
+	CreateEffect(func() {
+		next := source.Get()
+		if !initialized {
+			s.current, initialized = next, true
+			return
+		}
+		if next == s.current {
+			return
+		}
+		prev := s.current
+		s.current = next
+		s.notify(prev, false)
+		s.notify(next, true)
+	})
+	return s
+}
+
+// notify updates the signal observed for key, if any.
+func (s *selector[K]) notify(key K, selected bool) {
+	if k, ok := s.keys[key]; ok {
+		k.sig.Set(selected)
+	}
+}
+
+func (s *selector[K]) isSelected(key K) bool {
+	if currentEffect == nil || currentEffect.disposed || untracking {
+		return key == s.current
+	}
+	k, ok := s.keys[key]
+	if !ok {
+		k = &selectorKey[K]{
+			s:   s,
+			key: key,
+			sig: &baseSignal[bool]{value: key == s.current, deps: make(map[*effect]struct{})},
+		}
+		s.keys[key] = k
+	}
+	// Register the dependency through k so its removal can prune the key
+	k.sig.deps[currentEffect] = struct{}{}
+	currentEffect.deps[k] = struct{}{}
+	return k.sig.value
+}
+
+// removeEffect detaches eff and drops the key once nothing observes it.
+func (k *selectorKey[K]) removeEffect(eff *effect) {
+	k.sig.removeEffect(eff)
+	if len(k.sig.deps) == 0 && k.s.keys[k.key] == k {
+		delete(k.s.keys, k.key)
+	}
+}
+
+// selectorSignal is the Signal of one key; every signal of the same key
+// shares the selector's dependency node for it.
+type selectorSignal[K comparable] struct {
+	s   *selector[K]
+	key K
+}
+
+func (k *selectorSignal[K]) Get() bool  { return k.s.isSelected(k.key) }
+func (k *selectorSignal[K]) Peek() bool { return k.key == k.s.current }
+
+func (k *selectorSignal[K]) Set(selected bool) {
+	if selected {
+		k.s.source.Set(k.key)
+	} else if k.s.source.Peek() == k.key {
+		var zero K
+		k.s.source.Set(zero)
+	}
+}
+
+func (k *selectorSignal[K]) OnChange(fn func(old, new bool)) func() {
+	return onChange[bool](k, nil, fn)
+}
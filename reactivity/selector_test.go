@@ -0,0 +1,155 @@
+package reactivity
+
+import "testing"
+
+func TestCreateSelectorRerunsOnlyAffectedKeys(t *testing.T) {
+	selected := CreateSignal("a")
+	isSelected := CreateSelector(selected)
+
+	runs := map[string]int{}
+	state := map[string]bool{}
+	for _, key := range []string{"a", "b", "c"} {
+		key := key
+		CreateEffect(func() {
+			state[key] = isSelected(key).Get()
+			runs[key]++
+		})
+	}
+	if !state["a"] || state["b"] || state["c"] {
+		t.Fatalf("initial state = %v, want only a selected", state)
+	}
+
+	selected.Set("b")
+	if state["a"] || !state["b"] || state["c"] {
+		t.Fatalf("state after selecting b = %v", state)
+	}
+	if runs["a"] != 2 || runs["b"] != 2 || runs["c"] != 1 {
+		t.Fatalf("runs after selecting b = %v, want a:2 b:2 c:1", runs)
+	}
+
+	selected.Set("z")
+	if runs["a"] != 2 || runs["b"] != 3 || runs["c"] != 1 {
+		t.Fatalf("runs after selecting an unobserved key = %v, want a:2 b:3 c:1", runs)
+	}
+}
+
+func TestCreateSelectorOutsideEffect(t *testing.T) {
+	selected := CreateSignal(1)
+	isSelected := CreateSelector(selected)
+
+	if !isSelected(1).Get() || isSelected(2).Get() {
+		t.Fatal("untracked reads should compare against the current value")
+	}
+	selected.Set(2)
+	if isSelected(1).Peek() || !isSelected(2).Peek() {
+		t.Fatal("untracked reads should see the updated value")
+	}
+}
+
+func TestCreateSelectorSignalSetSelects(t *testing.T) {
+	selected := CreateSignal("a")
+	isSelected := CreateSelector(selected)
+
+	isSelected("b").Set(true)
+	if selected.Get() != "b" {
+		t.Fatalf("source = %q after selecting b, want b", selected.Get())
+	}
+	// Deselecting a key that is not selected leaves the selection alone
+	isSelected("a").Set(false)
+	if selected.Get() != "b" {
+		t.Fatalf("source = %q after deselecting a, want b", selected.Get())
+	}
+	isSelected("b").Set(false)
+	if selected.Get() != "" {
+		t.Fatalf("source = %q after deselecting b, want the zero value", selected.Get())
+	}
+
+	var changes [][2]bool
+	isSelected("c").OnChange(func(old, new bool) { changes = append(changes, [2]bool{old, new}) })
+	selected.Set("c")
+	if len(changes) != 1 || changes[0] != [2]bool{false, true} {
+		t.Fatalf("OnChange saw %v, want one change to true", changes)
+	}
+}
+
+func TestCreateSelectorDisposedWithScope(t *testing.T) {
+	selected := CreateSignal("a")
+	scope := NewCleanupScope(nil)
+	SetCurrentCleanupScope(scope)
+	isSelected := CreateSelector(selected)
+	SetCurrentCleanupScope(nil)
+
+	scope.Dispose()
+	selected.Set("b")
+	if !isSelected("a").Get() {
+		t.Error("a disposed selector should stop following its source")
+	}
+}
+
+func TestCreateSelectorPrunesKeysWithoutDependents(t *testing.T) {
+	selected := CreateSignal(0)
+	s := newSelector[int](selected)
+
+	var effects []Effect
+	for key := 0; key < 10; key++ {
+		key := key
+		effects = append(effects, CreateEffect(func() { s.isSelected(key) }))
+	}
+	if len(s.keys) != 10 {
+		t.Fatalf("observed keys = %d, want 10", len(s.keys))
+	}
+
+	// Keys go away with their last reader, notified or not
+	for _, e := range effects[1:] {
+		e.Dispose()
+	}
+	if len(s.keys) != 1 {
+		t.Fatalf("keys after disposing their readers = %d, want 1", len(s.keys))
+	}
+	effects[0].Dispose()
+	if len(s.keys) != 0 {
+		t.Fatalf("keys after disposing every reader = %d, want 0", len(s.keys))
+	}
+}
+
+func TestCreateSelectorNotifiesTwoOfManyKeys(t *testing.T) {
+	const keys = 1000
+	selected := CreateSignal(0)
+	isSelected := CreateSelector(selected)
+
+	notified := map[int]int{}
+	for key := 0; key < keys; key++ {
+		key := key
+		sig := isSelected(key)
+		CreateEffect(func() {
+			sig.Get()
+			notified[key]++
+		})
+	}
+	if len(notified) != keys {
+		t.Fatalf("initial subscribers = %d, want %d", len(notified), keys)
+	}
+
+	for _, next := range []int{500, 999, 0} {
+		prev := selected.Peek()
+		notified = map[int]int{}
+		selected.Set(next)
+		if len(notified) != 2 || notified[prev] != 1 || notified[next] != 1 {
+			t.Fatalf("selecting %d after %d notified %v, want only %d and %d once", next, prev, notified, prev, next)
+		}
+	}
+}
+
+func BenchmarkCreateSelectorChange(b *testing.B) {
+	const keys = 1000
+	selected := CreateSignal(0)
+	isSelected := CreateSelector(selected)
+	for key := 0; key < keys; key++ {
+		sig := isSelected(key)
+		CreateEffect(func() { sig.Get() })
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		selected.Set(i % keys)
+	}
+}
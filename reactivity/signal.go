@@ -10,9 +10,44 @@ type Signal[T any] interface {
 	// Get returns the current value and registers the current running effect
 	// (if any) as a dependent of this signal.
 	Get() T
+	// Peek returns the current value without registering the current running
+	// effect as a dependent, so reading a signal in an effect that also
+	// writes it cannot cause a loop. Outside an effect it behaves like Get.
+	Peek() T
 	// Set updates the value. If the value hasn't changed (DeepEqual), it's a no-op.
 	// Otherwise all dependent effects are re-executed.
 	Set(value T)
+	// OnChange calls fn with the previous and the new value after every
+	// change, once the value has committed (after the outermost Batch) and
+	// outside any tracking. The subscription ends when unsubscribe is
+	// called, which may be done more than once, or when the current cleanup
+	// scope is disposed.
+	OnChange(fn func(old, new T)) (unsubscribe func())
+}
+
+// ReadonlySignal is a read-only view of a Signal. It can be handed to child
+// components that should observe a value without being able to change it.
+// Every Signal satisfies ReadonlySignal.
+type ReadonlySignal[T any] interface {
+	// Get returns the current value and registers the current running effect
+	// (if any) as a dependent of the underlying signal.
+	Get() T
+}
+
+// readonlySignal wraps a Signal and exposes only Get. The wrapped signal is
+// unexported so callers cannot recover the writable signal from the view.
+type readonlySignal[T any] struct {
+	sig Signal[T]
+}
+
+// ReadOnly returns a read-only view of sig. Reads through the view are
+// tracked exactly like reads of sig itself.
+func ReadOnly[T any](sig Signal[T]) ReadonlySignal[T] {
+	return &readonlySignal[T]{sig: sig}
+}
+
+func (r *readonlySignal[T]) Get() T {
+	return r.sig.Get()
 }
 
 // baseSignal implements Signal and tracks dependent effects.
@@ -21,22 +56,55 @@ type baseSignal[T any] struct {
 	value T
 	// deps tracks effects depending on this signal
 	deps map[*effect]struct{}
+	// equals decides whether a Set is a no-op; nil means reflect.DeepEqual
+	equals func(a, b T) bool
+	// site counts the signal for the leak detector; nil while it is off
+	site *leakSite
+}
+
+// equal reports whether a and b are equal under the signal's comparator.
+func (s *baseSignal[T]) equal(a, b T) bool {
+	if s.equals != nil {
+		return s.equals(a, b)
+	}
+	return reflect.DeepEqual(a, b)
 }
 
 // removeEffect detaches the given effect from this signal's dependency list.
 func (s *baseSignal[T]) removeEffect(eff *effect) {
+	if _, ok := s.deps[eff]; ok && s.site != nil {
+		leakCount(&s.site.subs, -1)
+	}
 	delete(s.deps, eff)
 }
 
 func CreateSignal[T any](initial T) Signal[T] {
-	return &baseSignal[T]{
+	s := &baseSignal[T]{
 		value: initial,
 		deps:  make(map[*effect]struct{}),
 	}
+	trackSignal(s)
+	return s
+}
+
+// CreateSignalWithEquals is like CreateSignal but uses equals instead of
+// reflect.DeepEqual to decide whether Set changes the value. When equals
+// reports true, Set is a no-op and no effects run.
+func CreateSignalWithEquals[T any](initial T, equals func(a, b T) bool) Signal[T] {
+	s := &baseSignal[T]{
+		value:  initial,
+		deps:   make(map[*effect]struct{}),
+		equals: equals,
+	}
+	trackSignal(s)
+	return s
 }
 
 func (s *baseSignal[T]) Get() T {
-	if currentEffect != nil && !currentEffect.disposed {
+	if s.site != nil {
+		s.countRead()
+	}
+	if currentEffect != nil && !currentEffect.disposed && !untracking {
 		// Register dependency both ways
 		s.deps[currentEffect] = struct{}{}
 		currentEffect.deps[s] = struct{}{}
@@ -44,24 +112,85 @@ func (s *baseSignal[T]) Get() T {
 	return s.value
 }
 
+// countRead counts a read, and the subscription it adds, for the leak
+// detector.
+func (s *baseSignal[T]) countRead() {
+	leakCount(&s.site.reads, 1)
+	if currentEffect != nil && !currentEffect.disposed && !untracking {
+		if _, ok := s.deps[currentEffect]; !ok {
+			leakCount(&s.site.subs, 1)
+		}
+	}
+}
+
+func (s *baseSignal[T]) Peek() T {
+	if s.site != nil {
+		leakCount(&s.site.reads, 1)
+	}
+	return s.value
+}
+
 func (s *baseSignal[T]) Set(v T) {
-	if reflect.DeepEqual(s.value, v) {
+	if s.equal(s.value, v) {
 		return
 	}
 	s.value = v
-	// Re-run all dependent effects (iterate over a snapshot to avoid mutation issues)
+	recordWrite()
+	if batchDepth > 0 {
+		for e := range s.deps {
+			e.schedule()
+		}
+		return
+	}
+	// Re-run all dependent effects in priority order (iterate over a snapshot to avoid mutation issues)
 	effects := make([]*effect, 0, len(s.deps))
 	for e := range s.deps {
 		effects = append(effects, e)
 	}
+	sortEffects(effects)
 	for _, e := range effects {
 		if e.disposed {
 			delete(s.deps, e)
 			continue
 		}
+		if e.deferred {
+			e.enqueueDeferred()
+			continue
+		}
 		e.run()
 	}
 }
 
+func (s *baseSignal[T]) OnChange(fn func(old, new T)) func() {
+	return onChange[T](s, s.equal, fn)
+}
+
+// onChange implements OnChange for s with a low priority effect that
+// remembers the last value it saw. equal filters out runs that end on the
+// value they started from, such as a Batch setting a value and back; nil
+// means reflect.DeepEqual.
+func onChange[T any](s ReadonlySignal[T], equal func(a, b T) bool, fn func(old, new T)) (unsubscribe func()) {
+	if equal == nil {
+		equal = func(a, b T) bool { return reflect.DeepEqual(a, b) }
+	}
+	var prev T
+	first := true
+	e := CreateEffectWithOptions(func() {
+		v := s.Get()
+		if first {
+			first = false
+			prev = v
+			return
+		}
+		old := prev
+		prev = v
+		if equal(old, v) {
+			return
+		}
+		runOwned(currentCleanupScope, func() { fn(old, v) })
+	}, EffectOptions{Priority: PriorityLow})
+	return e.Dispose
+}
+
 // sAny is a tiny helper to coerce generic signal to any for effect deps map.
 func sAny[T any](s *baseSignal[T]) any { return any(s) }
@@ -1,6 +1,8 @@
 package reactivity
 
 import (
+	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -60,3 +62,152 @@ func TestUnrelatedSignalDoesNotTrigger(t *testing.T) {
 		t.Fatalf("runs after unrelated signal set = %d, want 1", runs)
 	}
 }
+
+func TestReadOnlyTracksUnderlyingSignal(t *testing.T) {
+	s := CreateSignal(1)
+	ro := ReadOnly(s)
+
+	var seen int
+	runs := 0
+	_ = CreateEffect(func() {
+		seen = ro.Get()
+		runs++
+	})
+
+	s.Set(2)
+	if runs != 2 || seen != 2 {
+		t.Fatalf("readonly view did not track: runs=%d seen=%d", runs, seen)
+	}
+}
+
+func TestReadOnlyCannotBeAssertedWritable(t *testing.T) {
+	ro := ReadOnly(CreateSignal("a"))
+	if _, ok := any(ro).(Signal[string]); ok {
+		t.Fatal("readonly view must not be assertable to Signal")
+	}
+	if _, ok := any(ro).(interface{ Set(string) }); ok {
+		t.Fatal("readonly view must not expose Set")
+	}
+}
+
+func TestPeekDoesNotTrack(t *testing.T) {
+	s := CreateSignal(1)
+	if s.Peek() != 1 {
+		t.Fatalf("Peek outside an effect = %d, want 1", s.Peek())
+	}
+
+	runs := 0
+	_ = CreateEffect(func() {
+		// Reading and writing the same signal must not loop
+		s.Set(s.Peek() + 1)
+		runs++
+	})
+	if runs != 1 || s.Get() != 2 {
+		t.Fatalf("runs=%d value=%d, want 1 and 2", runs, s.Get())
+	}
+
+	s.Set(10)
+	if runs != 1 {
+		t.Fatalf("effect re-ran after a peeked signal changed: runs=%d", runs)
+	}
+}
+
+func TestPeekMemoAndAdapter(t *testing.T) {
+	base := CreateSignal(2)
+	double := CreateMemo(func() int { return base.Get() * 2 })
+	typed := Adapt[int](CreateSignal[any](5))
+
+	runs := 0
+	_ = CreateEffect(func() {
+		_ = double.Peek()
+		_ = typed.Peek()
+		runs++
+	})
+	if double.Peek() != 4 || typed.Peek() != 5 {
+		t.Fatalf("Peek values = %d, %d; want 4, 5", double.Peek(), typed.Peek())
+	}
+
+	base.Set(3)
+	typed.Set(6)
+	if runs != 1 {
+		t.Fatalf("effect re-ran after peeked signals changed: runs=%d", runs)
+	}
+	if double.Peek() != 6 || typed.Peek() != 6 {
+		t.Fatalf("Peek should see updated values, got %d, %d", double.Peek(), typed.Peek())
+	}
+}
+
+func TestSignalOnChange(t *testing.T) {
+	s := CreateSignal(1)
+	var first, second [][2]int
+	unsubscribe := s.OnChange(func(old, new int) { first = append(first, [2]int{old, new}) })
+	s.OnChange(func(old, new int) { second = append(second, [2]int{old, new}) })
+
+	s.Set(2)
+	s.Set(2)
+	s.Set(5)
+	want := [][2]int{{1, 2}, {2, 5}}
+	if !reflect.DeepEqual(first, want) || !reflect.DeepEqual(second, want) {
+		t.Fatalf("changes = %v and %v, want %v for both", first, second, want)
+	}
+
+	unsubscribe()
+	unsubscribe()
+	s.Set(6)
+	if len(first) != 2 {
+		t.Errorf("unsubscribed callback still called: %v", first)
+	}
+	if len(second) != 3 {
+		t.Errorf("other subscriber stopped after unsubscribe: %v", second)
+	}
+}
+
+func TestSignalOnChangeAfterBatchCommits(t *testing.T) {
+	a := CreateSignal("a")
+	b := CreateSignal(0)
+	var seen []string
+	a.OnChange(func(old, new string) {
+		// b was written in the same batch; the callback sees the result
+		seen = append(seen, old+">"+new+":"+strconv.Itoa(b.Get()))
+	})
+
+	Batch(func() {
+		a.Set("b")
+		a.Set("c")
+		b.Set(7)
+	})
+	Batch(func() {
+		a.Set("x")
+		a.Set("c")
+	})
+	if want := []string{"a>c:7"}; !reflect.DeepEqual(seen, want) {
+		t.Fatalf("changes = %v, want %v", seen, want)
+	}
+
+	// Reads in the callback do not subscribe it
+	b.Set(8)
+	if len(seen) != 1 {
+		t.Errorf("callback tracked a read: %v", seen)
+	}
+}
+
+func TestSignalOnChangeDisposedWithScope(t *testing.T) {
+	s := CreateSignal(0)
+	calls := 0
+	WithCleanupScope(nil, func(*CleanupScope) {
+		s.OnChange(func(int, int) { calls++ })
+		s.Set(1)
+	})
+	s.Set(2)
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 before the scope was disposed", calls)
+	}
+
+	memo := CreateMemo(func() int { return s.Get() * 10 })
+	var memoChange [2]int
+	memo.OnChange(func(old, new int) { memoChange = [2]int{old, new} })
+	s.Set(3)
+	if memoChange != [2]int{20, 30} {
+		t.Errorf("memo change = %v, want [20 30]", memoChange)
+	}
+}
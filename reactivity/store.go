@@ -40,8 +40,29 @@ type Store[T any] interface {
 	Select(path ...any) Signal[any]
 	// SelectLen returns a Signal[int] representing the length of the slice/array at the given path.
 	SelectLen(path ...any) Signal[int]
+	// Push appends an item to the slice at path: Push("Todos", item).
+	Push(args ...any)
+	// RemoveAt removes the element at an index: RemoveAt("Todos", i).
+	RemoveAt(args ...any)
+	// InsertAt inserts an item before an index: InsertAt("Todos", i, item).
+	InsertAt(args ...any)
+	// Swap exchanges two elements: Swap("Todos", i, j).
+	Swap(args ...any)
+	// Subscribe calls callback with the previous and new snapshot of the
+	// value at path whenever anything under it changes. It returns a
+	// function that unsubscribes.
+	Subscribe(callback func(old, new any), path ...any) func()
+	// Transaction runs fn with a setter whose writes are applied together:
+	// reads inside fn see them, but effects and subscribers are notified
+	// only after fn returns. If fn panics every write made during it is
+	// undone and the panic re-raised.
+	Transaction(fn func(set SetFunc))
 }
 
+// SetFunc sets the value at a path, with the same arguments as the
+// setState function returned by CreateStore.
+type SetFunc func(args ...any)
+
 type store[T any] struct {
 	root *storeNode
 	typ  reflect.Type
@@ -78,6 +99,137 @@ func (s *store[T]) SelectLen(path ...any) Signal[int] {
 	return n.slen
 }
 
+// Slice operations keep index selectors positional: Select("Todos", i, ...)
+// always reads whatever element is at index i. Elements keep their nodes
+// and only the values that move between indices are reassigned, so after
+// RemoveAt(i) or InsertAt(i) selectors for indices before i are not
+// notified and those at or after i are notified only where the element now
+// at that index differs; selectors obtained for the index that disappears
+// are reset to the zero value. Push notifies only SelectLen; Swap only the
+// two indices involved. Each operation runs as a single Batch.
+
+// Push appends the last argument to the slice at the preceding path.
+func (s *store[T]) Push(args ...any) {
+	if len(args) == 0 {
+		panic("Push requires an item")
+	}
+	n := s.sliceNode("Push", args[:len(args)-1])
+	Batch(func() {
+		n.elems = append(n.elems, buildNode(reflect.ValueOf(args[len(args)-1])))
+		n.slen.Set(len(n.elems))
+	})
+}
+
+// RemoveAt removes the element at the index given as the last argument.
+func (s *store[T]) RemoveAt(args ...any) {
+	if len(args) == 0 {
+		panic("RemoveAt requires an index")
+	}
+	n := s.sliceNode("RemoveAt", args[:len(args)-1])
+	i := sliceIndex("RemoveAt", args[len(args)-1], len(n.elems))
+	Batch(func() {
+		last := len(n.elems) - 1
+		for k := i; k < last; k++ {
+			s.assignNodeValue(n.elems[k], snapshotElem(n, k+1))
+		}
+		// Selectors still holding the dropped index read the zero value
+		s.assignNodeValue(n.elems[last], reflect.Zero(n.typ.Elem()))
+		n.elems = n.elems[:last]
+		n.slen.Set(len(n.elems))
+	})
+}
+
+// InsertAt inserts the last argument before the index given just before
+// it. An index equal to the length appends.
+func (s *store[T]) InsertAt(args ...any) {
+	if len(args) < 2 {
+		panic("InsertAt requires an index and an item")
+	}
+	n := s.sliceNode("InsertAt", args[:len(args)-2])
+	i := sliceIndex("InsertAt", args[len(args)-2], len(n.elems)+1)
+	item := reflect.ValueOf(args[len(args)-1])
+	Batch(func() {
+		if i == len(n.elems) {
+			n.elems = append(n.elems, buildNode(item))
+		} else {
+			last := len(n.elems) - 1
+			n.elems = append(n.elems, buildNode(snapshotElem(n, last)))
+			for k := last; k > i; k-- {
+				s.assignNodeValue(n.elems[k], snapshotElem(n, k-1))
+			}
+			s.assignNodeValue(n.elems[i], item)
+		}
+		n.slen.Set(len(n.elems))
+	})
+}
+
+// Swap exchanges the elements at the two indices given as the last
+// arguments.
+func (s *store[T]) Swap(args ...any) {
+	if len(args) < 2 {
+		panic("Swap requires two indices")
+	}
+	n := s.sliceNode("Swap", args[:len(args)-2])
+	i := sliceIndex("Swap", args[len(args)-2], len(n.elems))
+	j := sliceIndex("Swap", args[len(args)-1], len(n.elems))
+	if i == j {
+		return
+	}
+	vi, vj := snapshotElem(n, i), snapshotElem(n, j)
+	Batch(func() {
+		s.assignNodeValue(n.elems[i], vj)
+		s.assignNodeValue(n.elems[j], vi)
+	})
+}
+
+// sliceNode resolves path to a slice node for the named operation.
+func (s *store[T]) sliceNode(op string, path []any) *storeNode {
+	n := s.root
+	for i, p := range path {
+		switch key := p.(type) {
+		case string:
+			if n.fields == nil || n.fields[key] == nil {
+				panic(fmt.Sprintf("%s: segment %d ('%v') does not point to a struct field", op, i, key))
+			}
+			n = n.fields[key]
+		case int:
+			if n.elems == nil || key < 0 || key >= len(n.elems) {
+				panic(fmt.Sprintf("%s: segment %d (%v) is not a valid slice index", op, i, key))
+			}
+			n = n.elems[key]
+		default:
+			panic(fmt.Sprintf("%s: unsupported path segment type %T", op, p))
+		}
+	}
+	if n.elems == nil || n.typ == nil || n.typ.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("%s: path does not point to a slice", op))
+	}
+	if n.slen == nil {
+		n.slen = CreateSignal(len(n.elems))
+	}
+	return n
+}
+
+// sliceIndex validates an index argument against the bound [0, limit).
+func sliceIndex(op string, arg any, limit int) int {
+	i, ok := arg.(int)
+	if !ok {
+		panic(fmt.Sprintf("%s: index must be an int, got %T", op, arg))
+	}
+	if i < 0 || i >= limit {
+		panic(fmt.Sprintf("%s: index %d out of range", op, i))
+	}
+	return i
+}
+
+// snapshotElem returns the value of element k of slice node n without
+// subscribing the running effect to it.
+func snapshotElem(n *storeNode, k int) reflect.Value {
+	dst := reflect.New(n.typ.Elem()).Elem()
+	UntrackVoid(func() { buildSnapshot(n.elems[k], dst) })
+	return dst
+}
+
 type storeNode struct {
 	// kind/type of the node's data
 	typ reflect.Type
@@ -101,63 +253,85 @@ func CreateStore[T any](initialState T) (Store[T], func(...any)) {
 	root := buildNode(val)
 	st := &store[T]{root: root, typ: typ}
 
+	// Notify once per call, however many leaves change
 	setter := func(args ...any) {
-		if len(args) == 0 {
-			panic("setState requires at least a value")
-		}
-		newVal := args[len(args)-1]
-		path := args[:len(args)-1]
-		if len(path) == 0 {
-			// Replace entire root
-			st.assignNodeValue(st.root, reflect.ValueOf(newVal))
-			return
-		}
-		n := st.root
-		for i, p := range path {
-			switch key := p.(type) {
-			case string:
-				if n.fields == nil {
-					panic(fmt.Sprintf("path at segment %d ('%v') does not point to a struct", i, key))
-				}
-				nn, ok := n.fields[key]
-				if !ok {
-					// If missing (e.g., setting new field on struct), create a node on demand based on the incoming value type.
-					nn = buildNode(reflect.ValueOf(newVal))
-					n.fields[key] = nn
-				}
-				n = nn
-			case int:
-				if n.elems == nil {
-					panic(fmt.Sprintf("path at segment %d (%v) does not point to a slice/array", i, key))
-				}
-				idx := key
-				if idx < 0 {
-					panic("negative index in setState path")
-				}
-				// Expand elems if necessary
-				for len(n.elems) <= idx {
-					// Create properly typed element nodes based on the slice element type
-					var child *storeNode
-					if n.typ != nil && (n.typ.Kind() == reflect.Slice || n.typ.Kind() == reflect.Array) {
-						et := n.typ.Elem()
-						child = buildNode(reflect.Zero(et))
-					} else {
-						child = &storeNode{leaf: CreateSignal(any(nil))}
-					}
-					n.elems = append(n.elems, child)
-				}
-				if n.slen != nil {
-					n.slen.Set(len(n.elems))
+		Batch(func() { st.set(args...) })
+	}
+
+	return st, setter
+}
+
+// set applies one setState call: a path followed by the new value.
+func (s *store[T]) set(args ...any) {
+	if len(args) == 0 {
+		panic("setState requires at least a value")
+	}
+	newVal := args[len(args)-1]
+	path := args[:len(args)-1]
+	if len(path) == 0 {
+		// Replace entire root
+		s.assignNodeValue(s.root, reflect.ValueOf(newVal))
+		return
+	}
+	n := s.root
+	for i, p := range path {
+		switch key := p.(type) {
+		case string:
+			if n.fields == nil {
+				panic(fmt.Sprintf("path at segment %d ('%v') does not point to a struct", i, key))
+			}
+			nn, ok := n.fields[key]
+			if !ok {
+				// If missing (e.g., setting new field on struct), create a node on demand based on the incoming value type.
+				nn = buildNode(reflect.ValueOf(newVal))
+				n.fields[key] = nn
+			}
+			n = nn
+		case int:
+			if n.elems == nil {
+				panic(fmt.Sprintf("path at segment %d (%v) does not point to a slice/array", i, key))
+			}
+			idx := key
+			if idx < 0 {
+				panic("negative index in setState path")
+			}
+			// Expand elems if necessary
+			for len(n.elems) <= idx {
+				// Create properly typed element nodes based on the slice element type
+				var child *storeNode
+				if n.typ != nil && (n.typ.Kind() == reflect.Slice || n.typ.Kind() == reflect.Array) {
+					et := n.typ.Elem()
+					child = buildNode(reflect.Zero(et))
+				} else {
+					child = &storeNode{leaf: CreateSignal(any(nil))}
 				}
-				n = n.elems[idx]
-			default:
-				panic(fmt.Sprintf("unsupported path segment type %T; use string (field) or int (index)", p))
+				n.elems = append(n.elems, child)
+			}
+			if n.slen != nil {
+				n.slen.Set(len(n.elems))
 			}
+			n = n.elems[idx]
+		default:
+			panic(fmt.Sprintf("unsupported path segment type %T; use string (field) or int (index)", p))
 		}
-		st.assignNodeValue(n, reflect.ValueOf(newVal))
 	}
+	s.assignNodeValue(n, reflect.ValueOf(newVal))
+}
 
-	return st, setter
+// Transaction applies every write made through set as one batch, rolling
+// them all back if fn panics.
+func (s *store[T]) Transaction(fn func(set SetFunc)) {
+	before := reflect.New(s.typ).Elem()
+	UntrackVoid(func() { buildSnapshot(s.root, before) })
+	Batch(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.assignNodeValue(s.root, before)
+				panic(r)
+			}
+		}()
+		fn(s.set)
+	})
 }
 
 func buildNode(v reflect.Value) *storeNode {
@@ -308,6 +482,10 @@ func buildSnapshot(n *storeNode, dst reflect.Value) {
 			buildSnapshot(child, dst.Field(i))
 		}
 	case reflect.Slice:
+		// Track the length too, so appended elements are noticed
+		if n.slen != nil {
+			n.slen.Get()
+		}
 		// For slices, construct slice of the same length as node.elems
 		l := len(n.elems)
 		dst.Set(reflect.MakeSlice(dst.Type(), l, l))
@@ -340,6 +518,17 @@ func buildSnapshot(n *storeNode, dst reflect.Value) {
 
 // Select returns a Signal[any] for a nested property.
 func (s *store[T]) Select(path ...any) Signal[any] {
+	n := s.selectNode(path)
+	// If n is non-leaf (struct/slice), we provide a memo that snapshots it.
+	if n.leaf == nil {
+		return CreateMemo(func() any { return snapshotNode(n) })
+	}
+	return n.leaf
+}
+
+// selectNode resolves path, creating nodes for fields and indices that do
+// not exist yet so they can be populated later.
+func (s *store[T]) selectNode(path []any) *storeNode {
 	n := s.root
 	for i, p := range path {
 		switch key := p.(type) {
@@ -389,18 +578,44 @@ func (s *store[T]) Select(path ...any) Signal[any] {
 			panic(fmt.Sprintf("Select: unsupported path segment type %T", p))
 		}
 	}
-	// If n is non-leaf (struct/slice), we provide a memo that snapshots it.
-	if n.leaf == nil {
-		return CreateMemo(func() any {
-			if n.typ == nil {
-				return nil
-			}
-			dst := reflect.New(n.typ).Elem()
-			buildSnapshot(n, dst)
-			return dst.Interface()
-		})
+	return n
+}
+
+// Subscribe registers callback for changes under path. Changes made by one
+// setState call or slice operation are delivered as a single call; old and
+// new are independent snapshots, so callback may keep them.
+func (s *store[T]) Subscribe(callback func(old, new any), path ...any) func() {
+	n := s.selectNode(path)
+	var prev any
+	initialized := false
+	e := CreateEffect(func() {
+		next := snapshotNode(n)
+		if !initialized {
+			prev, initialized = next, true
+			return
+		}
+		if reflect.DeepEqual(prev, next) {
+			return
+		}
+		old := prev
+		prev = next
+		UntrackVoid(func() { callback(old, next) })
+	})
+	return e.Dispose
+}
+
+// snapshotNode returns the current value of n, subscribing the running
+// effect to every leaf and length under it.
+func snapshotNode(n *storeNode) any {
+	if n.leaf != nil {
+		return n.leaf.Get()
 	}
-	return n.leaf
+	if n.typ == nil {
+		return nil
+	}
+	dst := reflect.New(n.typ).Elem()
+	buildSnapshot(n, dst)
+	return dst.Interface()
 }
 
 // Adapt wraps a Signal[any] into a typed Signal[V].
@@ -411,8 +626,12 @@ type adapter[V any] struct {
 // Adapt converts a generic any-based signal to a typed one.
 func Adapt[V any](s Signal[any]) Signal[V] { return &adapter[V]{inner: s} }
 
-func (t *adapter[V]) Get() V {
-	v := t.inner.Get()
+func (t *adapter[V]) Get() V { return adaptValue[V](t.inner.Get()) }
+
+func (t *adapter[V]) Peek() V { return adaptValue[V](t.inner.Peek()) }
+
+// adaptValue converts v to V, returning the zero value if it cannot.
+func adaptValue[V any](v any) V {
 	if v == nil {
 		var zero V
 		return zero
@@ -433,3 +652,7 @@ func (t *adapter[V]) Get() V {
 }
 
 func (t *adapter[V]) Set(v V) { t.inner.Set(any(v)) }
+
+func (t *adapter[V]) OnChange(fn func(old, new V)) func() {
+	return t.inner.OnChange(func(old, new any) { fn(adaptValue[V](old), adaptValue[V](new)) })
+}
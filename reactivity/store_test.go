@@ -1,6 +1,7 @@
 package reactivity
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -170,3 +171,216 @@ func TestStore_ExpandThenSelectField(t *testing.T) {
 		t.Fatalf("runs after setting same value = %d; want 2", runs)
 	}
 }
+
+func TestStore_SliceOperations(t *testing.T) {
+	store, _ := CreateStore(testApp{Items: []testItem{{ID: 1}, {ID: 2}, {ID: 3}}})
+
+	ids := func() []int {
+		var out []int
+		for _, it := range store.Get().Items {
+			out = append(out, it.ID)
+		}
+		return out
+	}
+	assertIDs := func(want ...int) {
+		t.Helper()
+		got := ids()
+		if len(got) != len(want) {
+			t.Fatalf("ids = %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("ids = %v, want %v", got, want)
+			}
+		}
+		if l := store.SelectLen("Items").Get(); l != len(want) {
+			t.Fatalf("SelectLen = %d, want %d", l, len(want))
+		}
+	}
+
+	store.Push("Items", testItem{ID: 4})
+	assertIDs(1, 2, 3, 4)
+	store.InsertAt("Items", 1, testItem{ID: 9})
+	assertIDs(1, 9, 2, 3, 4)
+	store.RemoveAt("Items", 0)
+	assertIDs(9, 2, 3, 4)
+	store.Swap("Items", 0, 3)
+	assertIDs(4, 2, 3, 9)
+	store.InsertAt("Items", 4, testItem{ID: 5})
+	assertIDs(4, 2, 3, 9, 5)
+}
+
+func TestStore_SliceOperationsNotifyOnlyAffectedIndices(t *testing.T) {
+	items := make([]testItem, 6)
+	for i := range items {
+		items[i] = testItem{ID: i + 1}
+	}
+	store, setState := CreateStore(testApp{Items: items})
+
+	// One "view" per item, like a list rendering each row from its index
+	renders := make([]int, len(items))
+	for i := range items {
+		i := i
+		CreateEffect(func() {
+			_ = Adapt[int](store.Select("Items", i, "ID")).Get()
+			_ = Adapt[bool](store.Select("Items", i, "Completed")).Get()
+			renders[i]++
+		})
+	}
+	lenRuns := 0
+	CreateEffect(func() {
+		_ = store.SelectLen("Items").Get()
+		lenRuns++
+	})
+	reset := func() {
+		for i := range renders {
+			renders[i] = 0
+		}
+		lenRuns = 0
+	}
+	assertRenders := func(op string, want ...int) {
+		t.Helper()
+		for i := range want {
+			if renders[i] != want[i] {
+				t.Fatalf("%s: renders = %v, want %v", op, renders, want)
+			}
+		}
+	}
+
+	reset()
+	setState("Items", 3, "Completed", true)
+	assertRenders("toggle item 3", 0, 0, 0, 1, 0, 0)
+	if lenRuns != 0 {
+		t.Errorf("toggle notified SelectLen %d times", lenRuns)
+	}
+
+	reset()
+	store.Push("Items", testItem{ID: 7})
+	assertRenders("push", 0, 0, 0, 0, 0, 0)
+	if lenRuns != 1 {
+		t.Errorf("push notified SelectLen %d times, want 1", lenRuns)
+	}
+
+	reset()
+	store.Swap("Items", 1, 4)
+	assertRenders("swap", 0, 1, 0, 0, 1, 0)
+
+	// Removing index 2 shifts later values down; earlier views are untouched
+	// and each shifted view runs once despite several fields changing.
+	reset()
+	store.RemoveAt("Items", 2)
+	assertRenders("remove", 0, 0, 1, 1, 1, 1)
+	if lenRuns != 1 {
+		t.Errorf("remove notified SelectLen %d times, want 1", lenRuns)
+	}
+}
+
+func TestStore_Subscribe(t *testing.T) {
+	store, setState := CreateStore(testApp{Items: []testItem{{ID: 1}, {ID: 2}}})
+
+	type call struct{ old, new []testItem }
+	var calls []call
+	unsubscribe := store.Subscribe(func(old, new any) {
+		calls = append(calls, call{old.([]testItem), new.([]testItem)})
+	}, "Items")
+
+	if len(calls) != 0 {
+		t.Fatalf("Subscribe fired %d times on registration", len(calls))
+	}
+
+	// Replacing the slice changes several leaves but fires once
+	setState("Items", []testItem{{ID: 1, Completed: true}, {ID: 3, Completed: true}})
+	if len(calls) != 1 {
+		t.Fatalf("calls after setState = %d, want 1", len(calls))
+	}
+	if calls[0].old[1].ID != 2 || calls[0].new[1].ID != 3 || !calls[0].new[0].Completed {
+		t.Errorf("unexpected old/new: %+v", calls[0])
+	}
+
+	// Writing the same value is not a change
+	setState("Items", 0, "Completed", true)
+	if len(calls) != 1 {
+		t.Errorf("unchanged write fired the callback")
+	}
+
+	store.Push("Items", testItem{ID: 4})
+	if len(calls) != 2 || len(calls[1].new) != 3 || len(calls[1].old) != 2 {
+		t.Fatalf("Push should fire with the grown slice, got %+v", calls)
+	}
+
+	unsubscribe()
+	setState("Items", 0, "ID", 10)
+	if len(calls) != 2 {
+		t.Errorf("callback fired after unsubscribe")
+	}
+}
+
+func TestStore_SubscribeLeaf(t *testing.T) {
+	store, setState := CreateStore(testNested{A: 1, B: "x"})
+	var got []any
+	defer store.Subscribe(func(old, new any) { got = append(got, old, new) }, "A")()
+
+	setState("B", "y")
+	setState("A", 2)
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("leaf subscription got %v, want [1 2]", got)
+	}
+}
+
+func TestStore_TransactionNotifiesOnce(t *testing.T) {
+	store, _ := CreateStore(testNested{A: 1, B: "x"})
+
+	var seen []testNested
+	defer store.Subscribe(func(old, new any) { seen = append(seen, new.(testNested)) })()
+	a := Adapt[int](store.Select("A"))
+
+	var inside int
+	store.Transaction(func(set SetFunc) {
+		set("A", 2)
+		// Reads inside the transaction see staged values
+		inside = a.Get()
+		set("B", "y")
+		if len(seen) != 0 {
+			t.Error("subscriber fired before the transaction finished")
+		}
+	})
+
+	if inside != 2 {
+		t.Errorf("Select read %d inside the transaction, want 2", inside)
+	}
+	if len(seen) != 1 || seen[0] != (testNested{A: 2, B: "y"}) {
+		t.Errorf("subscriber saw %+v, want one call with {2 y}", seen)
+	}
+}
+
+func TestStore_TransactionRollsBackOnPanic(t *testing.T) {
+	store, _ := CreateStore(testApp{Items: []testItem{{ID: 1}, {ID: 2}}})
+
+	calls := 0
+	defer store.Subscribe(func(old, new any) { calls++ })()
+	length := store.SelectLen("Items")
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want the original panic", r)
+			}
+		}()
+		store.Transaction(func(set SetFunc) {
+			set("Items", 0, "Completed", true)
+			store.Push("Items", testItem{ID: 3})
+			panic("boom")
+		})
+	}()
+
+	want := testApp{Items: []testItem{{ID: 1}, {ID: 2}}}
+	if got := store.Get(); !reflect.DeepEqual(got, want) {
+		t.Errorf("state after rollback = %+v, want %+v", got, want)
+	}
+	if length.Get() != 2 {
+		t.Errorf("SelectLen after rollback = %d, want 2", length.Get())
+	}
+	if calls != 0 {
+		t.Errorf("subscriber fired %d times for a rolled back transaction", calls)
+	}
+}
@@ -0,0 +1,76 @@
+package reactivity
+
+import "time"
+
+// nowClock is the shared ticking signal behind Now for one resolution.
+type nowClock struct {
+	sig  Signal[time.Time]
+	refs int
+	stop func()
+}
+
+var (
+	// nowClocks holds one clock per resolution so that every Now caller with
+	// the same resolution shares a single interval.
+	nowClocks = map[time.Duration]*nowClock{}
+
+	// timeNow and startInterval are replaced in tests with a fake clock. The
+	// ticks of the real interval are handed to the graph's goroutine with
+	// post.
+	timeNow       = time.Now
+	startInterval = func(d time.Duration, tick func()) (stop func()) {
+		ticker := time.NewTicker(d)
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case <-ticker.C:
+					post(tick)
+				case <-done:
+					return
+				}
+			}
+		}()
+		return func() {
+			ticker.Stop()
+			close(done)
+		}
+	}
+)
+
+// Now returns a signal holding the current time, refreshed every resolution.
+// All callers with the same resolution share one signal and one interval.
+// The interval starts with the first caller and stops once every caller's
+// cleanup scope has been disposed; callers outside a cleanup scope keep it
+// running. A resolution <= 0 defaults to one second.
+func Now(resolution time.Duration) Signal[time.Time] {
+	if resolution <= 0 {
+		resolution = time.Second
+	}
+	clock, ok := nowClocks[resolution]
+	if !ok {
+		clock = &nowClock{sig: CreateSignal(timeNow())}
+		nowClocks[resolution] = clock
+	}
+	if clock.refs == 0 {
+		clock.sig.Set(timeNow())
+		clock.stop = startInterval(resolution, func() {
+			clock.sig.Set(timeNow())
+		})
+	}
+	clock.refs++
+
+	released := false
+	RegisterCleanup(func() {
+		if released {
+			return
+		}
+		released = true
+		clock.refs--
+		if clock.refs == 0 && clock.stop != nil {
+			clock.stop()
+			clock.stop = nil
+		}
+	})
+	return clock.sig
+}
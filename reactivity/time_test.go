@@ -0,0 +1,109 @@
+package reactivity
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock replaces the wall clock and interval scheduler used by Now.
+type fakeClock struct {
+	now     time.Time
+	ticks   map[time.Duration]func()
+	started int
+	stopped int
+}
+
+func useFakeClock(t *testing.T) *fakeClock {
+	t.Helper()
+	fc := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), ticks: map[time.Duration]func(){}}
+	prevNow, prevStart, prevClocks := timeNow, startInterval, nowClocks
+	timeNow = func() time.Time { return fc.now }
+	startInterval = func(d time.Duration, tick func()) func() {
+		fc.started++
+		fc.ticks[d] = tick
+		return func() {
+			fc.stopped++
+			delete(fc.ticks, d)
+		}
+	}
+	nowClocks = map[time.Duration]*nowClock{}
+	t.Cleanup(func() {
+		timeNow, startInterval, nowClocks = prevNow, prevStart, prevClocks
+	})
+	return fc
+}
+
+// advance moves the fake time forward and fires every running interval.
+func (fc *fakeClock) advance(d time.Duration) {
+	fc.now = fc.now.Add(d)
+	for _, tick := range fc.ticks {
+		tick()
+	}
+}
+
+func TestNowUpdatesAsTimeAdvances(t *testing.T) {
+	fc := useFakeClock(t)
+	scope := NewCleanupScope(nil)
+	SetCurrentCleanupScope(scope)
+	now := Now(time.Second)
+	SetCurrentCleanupScope(nil)
+
+	var seen []time.Time
+	CreateEffect(func() { seen = append(seen, now.Get()) })
+
+	start := fc.now
+	fc.advance(time.Second)
+	fc.advance(time.Second)
+	if len(seen) != 3 || !seen[2].Equal(start.Add(2*time.Second)) {
+		t.Fatalf("expected 3 observed times ending at +2s, got %v", seen)
+	}
+
+	scope.Dispose()
+	if fc.stopped != 1 || len(fc.ticks) != 0 {
+		t.Errorf("expected the interval to stop after disposal, stopped=%d running=%d", fc.stopped, len(fc.ticks))
+	}
+}
+
+func TestNowSharesIntervalPerResolution(t *testing.T) {
+	fc := useFakeClock(t)
+	a, b, c := NewCleanupScope(nil), NewCleanupScope(nil), NewCleanupScope(nil)
+
+	SetCurrentCleanupScope(a)
+	first := Now(time.Second)
+	SetCurrentCleanupScope(b)
+	second := Now(time.Second)
+	SetCurrentCleanupScope(c)
+	Now(time.Minute)
+	SetCurrentCleanupScope(nil)
+
+	if first != second {
+		t.Error("callers with the same resolution should share a signal")
+	}
+	if fc.started != 2 {
+		t.Fatalf("expected one interval per resolution (2), got %d", fc.started)
+	}
+
+	a.Dispose()
+	if fc.stopped != 0 {
+		t.Error("interval should keep running while another caller remains")
+	}
+	b.Dispose()
+	if _, running := fc.ticks[time.Second]; running {
+		t.Error("second-resolution interval should stop after its last caller")
+	}
+	if _, running := fc.ticks[time.Minute]; !running {
+		t.Error("minute-resolution interval should be unaffected")
+	}
+
+	// A new caller restarts the interval with a fresh time.
+	fc.now = fc.now.Add(time.Hour)
+	d := NewCleanupScope(nil)
+	SetCurrentCleanupScope(d)
+	again := Now(time.Second)
+	SetCurrentCleanupScope(nil)
+	if fc.started != 3 || !again.Get().Equal(fc.now) {
+		t.Errorf("expected restart with current time, started=%d value=%v", fc.started, again.Get())
+	}
+	c.Dispose()
+	d.Dispose()
+}
@@ -0,0 +1,25 @@
+package reactivity
+
+// untracking is set while an Untrack callback runs; signal reads made then do
+// not register dependencies on the current effect.
+var untracking bool
+
+// Untrack runs fn and returns its result without subscribing the current
+// effect to any signal read inside fn, including reads made by nested calls.
+// Cleanups registered with OnCleanup inside fn still belong to the current
+// effect, and effects created inside fn track their own dependencies as
+// usual.
+func Untrack[T any](fn func() T) T {
+	prev := untracking
+	untracking = true
+	defer func() { untracking = prev }()
+	return fn()
+}
+
+// UntrackVoid is Untrack for callbacks without a result.
+func UntrackVoid(fn func()) {
+	Untrack(func() struct{} {
+		fn()
+		return struct{}{}
+	})
+}
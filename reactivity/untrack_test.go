@@ -0,0 +1,78 @@
+package reactivity
+
+import "testing"
+
+func TestUntrackDoesNotSubscribe(t *testing.T) {
+	posts := CreateSignal(1)
+	user := CreateSignal("alice")
+
+	runs := 0
+	var seenUser string
+	CreateEffect(func() {
+		_ = posts.Get()
+		seenUser = Untrack(user.Get)
+		runs++
+	})
+
+	user.Set("bob")
+	if runs != 1 {
+		t.Fatalf("untracked signal re-ran the effect: runs = %d, want 1", runs)
+	}
+
+	posts.Set(2)
+	if runs != 2 || seenUser != "bob" {
+		t.Fatalf("tracked signal: runs = %d, user = %q; want 2, bob", runs, seenUser)
+	}
+}
+
+func TestUntrackSkipsNestedReads(t *testing.T) {
+	a := CreateSignal(1)
+	b := CreateSignal(2)
+	sum := CreateMemo(func() int { return a.Get() + b.Get() })
+
+	runs := 0
+	CreateEffect(func() {
+		UntrackVoid(func() {
+			_ = a.Get()
+			_ = Untrack(func() int { return b.Get() })
+			_ = sum.Get()
+		})
+		runs++
+	})
+
+	a.Set(10)
+	b.Set(20)
+	if runs != 1 {
+		t.Fatalf("nested untracked reads re-ran the effect: runs = %d, want 1", runs)
+	}
+	if got := sum.Get(); got != 30 {
+		t.Fatalf("memo read inside Untrack should keep tracking its own deps: got %d, want 30", got)
+	}
+}
+
+func TestUntrackKeepsCleanupsAndInnerEffects(t *testing.T) {
+	outer := CreateSignal(0)
+	inner := CreateSignal(0)
+
+	cleanups, innerRuns := 0, 0
+	CreateEffect(func() {
+		_ = outer.Get()
+		UntrackVoid(func() {
+			OnCleanup(func() { cleanups++ })
+			CreateEffect(func() {
+				_ = inner.Get()
+				innerRuns++
+			})
+		})
+	})
+
+	inner.Set(1)
+	if innerRuns != 2 {
+		t.Fatalf("effect created inside Untrack should track its reads: runs = %d, want 2", innerRuns)
+	}
+
+	outer.Set(1)
+	if cleanups != 1 {
+		t.Fatalf("OnCleanup inside Untrack should belong to the outer effect: cleanups = %d, want 1", cleanups)
+	}
+}
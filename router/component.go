@@ -0,0 +1,129 @@
+package router
+
+import (
+	"net/url"
+
+	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/reactivity"
+	g "maragu.dev/gomponents"
+)
+
+// RouteContext is what a component created with Comp is rendered with.
+type RouteContext struct {
+	router   *Router
+	route    *RouteDefinition
+	location Location
+	params   map[string]string
+	outlet   g.Node
+}
+
+// Route returns the route being rendered.
+func (rc RouteContext) Route() *RouteDefinition { return rc.route }
+
+// Location returns the location being rendered.
+func (rc RouteContext) Location() Location { return rc.location }
+
+// Params returns a signal holding the params of the rendered route, updated
+// whenever the router renders a route.
+func (rc RouteContext) Params() reactivity.ReadonlySignal[map[string]string] {
+	return reactivity.ReadOnly(rc.router.params)
+}
+
+// Param returns the named param the route was rendered with, or "".
+func (rc RouteContext) Param(name string) string { return rc.params[name] }
+
+// Query returns the search parameters of the location being rendered.
+func (rc RouteContext) Query() url.Values { return rc.location.Query() }
+
+// LoaderData returns what this route's Loader passed to SetLoaderData during
+// the navigation that rendered it, or nil.
+func (rc RouteContext) LoaderData() any { return rc.router.loaderData[rc.route] }
+
+// Outlet returns the rendered child route for a layout, or an empty group
+// when the route itself was matched.
+func (rc RouteContext) Outlet() g.Node {
+	if rc.outlet == nil {
+		return g.Group(nil)
+	}
+	return rc.outlet
+}
+
+// Comp adapts a typed component to the RouteDefinition.Component signature,
+// so it can be passed to Route and ModalRoute:
+//
+//	Route("/users/:id", Comp(func(rc RouteContext) g.Node {
+//		return h.H1(g.Text("User " + rc.Param("id")))
+//	}))
+func Comp(fn func(rc RouteContext) g.Node) func(props ...any) interface{} {
+	return func(props ...any) interface{} {
+		for _, prop := range props {
+			if rc, ok := prop.(RouteContext); ok {
+				return fn(rc)
+			}
+		}
+		logutil.Log("router: Comp component rendered without a RouteContext")
+		return nil
+	}
+}
+
+// buildComponentHierarchy constructs the component hierarchy for nested routes.
+// It traverses the route tree from root to the matched route, composing parent
+// components with their child content according to the layout pattern.
+// Components get the child content (parents only) and params as positional
+// props, followed by their RouteContext.
+func buildComponentHierarchy(router *Router, location Location, matchedRoute *RouteDefinition, params map[string]string) g.Node {
+	// Find the route hierarchy from root to the matched route
+	routeHierarchy := findRouteHierarchy(router.routes, location.Pathname, matchedRoute)
+	if len(routeHierarchy) == 0 {
+		logutil.Log("No route hierarchy found")
+		return nil
+	}
+
+	logutil.Logf("Building component hierarchy with %d levels", len(routeHierarchy))
+	router.params.Set(params)
+	context := func(rd *RouteDefinition, outlet g.Node) RouteContext {
+		return RouteContext{router: router, route: rd, location: location, params: params, outlet: outlet}
+	}
+
+	// Start from the deepest (matched) route and work backwards
+	var currentNode g.Node
+
+	// Render the deepest route first
+	deepestRoute := routeHierarchy[len(routeHierarchy)-1]
+	logutil.Logf("Rendering deepest component for route: %s", deepestRoute.Path)
+	componentResult := deepestRoute.Component(params, context(deepestRoute, nil))
+	if componentResult == nil {
+		logutil.Log("Deepest component function returned nil")
+		return nil
+	}
+
+	var ok bool
+	currentNode, ok = componentResult.(g.Node)
+	if !ok {
+		logutil.Logf("Deepest component did not return a gomponents.Node, got: %T", componentResult)
+		return nil
+	}
+
+	// Work backwards through parent routes, passing child content as props
+	for i := len(routeHierarchy) - 2; i >= 0; i-- {
+		parentRoute := routeHierarchy[i]
+		logutil.Logf("Composing parent component for route: %s", parentRoute.Path)
+
+		// Call parent component with child node as first argument
+		parentResult := parentRoute.Component(currentNode, params, context(parentRoute, currentNode))
+		if parentResult == nil {
+			logutil.Logf("Parent component for route %s returned nil", parentRoute.Path)
+			return nil
+		}
+
+		parentNode, ok := parentResult.(g.Node)
+		if !ok {
+			logutil.Logf("Parent component for route %s did not return a gomponents.Node, got: %T", parentRoute.Path, parentResult)
+			return nil
+		}
+
+		currentNode = parentNode
+	}
+
+	return currentNode
+}
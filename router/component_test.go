@@ -0,0 +1,91 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func renderHierarchy(t *testing.T, r *Router, path string) string {
+	t.Helper()
+	location := parseLocation(path, nil)
+	route, params := r.Match(location.Pathname)
+	if route == nil {
+		t.Fatalf("no route matched %s", path)
+	}
+	node := buildComponentHierarchy(r, location, route, params)
+	if node == nil {
+		t.Fatalf("no component hierarchy for %s", path)
+	}
+	var buf bytes.Buffer
+	if err := node.Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestCompRendersNestedLayoutWithOutlet(t *testing.T) {
+	user := Route("/:id", Comp(func(rc RouteContext) g.Node {
+		return h.P(g.Text("user " + rc.Param("id") + " tab " + rc.Query().Get("tab")))
+	}))
+	layout := Route("/users", Comp(func(rc RouteContext) g.Node {
+		return h.Main(h.H1(g.Text("Users")), rc.Outlet())
+	}), user)
+
+	r := New([]*RouteDefinition{layout}, nil)
+
+	if got, want := renderHierarchy(t, r, "/users/42?tab=posts"), "<main><h1>Users</h1><p>user 42 tab posts</p></main>"; got != want {
+		t.Errorf("nested render = %q, want %q", got, want)
+	}
+	if got := r.params.Peek()["id"]; got != "42" {
+		t.Errorf("params signal id = %q, want 42", got)
+	}
+	if got, want := renderHierarchy(t, r, "/users"), "<main><h1>Users</h1></main>"; got != want {
+		t.Errorf("layout without a child = %q, want %q", got, want)
+	}
+}
+
+func TestCompMixesWithPositionalComponents(t *testing.T) {
+	child := Route("/:id", Comp(func(rc RouteContext) g.Node {
+		return h.Span(g.Text(rc.Params().Get()["id"]))
+	}))
+	legacy := Route("/items", func(props ...any) interface{} {
+		if len(props) > 0 {
+			if childNode, ok := props[0].(g.Node); ok {
+				return h.Div(childNode)
+			}
+		}
+		return h.Div()
+	}, child)
+
+	r := New([]*RouteDefinition{legacy}, nil)
+	if got, want := renderHierarchy(t, r, "/items/7"), "<div><span>7</span></div>"; got != want {
+		t.Errorf("render = %q, want %q", got, want)
+	}
+}
+
+func TestCompLoaderData(t *testing.T) {
+	var rendered string
+	profile := Route("/profile/:id", Comp(func(rc RouteContext) g.Node {
+		name, _ := rc.LoaderData().(string)
+		rendered = name
+		return h.P(g.Text(name))
+	}))
+	profile.Loader = func(ctx context.Context, to Location, params map[string]string) error {
+		SetLoaderData(ctx, "user-"+params["id"])
+		return nil
+	}
+
+	restoreURL(t)
+	r := New([]*RouteDefinition{profile}, nil)
+	r.Navigate("/profile/3")
+	waitFor(t, func() bool { return r.Location().Pathname == "/profile/3" })
+
+	renderHierarchy(t, r, "/profile/3")
+	if rendered != "user-3" {
+		t.Errorf("LoaderData = %q, want user-3", rendered)
+	}
+}
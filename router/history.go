@@ -0,0 +1,73 @@
+package router
+
+import "github.com/ozanturksever/uiwgo/reactivity"
+
+// HistoryIndex returns a signal holding the position of the current history
+// entry among those the router created in this session: 0 for the entry the
+// app was opened at, one more for each entry pushed after it. Replacing an
+// entry keeps the index; Back, Forward and the browser buttons move it to
+// the index of the entry they return to.
+func (r *Router) HistoryIndex() reactivity.Signal[int] {
+	return r.historyIndex
+}
+
+// CanGoBack returns a signal that is true while Back returns to an entry
+// the router created in this session, e.g. to show a back arrow in a
+// header. It is false on the entry the app was opened at, such as a deep
+// link.
+func (r *Router) CanGoBack() reactivity.Signal[bool] {
+	return r.canGoBack
+}
+
+// PreviousLocation returns a signal holding the location the current
+// history entry was entered from, e.g. a search page with its filters for
+// a "Back to results" link. It is nil on the entry the app was opened at.
+// Replacing an entry keeps its previous location, and Back, Forward and
+// the browser buttons restore that of the entry they return to.
+func (r *Router) PreviousLocation() reactivity.Signal[*Location] {
+	return r.previous
+}
+
+// UsePreviousLocation returns the PreviousLocation signal of the current
+// router, or a signal holding nil when no router has been created.
+func UsePreviousLocation() reactivity.Signal[*Location] {
+	if currentRouter == nil {
+		return reactivity.CreateSignal[*Location](nil)
+	}
+	return currentRouter.previous
+}
+
+// backOr goes back when Back returns to an entry of this session and
+// navigates to fallback otherwise, e.g. after opening a deep link.
+func (r *Router) backOr(fallback string) {
+	if r.canGoBack.Peek() {
+		r.Back()
+		return
+	}
+	r.navigate(fallback, NavigateOptions{})
+}
+
+// trackEntry records the index of a history entry the router is about to
+// make current: the same as the current one when replacing, the next one
+// when pushing. A pushed entry is entered from the current location; a
+// replacing one keeps the previous location of the entry it replaces.
+func (r *Router) trackEntry(loc Location, replace bool) {
+	current := r.locationState.Get()
+	index := r.historyIndex.Peek()
+	previous := r.previousLocations[current.Key]
+	if !replace {
+		index++
+		previous = &current
+	}
+	r.entryIndexes[loc.Key] = index
+	r.previousLocations[loc.Key] = previous
+	r.historyIndex.Set(index)
+	r.previous.Set(previous)
+}
+
+// restoreEntry moves the index and previous location to those of the entry
+// loc returns to. Entries the router did not create count as the first.
+func (r *Router) restoreEntry(loc Location) {
+	r.historyIndex.Set(r.entryIndexes[loc.Key])
+	r.previous.Set(r.previousLocations[loc.Key])
+}
@@ -0,0 +1,15 @@
+//go:build !js && !wasm
+
+package router
+
+import "testing"
+
+// goBack goes back one history entry.
+func goBack(t *testing.T, r *Router) {
+	t.Helper()
+	r.Back()
+}
+
+// restoreURL puts the browser URL back when the test ends; without a
+// browser there is nothing to restore.
+func restoreURL(t *testing.T) {}
@@ -0,0 +1,27 @@
+//go:build js && wasm
+
+package router
+
+import (
+	"syscall/js"
+	"testing"
+)
+
+// goBack goes back one history entry and waits for the browser's popstate
+// to update the router's location.
+func goBack(t *testing.T, r *Router) {
+	t.Helper()
+	key := r.Location().Key
+	r.Back()
+	waitFor(t, func() bool { return r.Location().Key != key })
+}
+
+// restoreURL puts the browser URL back when the test ends, so the next
+// test's router starts from the same location.
+func restoreURL(t *testing.T) {
+	loc := js.Global().Get("location")
+	url := loc.Get("pathname").String() + loc.Get("search").String() + loc.Get("hash").String()
+	t.Cleanup(func() {
+		js.Global().Get("history").Call("replaceState", nil, "", url)
+	})
+}
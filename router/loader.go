@@ -0,0 +1,138 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ozanturksever/logutil"
+)
+
+// navigationTracker hands out navigation tokens. Starting a navigation
+// cancels the context of the previous one, and only the holder of the latest
+// token may commit.
+type navigationTracker struct {
+	mu     sync.Mutex
+	id     uint64
+	cancel context.CancelFunc
+}
+
+// begin starts a new navigation and returns its token and context.
+func (t *navigationTracker) begin() (uint64, context.Context) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.id++
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	return t.id, ctx
+}
+
+// current reports whether id is the latest navigation.
+func (t *navigationTracker) current(id uint64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.id == id
+}
+
+// finish releases the context of navigation id if it is still the latest.
+func (t *navigationTracker) finish(id uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.id == id && t.cancel != nil {
+		t.cancel()
+		t.cancel = nil
+	}
+}
+
+// runNavigation starts a navigation to location and calls commit once the
+// loaders of its route hierarchy have succeeded, provided no newer
+// navigation has started. Without loaders commit runs synchronously.
+func (r *Router) runNavigation(location Location, commit func()) {
+	id, ctx := r.nav.begin()
+	routes, params := r.routeLoaders(location.Pathname)
+	if len(routes) == 0 {
+		r.nav.finish(id)
+		r.loaderData = nil
+		commit()
+		return
+	}
+
+	go func() {
+		data := make(map[*RouteDefinition]any, len(routes))
+		for _, rd := range routes {
+			slot := &loaderDataSlot{}
+			if err := runLoader(context.WithValue(ctx, loaderDataKey{}, slot), rd.Loader, location, params); err != nil {
+				if ctx.Err() == nil {
+					logutil.Logf("router: navigation to %s abandoned: %v", location.Pathname, err)
+				}
+				r.nav.finish(id)
+				return
+			}
+			if !r.nav.current(id) {
+				return
+			}
+			if slot.set {
+				data[rd] = slot.data
+			}
+		}
+		if !r.nav.current(id) {
+			return
+		}
+		r.nav.finish(id)
+		r.loaderData = data
+		commit()
+	}()
+}
+
+// routeLoaders returns the routes with a loader among the route matching
+// path and its parents, outermost first, along with the matched params.
+func (r *Router) routeLoaders(path string) ([]*RouteDefinition, map[string]string) {
+	route, params := r.Match(path)
+	if route == nil {
+		return nil, nil
+	}
+	hierarchy := findRouteHierarchy(r.routes, path, route)
+	if len(hierarchy) == 0 {
+		hierarchy = []*RouteDefinition{route}
+	}
+	var routes []*RouteDefinition
+	for _, rd := range hierarchy {
+		if rd.Loader != nil {
+			routes = append(routes, rd)
+		}
+	}
+	return routes, params
+}
+
+// runLoader calls loader, turning a panic into an error.
+func runLoader(ctx context.Context, loader func(context.Context, Location, map[string]string) error, to Location, params map[string]string) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("loader panicked: %v", rec)
+		}
+	}()
+	return loader(ctx, to, params)
+}
+
+// loaderDataKey is the context key under which a loader receives the slot
+// SetLoaderData fills.
+type loaderDataKey struct{}
+
+// loaderDataSlot holds the data one loader published.
+type loaderDataSlot struct {
+	data any
+	set  bool
+}
+
+// SetLoaderData publishes data from a Loader to its route's component, which
+// reads it with RouteContext.LoaderData once the navigation commits. ctx must
+// be the context the loader received; otherwise the call does nothing.
+func SetLoaderData(ctx context.Context, data any) {
+	if slot, ok := ctx.Value(loaderDataKey{}).(*loaderDataSlot); ok {
+		slot.data = data
+		slot.set = true
+	}
+}
@@ -0,0 +1,164 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// renderLog records the pathnames committed to a router's location state.
+type renderLog struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (l *renderLog) add(loc Location) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.paths = append(l.paths, loc.Pathname)
+}
+
+func (l *renderLog) get() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.paths...)
+}
+
+// emptyComponent renders nothing; the outlet ignores routes it returns nil for.
+func emptyComponent(props ...any) interface{} { return nil }
+
+// waitFor polls cond until it holds or a second passes.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestNavigateInterruptedBySecondNavigation(t *testing.T) {
+	release := make(chan struct{})
+	slowCancelled := make(chan struct{})
+	slow := Route("/slow", emptyComponent)
+	slow.Loader = func(ctx context.Context, to Location, params map[string]string) error {
+		select {
+		case <-ctx.Done():
+			close(slowCancelled)
+		case <-time.After(time.Second):
+		}
+		<-release
+		return nil
+	}
+	fast := Route("/fast", emptyComponent)
+
+	restoreURL(t)
+	r := New([]*RouteDefinition{slow, fast}, nil)
+	var log renderLog
+	r.locationState.Subscribe(log.add)
+	start := r.Location().Pathname
+
+	r.Navigate("/slow")
+	if got := r.Location().Pathname; got != start {
+		t.Fatalf("location changed before the loader finished: %q", got)
+	}
+
+	r.Navigate("/fast")
+	select {
+	case <-slowCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the slow loader's context was not cancelled")
+	}
+	close(release)
+	time.Sleep(10 * time.Millisecond)
+
+	if got := log.get(); len(got) != 1 || got[0] != "/fast" {
+		t.Fatalf("expected only /fast to render, got %v", got)
+	}
+	if got := r.Location().Pathname; got != "/fast" {
+		t.Errorf("location = %q, want /fast", got)
+	}
+	// The in-memory history is only kept outside the browser
+	if r.navigateWASM == nil && (len(r.history) != 2 || r.history[1].Pathname != "/fast") {
+		t.Errorf("cancelled navigation should not add history entries, got %v", r.history)
+	}
+}
+
+func TestNavigateLaterLoaderFinishingFirstWins(t *testing.T) {
+	releaseA := make(chan struct{})
+	a := Route("/a", emptyComponent)
+	a.Loader = func(ctx context.Context, to Location, params map[string]string) error {
+		<-releaseA
+		return nil
+	}
+	b := Route("/b/:id", emptyComponent)
+	loadedID := make(chan string, 1)
+	b.Loader = func(ctx context.Context, to Location, params map[string]string) error {
+		loadedID <- params["id"]
+		return nil
+	}
+
+	restoreURL(t)
+	r := New([]*RouteDefinition{a, b}, nil)
+	var log renderLog
+	r.locationState.Subscribe(log.add)
+
+	r.Navigate("/a")
+	r.Navigate("/b/7")
+	waitFor(t, func() bool { return len(log.get()) == 1 })
+	close(releaseA)
+	time.Sleep(10 * time.Millisecond)
+
+	if got := log.get(); len(got) != 1 || got[0] != "/b/7" {
+		t.Fatalf("expected only /b/7 to render, got %v", got)
+	}
+	if id := <-loadedID; id != "7" {
+		t.Errorf("loader params id = %q, want 7", id)
+	}
+}
+
+func TestNavigateLoaderErrorAbandonsNavigation(t *testing.T) {
+	var parentCalls atomic.Int32
+	child := Route("/child", emptyComponent)
+	child.Loader = func(ctx context.Context, to Location, params map[string]string) error {
+		return errors.New("forbidden")
+	}
+	parent := Route("/parent", emptyComponent, child)
+	parent.Loader = func(ctx context.Context, to Location, params map[string]string) error {
+		parentCalls.Add(1)
+		return nil
+	}
+
+	restoreURL(t)
+	r := New([]*RouteDefinition{parent}, nil)
+	var log renderLog
+	r.locationState.Subscribe(log.add)
+	start := r.Location().Pathname
+
+	r.Navigate("/parent/child")
+	time.Sleep(10 * time.Millisecond)
+
+	if calls := parentCalls.Load(); calls != 1 {
+		t.Errorf("parent loader should run before the child's, calls = %d", calls)
+	}
+	if got := log.get(); len(got) != 0 {
+		t.Errorf("failed loader should not commit, rendered %v", got)
+	}
+	if got := r.Location().Pathname; got != start {
+		t.Errorf("location = %q, want %q", got, start)
+	}
+}
+
+func TestNavigateWithoutLoadersIsSynchronous(t *testing.T) {
+	restoreURL(t)
+	r := New([]*RouteDefinition{Route("/plain", emptyComponent)}, nil)
+	r.Navigate("/plain")
+	if got := r.Location().Pathname; got != "/plain" {
+		t.Fatalf("location = %q, want /plain immediately", got)
+	}
+}
@@ -0,0 +1,93 @@
+package router
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// scrollToAnchorFn scrolls the element with the given id into view.
+var scrollToAnchorFn = scrollToAnchor
+
+// locationKeySeq generates the unique Key of each history entry.
+var locationKeySeq uint64
+
+// newLocationKey returns a key not used by any earlier history entry.
+func newLocationKey() string {
+	return strconv.FormatUint(atomic.AddUint64(&locationKeySeq, 1), 36)
+}
+
+// parseLocation splits a URL path such as "/search?q=go#results" into a
+// Location with Pathname, Search and Hash populated. Search and Hash keep
+// their leading "?" and "#", mirroring window.location.
+func parseLocation(rawURL string, state any) Location {
+	loc := Location{State: state}
+	rest := rawURL
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		loc.Hash = rest[i:]
+		rest = rest[:i]
+	}
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		loc.Search = rest[i:]
+		rest = rest[:i]
+	}
+	loc.Pathname = rest
+	return loc
+}
+
+// nextLocation builds the location a navigation to path leads to, with a
+// fresh history key and the modal background attached when applicable.
+func (r *Router) nextLocation(path string, options NavigateOptions) Location {
+	loc := parseLocation(path, options.State)
+	loc.Key = newLocationKey()
+	if loc.Pathname == "" {
+		// "?q=1" or "#section" stays on the current page
+		current := r.Location()
+		loc.Pathname = current.Pathname
+		if loc.Search == "" && strings.HasPrefix(path, "#") {
+			loc.Search = current.Search
+		}
+	}
+	return r.withBackground(loc)
+}
+
+// Query returns the search parameters of the location. It is parsed from
+// Search on each call, so it always matches the URL; malformed pairs are
+// skipped. Location stays comparable with == because the parsed form is not
+// stored.
+func (l Location) Query() url.Values {
+	query, _ := url.ParseQuery(strings.TrimPrefix(l.Search, "?"))
+	return query
+}
+
+// HashOptions configures UseHash.
+type HashOptions struct {
+	// NoScroll disables scrolling the element whose id matches the hash into
+	// view after each navigation.
+	NoScroll bool
+}
+
+// UseHash returns a signal holding the current location hash, including its
+// leading "#", updated on every navigation and history change. Unless
+// opts.NoScroll is set, the element whose id matches the hash is scrolled
+// into view whenever the hash changes. The subscription ends when the
+// current cleanup scope is disposed.
+func (r *Router) UseHash(opts ...HashOptions) reactivity.Signal[string] {
+	var o HashOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	hash := reactivity.CreateSignal(r.Location().Hash)
+	unsubscribe := r.locationState.subscribe(func(loc Location) {
+		changed := hash.Get() != loc.Hash
+		hash.Set(loc.Hash)
+		if changed && !o.NoScroll && loc.Hash != "" {
+			scrollToAnchorFn(strings.TrimPrefix(loc.Hash, "#"))
+		}
+	})
+	reactivity.RegisterCleanup(unsubscribe)
+	return hash
+}
@@ -0,0 +1,223 @@
+package router
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+func newLocationTestRouter(t *testing.T) *Router {
+	restoreURL(t)
+	component := func(props ...any) interface{} { return nil }
+	return New([]*RouteDefinition{
+		Route("/", component),
+		Route("/search", component),
+		Route("/docs", component),
+	}, nil)
+}
+
+func TestParseLocation(t *testing.T) {
+	loc := parseLocation("/search?q=go&tag=a&tag=b#results", "st")
+	if loc.Pathname != "/search" || loc.Search != "?q=go&tag=a&tag=b" || loc.Hash != "#results" || loc.State != "st" {
+		t.Fatalf("unexpected location %+v", loc)
+	}
+	want := map[string][]string{"q": {"go"}, "tag": {"a", "b"}}
+	if got := loc.Query(); !reflect.DeepEqual(map[string][]string(got), want) {
+		t.Errorf("Query() = %v, want %v", got, want)
+	}
+
+	bare := parseLocation("/docs", nil)
+	if bare.Search != "" || bare.Hash != "" || len(bare.Query()) != 0 {
+		t.Errorf("expected no search or hash, got %+v", bare)
+	}
+}
+
+func TestNavigatePopulatesLocation(t *testing.T) {
+	router := newLocationTestRouter(t)
+	initialKey := router.Location().Key
+
+	router.Navigate("/search?q=go&tag=a&tag=b#results", NavigateOptions{State: 42})
+	loc := router.Location()
+	if loc.Pathname != "/search" {
+		t.Errorf("Pathname = %q, want /search", loc.Pathname)
+	}
+	if loc.Search != "?q=go&tag=a&tag=b" {
+		t.Errorf("Search = %q", loc.Search)
+	}
+	if got := loc.Query()["tag"]; !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Query()[tag] = %v", got)
+	}
+	if loc.Hash != "#results" {
+		t.Errorf("Hash = %q, want #results", loc.Hash)
+	}
+	if loc.State != 42 {
+		t.Errorf("State = %v, want 42", loc.State)
+	}
+	if loc.Key == "" || loc.Key == initialKey {
+		t.Errorf("expected a fresh key, got %q (initial %q)", loc.Key, initialKey)
+	}
+	if route, _ := router.Match(loc.Pathname); route == nil {
+		t.Error("expected the pathname without query to match /search")
+	}
+}
+
+func TestNavigateReplaceUpdatesQuery(t *testing.T) {
+	router := newLocationTestRouter(t)
+	start := router.Location()
+	router.Navigate("/search?q=go")
+	pushedKey := router.Location().Key
+	depth := len(router.history)
+
+	router.Navigate("/search?q=wasm", NavigateOptions{Replace: true})
+	loc := router.Location()
+	if len(router.history) != depth {
+		t.Errorf("replace should not add a history entry: %d -> %d", depth, len(router.history))
+	}
+	if loc.Query().Get("q") != "wasm" {
+		t.Errorf("Query().Get(q) = %q, want wasm", loc.Query().Get("q"))
+	}
+	if loc.Key == pushedKey {
+		t.Error("replaced entry should get a new key")
+	}
+
+	goBack(t, router)
+	if loc := router.Location(); loc.Pathname != start.Pathname || loc.Search != start.Search {
+		t.Errorf("Back should skip the replaced entry, got %+v", loc)
+	}
+}
+
+func TestNavigateHashOnlyKeepsPath(t *testing.T) {
+	router := newLocationTestRouter(t)
+	router.Navigate("/search?q=go")
+	router.Navigate("#top")
+
+	loc := router.Location()
+	if loc.Pathname != "/search" || loc.Search != "?q=go" || loc.Hash != "#top" {
+		t.Errorf("expected hash navigation to keep path and query, got %+v", loc)
+	}
+}
+
+func TestBackRestoresLocationFields(t *testing.T) {
+	router := newLocationTestRouter(t)
+	router.Navigate("/search?q=go#results", NavigateOptions{State: "first"})
+	first := router.Location()
+	router.Navigate("/docs#intro")
+
+	goBack(t, router)
+	if loc := router.Location(); loc != first {
+		t.Errorf("Back should restore %+v, got %+v", first, loc)
+	}
+}
+
+func TestUseHash(t *testing.T) {
+	router := newLocationTestRouter(t)
+	var scrolled []string
+	prevScroll := scrollToAnchorFn
+	scrollToAnchorFn = func(id string) { scrolled = append(scrolled, id) }
+	defer func() { scrollToAnchorFn = prevScroll }()
+
+	scope := reactivity.NewCleanupScope(nil)
+	prevScope := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	hash := router.UseHash()
+	quiet := router.UseHash(HashOptions{NoScroll: true})
+	reactivity.SetCurrentCleanupScope(prevScope)
+
+	router.Navigate("/docs#intro")
+	if hash.Get() != "#intro" || quiet.Get() != "#intro" {
+		t.Errorf("expected #intro, got %q and %q", hash.Get(), quiet.Get())
+	}
+	router.Navigate("/docs#api")
+	router.Navigate("/docs")
+	goBack(t, router)
+	if hash.Get() != "#api" {
+		t.Errorf("expected hash to follow Back, got %q", hash.Get())
+	}
+
+	if want := []string{"intro", "api", "api"}; !reflect.DeepEqual(scrolled, want) {
+		t.Errorf("scrolled to %v, want %v", scrolled, want)
+	}
+
+	scope.Dispose()
+	router.Navigate("/docs#later")
+	if hash.Get() != "#api" {
+		t.Errorf("hash should stop updating after dispose, got %q", hash.Get())
+	}
+}
+
+func TestHistoryIndexAndCanGoBack(t *testing.T) {
+	router := newLocationTestRouter(t)
+	if router.CanGoBack().Get() || router.HistoryIndex().Get() != 0 {
+		t.Fatalf("entry the app opened at: CanGoBack %v, HistoryIndex %d", router.CanGoBack().Get(), router.HistoryIndex().Get())
+	}
+
+	router.Navigate("/search")
+	router.Navigate("/docs")
+	if !router.CanGoBack().Get() || router.HistoryIndex().Get() != 2 {
+		t.Errorf("after two pushes: CanGoBack %v, HistoryIndex %d", router.CanGoBack().Get(), router.HistoryIndex().Get())
+	}
+	router.Navigate("/docs#api", NavigateOptions{Replace: true})
+	if router.HistoryIndex().Get() != 2 {
+		t.Errorf("replace moved HistoryIndex to %d", router.HistoryIndex().Get())
+	}
+
+	goBack(t, router)
+	if !router.CanGoBack().Get() || router.HistoryIndex().Get() != 1 {
+		t.Errorf("after Back: CanGoBack %v, HistoryIndex %d", router.CanGoBack().Get(), router.HistoryIndex().Get())
+	}
+	goBack(t, router)
+	if router.CanGoBack().Get() || router.HistoryIndex().Get() != 0 {
+		t.Errorf("back at the first entry: CanGoBack %v, HistoryIndex %d", router.CanGoBack().Get(), router.HistoryIndex().Get())
+	}
+}
+
+func TestPreviousLocation(t *testing.T) {
+	router := newLocationTestRouter(t)
+	if prev := router.PreviousLocation().Get(); prev != nil {
+		t.Fatalf("previous location of the first entry = %+v, want nil", prev)
+	}
+	if UsePreviousLocation() != router.PreviousLocation() {
+		t.Error("UsePreviousLocation did not return the current router's signal")
+	}
+
+	router.Navigate("/search?q=go#results")
+	router.Navigate("/docs")
+	prev := router.PreviousLocation().Get()
+	if prev == nil || prev.Pathname != "/search" || prev.Search != "?q=go" || prev.Hash != "#results" {
+		t.Fatalf("previous location = %+v, want /search?q=go#results", prev)
+	}
+
+	// Replacing keeps the location the entry was entered from
+	router.Navigate("/docs#api", NavigateOptions{Replace: true})
+	if prev := router.PreviousLocation().Get(); prev == nil || prev.Pathname != "/search" {
+		t.Errorf("previous location after a replace = %+v, want /search", prev)
+	}
+
+	goBack(t, router)
+	if prev := router.PreviousLocation().Get(); prev == nil || prev.Pathname != "/" {
+		t.Errorf("previous location after Back = %+v, want /", prev)
+	}
+	goBack(t, router)
+	if prev := router.PreviousLocation().Get(); prev != nil {
+		t.Errorf("previous location back at the first entry = %+v, want nil", prev)
+	}
+}
+
+func TestBackOrFallsBackWithoutHistory(t *testing.T) {
+	router := newLocationTestRouter(t)
+
+	// Opened at this entry: there is nothing to go back to
+	router.backOr("/search")
+	if got := router.Location().Pathname; got != "/search" {
+		t.Fatalf("location after backOr on the first entry = %q, want the fallback /search", got)
+	}
+
+	router.Navigate("/docs")
+	key := router.Location().Key
+	router.backOr("/")
+	waitFor(t, func() bool { return router.Location().Key != key })
+	if got := router.Location().Pathname; got != "/search" || router.HistoryIndex().Get() != 1 {
+		t.Errorf("backOr with history went to %q at index %d, want back to /search", got, router.HistoryIndex().Get())
+	}
+}
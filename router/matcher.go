@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"regexp"
 	"strings"
 )
@@ -12,9 +13,19 @@ type MatcherFunc func(path string) (isMatch bool, params map[string]string)
 // RouteDefinition encapsulates all information about a single route.
 type RouteDefinition struct {
 	Path         string
-	Component    func(props ...any) interface{} // Will be more specific with gomponents.Node later
+	Component    func(props ...any) interface{} // See Comp for the typed form
 	Children     []*RouteDefinition
 	MatchFilters map[string]any // Parameter validation filters (regex or function)
+	Modal        bool           // Render over the previous route instead of replacing it (see ModalRoute)
+	// Loader runs before a navigation to this route (or one of its children)
+	// is committed, e.g. to fetch data or check access. ctx is cancelled
+	// when a newer navigation starts; returning an error abandons the
+	// navigation. Data for the component can be passed on with
+	// SetLoaderData. See Router.Navigate.
+	Loader func(ctx context.Context, to Location, params map[string]string) error
+	// Meta holds arbitrary data about the route, such as its "breadcrumb"
+	// label, for components that read the matched chain (see UseMatches).
+	Meta map[string]any
 
 	// Internal pre-compiled matcher for performance.
 	matcher MatcherFunc
@@ -0,0 +1,88 @@
+package router
+
+import (
+	"reflect"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+// Match is one level of the route chain matched for a path, from the
+// top-level route down to the deepest child.
+type Match struct {
+	Route *RouteDefinition
+	// Pathname is the part of the path matched up to and including this
+	// route, e.g. "/users/42" for the "/:id" child of "/users".
+	Pathname string
+	// Params holds the parameters captured by the whole chain.
+	Params map[string]string
+}
+
+// Current returns the most recently created router, or nil.
+func Current() *Router {
+	return currentRouter
+}
+
+// Matches returns the chain of routes matching path, parent first, or nil
+// when no route matches. Unlike Match it does not change the router's
+// current route.
+func (r *Router) Matches(path string) []Match {
+	route, params := r.matchRecursive(path, r.routes, make(map[string]string))
+	if route == nil {
+		return nil
+	}
+	hierarchy := findRouteHierarchy(r.routes, path, route)
+	segments := splitPath(path)
+	matches := make([]Match, 0, len(hierarchy))
+	consumed := 0
+	for _, rd := range hierarchy {
+		consumed += routeSegmentCount(rd.Path, len(segments)-consumed)
+		matches = append(matches, Match{
+			Route:    rd,
+			Pathname: "/" + joinSegments(segments[:consumed]),
+			Params:   params,
+		})
+	}
+	return matches
+}
+
+// routeSegmentCount returns how many of the remaining path segments a route
+// path consumes; a wildcard consumes all of them.
+func routeSegmentCount(routePath string, remaining int) int {
+	n := 0
+	for _, segment := range splitPath(routePath) {
+		if segment[0] == '*' {
+			return remaining
+		}
+		n++
+	}
+	return min(n, remaining)
+}
+
+// UseMatches returns a signal holding the route chain matched for the
+// current location (see Matches), updated on every navigation and history
+// change. The subscription ends when the current cleanup scope is disposed.
+func (r *Router) UseMatches() reactivity.Signal[[]Match] {
+	matches := reactivity.CreateSignal(r.Matches(r.Location().Pathname))
+	unsubscribe := r.locationState.subscribe(func(loc Location) {
+		next := r.Matches(loc.Pathname)
+		if !sameMatches(matches.Peek(), next) {
+			matches.Set(next)
+		}
+	})
+	reactivity.RegisterCleanup(unsubscribe)
+	return matches
+}
+
+// sameMatches reports whether two chains hold the same routes, paths and
+// params. Routes are compared by identity.
+func sameMatches(a, b []Match) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Route != b[i].Route || a[i].Pathname != b[i].Pathname {
+			return false
+		}
+	}
+	return len(a) == 0 || reflect.DeepEqual(a[0].Params, b[0].Params)
+}
@@ -0,0 +1,70 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/ozanturksever/uiwgo/reactivity"
+)
+
+func newMatchesTestRouter(t *testing.T) *Router {
+	restoreURL(t)
+	component := func(props ...any) interface{} { return nil }
+	return New([]*RouteDefinition{
+		Route("/", component),
+		Route("/users", component,
+			Route("/:userId", component,
+				Route("/posts/:postId", component),
+			),
+		),
+	}, nil)
+}
+
+func TestMatchesReturnsRouteChain(t *testing.T) {
+	router := newMatchesTestRouter(t)
+
+	matches := router.Matches("/users/42/posts/7")
+	want := []struct{ route, pathname string }{
+		{"/users", "/users"},
+		{"/:userId", "/users/42"},
+		{"/posts/:postId", "/users/42/posts/7"},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("got %d matches, want %d", len(matches), len(want))
+	}
+	for i, w := range want {
+		if matches[i].Route.Path != w.route || matches[i].Pathname != w.pathname {
+			t.Errorf("match %d = %s at %s, want %s at %s", i, matches[i].Route.Path, matches[i].Pathname, w.route, w.pathname)
+		}
+	}
+	if p := matches[0].Params; p["userId"] != "42" || p["postId"] != "7" {
+		t.Errorf("Params = %v", p)
+	}
+
+	if matches := router.Matches("/nowhere"); matches != nil {
+		t.Errorf("expected no matches, got %v", matches)
+	}
+}
+
+func TestUseMatchesFollowsNavigation(t *testing.T) {
+	router := newMatchesTestRouter(t)
+	scope := reactivity.NewCleanupScope(nil)
+	prev := reactivity.GetCurrentCleanupScope()
+	reactivity.SetCurrentCleanupScope(scope)
+	matches := router.UseMatches()
+	reactivity.SetCurrentCleanupScope(prev)
+
+	if got := matches.Get(); len(got) != 1 || got[0].Pathname != "/" {
+		t.Fatalf("initial matches = %v", got)
+	}
+
+	router.Navigate("/users/42")
+	if got := matches.Get(); len(got) != 2 || got[1].Pathname != "/users/42" {
+		t.Errorf("matches after navigation = %v", got)
+	}
+
+	scope.Dispose()
+	router.Navigate("/users")
+	if got := matches.Get(); len(got) != 2 {
+		t.Errorf("matches changed after the scope was disposed: %v", got)
+	}
+}
@@ -0,0 +1,92 @@
+package router
+
+// ModalRoute creates a RouteDefinition that renders as a modal on top of the
+// route the user navigated from. The previous route stays mounted underneath
+// and the browser Back button closes the modal instead of leaving the page.
+//
+// When a modal route is loaded directly (deep link), the router renders the
+// closest non-modal ancestor path (e.g. "/products/42" for
+// "/products/42/quickview") underneath and inserts it into history, so Back
+// still only closes the modal.
+func ModalRoute(path string, component func(props ...any) interface{}) *RouteDefinition {
+	rd := Route(path, component)
+	rd.Modal = true
+	return rd
+}
+
+// IsModalOpen reports whether the current location is a modal route rendered
+// over a background location.
+func (r *Router) IsModalOpen() bool {
+	return r.locationState.Get().Background != nil
+}
+
+// Back navigates one step back in history. In the browser this defers to
+// window.history.back() and the resulting popstate restores the previous
+// location; elsewhere the router's in-memory history is used.
+func (r *Router) Back() {
+	if r.backWASM != nil {
+		r.backWASM()
+		return
+	}
+	if len(r.history) < 2 {
+		return
+	}
+	r.history = r.history[:len(r.history)-1]
+	r.restoreEntry(r.history[len(r.history)-1])
+	r.locationState.Set(r.history[len(r.history)-1])
+}
+
+// isModalPath reports whether path resolves to a modal route.
+func (r *Router) isModalPath(path string) bool {
+	route, _ := r.matchRecursive(path, r.routes, make(map[string]string))
+	return route != nil && route.Modal
+}
+
+// withBackground attaches the background location to loc when it targets a
+// modal route and is entered from within the app. Navigating from one modal
+// to another keeps the original background so Back returns to the page.
+func (r *Router) withBackground(loc Location) Location {
+	loc.Background = nil
+	if !r.isModalPath(loc.Pathname) {
+		return loc
+	}
+	current := r.locationState.Get()
+	if current.Background != nil {
+		loc.Background = current.Background
+		return loc
+	}
+	if current.Pathname == "" || r.isModalPath(current.Pathname) {
+		return loc
+	}
+	bg := current
+	loc.Background = &bg
+	return loc
+}
+
+// resolveInitialLocation expands the location the app was loaded with into
+// the history entries it should occupy. A deep link to a modal route becomes
+// two entries: the background page followed by the modal on top of it. Any
+// other location is returned as a single entry.
+func (r *Router) resolveInitialLocation(loc Location) []Location {
+	if loc.Background != nil || !r.isModalPath(loc.Pathname) {
+		return []Location{loc}
+	}
+	bg := Location{Pathname: r.deepLinkBackground(loc.Pathname), Key: newLocationKey()}
+	loc.Background = &bg
+	return []Location{bg, loc}
+}
+
+// deepLinkBackground finds the nearest ancestor path of a modal path that
+// resolves to a non-modal route, falling back to "/".
+func (r *Router) deepLinkBackground(path string) string {
+	segments := splitPath(path)
+	for len(segments) > 0 {
+		segments = segments[:len(segments)-1]
+		candidate := "/" + joinSegments(segments)
+		route, _ := r.matchRecursive(candidate, r.routes, make(map[string]string))
+		if route != nil && !route.Modal {
+			return candidate
+		}
+	}
+	return "/"
+}
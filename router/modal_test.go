@@ -0,0 +1,79 @@
+package router
+
+import "testing"
+
+func newModalTestRouter(t *testing.T) *Router {
+	restoreURL(t)
+	component := func(props ...any) interface{} { return nil }
+	routes := []*RouteDefinition{
+		Route("/", component),
+		Route("/products", component),
+		Route("/products/:id", component),
+		ModalRoute("/products/:id/quickview", component),
+		ModalRoute("/login", component),
+	}
+	return New(routes, nil)
+}
+
+func TestModalRouteInAppNavigationKeepsBackground(t *testing.T) {
+	router := newModalTestRouter(t)
+	router.Navigate("/products", NavigateOptions{})
+	router.Navigate("/products/42/quickview", NavigateOptions{})
+
+	loc := router.Location()
+	if loc.Pathname != "/products/42/quickview" {
+		t.Fatalf("expected modal pathname, got %q", loc.Pathname)
+	}
+	if loc.Background == nil || loc.Background.Pathname != "/products" {
+		t.Fatalf("expected background /products, got %+v", loc.Background)
+	}
+	if !router.IsModalOpen() {
+		t.Error("expected modal to be open")
+	}
+
+	goBack(t, router)
+	loc = router.Location()
+	if loc.Pathname != "/products" || loc.Background != nil {
+		t.Errorf("expected Back to close the modal and return to /products, got %+v", loc)
+	}
+	if router.IsModalOpen() {
+		t.Error("expected modal to be closed after Back")
+	}
+}
+
+func TestModalRouteToModalKeepsOriginalBackground(t *testing.T) {
+	router := newModalTestRouter(t)
+	router.Navigate("/products", NavigateOptions{})
+	router.Navigate("/products/1/quickview", NavigateOptions{})
+	router.Navigate("/login", NavigateOptions{})
+
+	loc := router.Location()
+	if loc.Background == nil || loc.Background.Pathname != "/products" {
+		t.Fatalf("expected background /products to be kept, got %+v", loc.Background)
+	}
+}
+
+func TestModalRouteDeepLinkRendersBackgroundFirst(t *testing.T) {
+	router := newModalTestRouter(t)
+
+	entries := router.resolveInitialLocation(Location{Pathname: "/products/42/quickview"})
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 history entries for modal deep link, got %d", len(entries))
+	}
+	if entries[0].Pathname != "/products/42" {
+		t.Errorf("expected background /products/42, got %q", entries[0].Pathname)
+	}
+	if entries[1].Background == nil || entries[1].Background.Pathname != "/products/42" {
+		t.Errorf("expected modal entry to carry its background, got %+v", entries[1].Background)
+	}
+
+	entries = router.resolveInitialLocation(Location{Pathname: "/login"})
+	if len(entries) != 2 || entries[0].Pathname != "/" {
+		t.Errorf("expected top-level modal deep link to fall back to /, got %+v", entries)
+	}
+
+	entries = router.resolveInitialLocation(Location{Pathname: "/products"})
+	if len(entries) != 1 || entries[0].Background != nil {
+		t.Errorf("expected non-modal location to be a single entry, got %+v", entries)
+	}
+}
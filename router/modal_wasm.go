@@ -0,0 +1,126 @@
+//go:build js && wasm
+
+package router
+
+import (
+	"bytes"
+	"syscall/js"
+
+	"github.com/ozanturksever/logutil"
+	dom "honnef.co/go/js/dom/v2"
+)
+
+// historyBackgroundKey is the history.state key holding a modal's background location.
+const historyBackgroundKey = "__routerBackground"
+
+// historyEntryKey is the history.state key holding the entry's Location.Key.
+const historyEntryKey = "__routerKey"
+
+// modalView tracks the overlay host of an open modal route.
+type modalView struct {
+	host       dom.Element
+	background string
+}
+
+// modalViews holds the open modal per router; closing it removes the entry.
+var modalViews = make(map[*Router]*modalView)
+
+// locationURL returns the path, search and hash of loc as a single URL string.
+func locationURL(loc Location) string {
+	return loc.Pathname + loc.Search + loc.Hash
+}
+
+// historyStateValue converts a location into the value stored in history.state.
+// It records the entry key and, for modal locations, the background location
+// so both can be restored on popstate.
+func historyStateValue(loc Location) js.Value {
+	state := map[string]interface{}{
+		historyEntryKey: loc.Key,
+	}
+	if loc.Background != nil {
+		state[historyBackgroundKey] = map[string]interface{}{
+			"pathname": loc.Background.Pathname,
+			"search":   loc.Background.Search,
+			"hash":     loc.Background.Hash,
+		}
+	}
+	if loc.State != nil {
+		state["state"] = loc.State
+	}
+	return js.ValueOf(state)
+}
+
+// backgroundFromHistoryState extracts the background location stored by
+// historyStateValue, or nil when the entry is not a modal.
+func backgroundFromHistoryState(state js.Value) *Location {
+	if state.Type() != js.TypeObject {
+		return nil
+	}
+	bg := state.Get(historyBackgroundKey)
+	if bg.Type() != js.TypeObject {
+		return nil
+	}
+	loc := parseLocation(bg.Get("pathname").String()+bg.Get("search").String()+bg.Get("hash").String(), nil)
+	return &loc
+}
+
+// keyFromHistoryState returns the entry key stored by historyStateValue, or
+// "" for entries the router did not create.
+func keyFromHistoryState(state js.Value) string {
+	if state.Type() != js.TypeObject {
+		return ""
+	}
+	if key := state.Get(historyEntryKey); key.Type() == js.TypeString {
+		return key.String()
+	}
+	return ""
+}
+
+// renderModal renders a modal route into an overlay host placed after the
+// router outlet, leaving the outlet content untouched.
+func renderModal(router *Router, location Location, route *RouteDefinition, params map[string]string) {
+	node := buildComponentHierarchy(router, location, route, params)
+	if node == nil {
+		logutil.Log("Failed to build modal component hierarchy")
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := node.Render(&buf); err != nil {
+		logutil.Logf("Error rendering modal to HTML: %v", err)
+		return
+	}
+
+	view, ok := modalViews[router]
+	if !ok {
+		outlet, isElement := router.outlet.(dom.Element)
+		if !isElement {
+			logutil.Logf("Outlet is not a DOM element, got: %T", router.outlet)
+			return
+		}
+		host := dom.GetWindow().Document().CreateElement("div")
+		host.SetAttribute("data-router-modal", "")
+		if parent := outlet.ParentNode(); parent != nil {
+			parent.InsertBefore(host, outlet.NextSibling())
+		} else {
+			outlet.AppendChild(host)
+		}
+		view = &modalView{host: host}
+		modalViews[router] = view
+	}
+	view.background = locationURL(*location.Background)
+	view.host.SetInnerHTML(buf.String())
+	logutil.Logf("Rendered modal route %s over %s", location.Pathname, view.background)
+}
+
+// closeModal removes the modal overlay host of router, if any.
+func closeModal(router *Router) {
+	view, ok := modalViews[router]
+	if !ok {
+		return
+	}
+	if parent := view.host.ParentNode(); parent != nil {
+		parent.RemoveChild(view.host)
+	}
+	delete(modalViews, router)
+}
@@ -19,3 +19,21 @@ func A(href string, children ...any) any {
 		},
 	}
 }
+
+// BackLink creates a "back" link component that returns to the previous
+// history entry when the app navigated to the current one and navigates to
+// fallback otherwise. Like A, it returns a struct with Href and OnClick
+// fields outside the browser.
+func BackLink(fallback string, children ...any) any {
+	return struct {
+		Href    string
+		OnClick func()
+	}{
+		Href: fallback,
+		OnClick: func() {
+			if currentRouter != nil {
+				currentRouter.backOr(fallback)
+			}
+		},
+	}
+}
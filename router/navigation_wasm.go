@@ -30,3 +30,12 @@ func A(href string, children ...any) g.Node {
 	}
 	return html.A(nodes...)
 }
+
+// BackLink creates a "back" link, such as "Back to results", that returns to
+// the exact previous URL, filters included, when the app navigated to the
+// current page and navigates to fallback otherwise, e.g. after opening a
+// deep link. It renders an <a> with fallback as its href, so opening it in a
+// new tab leads there too.
+func BackLink(fallback string, children ...any) g.Node {
+	return A(fallback, append([]any{html.DataAttr("router-back", "true")}, children...)...)
+}
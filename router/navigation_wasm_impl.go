@@ -11,16 +11,10 @@ import (
 )
 
 // navigateWASMImpl handles navigation in WASM builds with proper history API integration.
-func (r *Router) navigateWASMImpl(path string, options NavigateOptions) {
+func (r *Router) navigateWASMImpl(newLocation Location, options NavigateOptions) {
 	window := dom.GetWindow()
 	if window == nil {
 		// Fallback for test environments where DOM is not available
-		newLocation := Location{
-			Pathname: path,
-			Search:   "",
-			Hash:     "",
-			State:    options.State,
-		}
 		r.locationState.Set(newLocation)
 		return
 	}
@@ -28,36 +22,19 @@ func (r *Router) navigateWASMImpl(path string, options NavigateOptions) {
 	history := window.History()
 	if history == nil {
 		// Fallback for test environments where History API is not available
-		newLocation := Location{
-			Pathname: path,
-			Search:   "",
-			Hash:     "",
-			State:    options.State,
-		}
 		r.locationState.Set(newLocation)
 		return
 	}
 
-	// Create a new Location with the given path
-	newLocation := Location{
-		Pathname: path,
-		Search:   "", // TODO: Parse search from path if needed
-		Hash:     "", // TODO: Parse hash from path if needed
-		State:    options.State,
-	}
+	rememberEntryState(r, newLocation)
 
-	// Push the new state to browser history
-	var stateValue js.Value
-	if options.State != nil {
-		// Convert Go state to JS value
-		stateValue = js.ValueOf(options.State)
+	// Use pushState (or replaceState) to record the new location in browser history
+	if options.Replace {
+		history.ReplaceState(historyStateValue(newLocation), "", locationURL(newLocation))
 	} else {
-		stateValue = js.Null()
+		history.PushState(historyStateValue(newLocation), "", locationURL(newLocation))
 	}
 
-	// Use pushState to add the new location to browser history
-	history.PushState(stateValue, "", path)
-
 	// Update the router's location state (this will trigger rendering)
 	r.locationState.Set(newLocation)
 }
@@ -169,6 +146,10 @@ func setupLinkClickHandling(router *Router) {
 		}
 
 		logutil.Logf("Intercepted navigation to %s", path)
+		if anchor.HasAttribute("data-router-back") {
+			router.backOr(path)
+			return
+		}
 		router.Navigate(path, NavigateOptions{})
 	})
 }
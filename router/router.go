@@ -2,6 +2,7 @@ package router
 
 import (
 	"github.com/ozanturksever/logutil"
+	"github.com/ozanturksever/uiwgo/reactivity"
 	"strings"
 )
 
@@ -11,7 +12,8 @@ var currentRouter *Router
 
 // NavigateOptions contains options for programmatic navigation.
 type NavigateOptions struct {
-	State any // State data to associate with the navigation
+	State   any  // State data to associate with the navigation
+	Replace bool // Replace the current history entry instead of pushing a new one
 }
 
 // Router holds a collection of route definitions and provides matching functionality.
@@ -25,27 +27,62 @@ type Router struct {
 	OnBeforeNavigate func(path string, options NavigateOptions)
 	OnAfterNavigate  func(path string, options NavigateOptions)
 	// WASM-specific navigation function
-	navigateWASM func(path string, options NavigateOptions)
+	navigateWASM func(location Location, options NavigateOptions)
+	// WASM-specific history back function
+	backWASM func()
+	// history holds visited locations for non-WASM builds, where there is no
+	// browser history to pop
+	history []Location
+	// nav tracks the current navigation so loaders of superseded ones are
+	// cancelled and never committed
+	nav navigationTracker
+	// params holds the params of the rendered route for RouteContext.Params
+	params reactivity.Signal[map[string]string]
+	// loaderData holds what the loaders of the committed navigation passed
+	// to SetLoaderData, by route
+	loaderData map[*RouteDefinition]any
+	// historyIndex and canGoBack back HistoryIndex and CanGoBack;
+	// entryIndexes holds the index of each entry by location key
+	historyIndex reactivity.Signal[int]
+	canGoBack    reactivity.Signal[bool]
+	entryIndexes map[string]int
+	// previous backs PreviousLocation; previousLocations holds the
+	// location each entry was entered from, by location key
+	previous          reactivity.Signal[*Location]
+	previousLocations map[string]*Location
+	// renderedURL is the URL currently rendered in the outlet, in the
+	// browser
+	renderedURL string
+	// entryStates keeps the Go state of history entries by location key,
+	// so popstate can restore Location.State without converting through JS
+	entryStates map[string]any
 }
 
 // New creates a new Router instance with the provided routes and outlet.
 // The outlet parameter is any to accommodate mocks for testing.
 func New(routes []*RouteDefinition, outlet any) *Router {
 	router := &Router{
-		routes:        routes,
-		outlet:        outlet,
-		locationState: NewLocationState(),
+		routes:            routes,
+		outlet:            outlet,
+		locationState:     NewLocationState(),
+		params:            reactivity.CreateSignal(map[string]string{}),
+		historyIndex:      reactivity.CreateSignal(0),
+		entryIndexes:      make(map[string]int),
+		previous:          reactivity.CreateSignal[*Location](nil),
+		previousLocations: make(map[string]*Location),
 	}
+	router.canGoBack = reactivity.CreateMemo(func() bool {
+		return router.historyIndex.Get() > 0
+	})
 	// Set this as the current router for navigation
 	currentRouter = router
 
 	// Set initial location to root path
-	router.locationState.Set(Location{
-		Pathname: "/",
-		Search:   "",
-		Hash:     "",
-		State:    nil,
-	})
+	initial := parseLocation("/", nil)
+	initial.Key = newLocationKey()
+	router.history = []Location{initial}
+	router.entryIndexes[initial.Key] = 0
+	router.locationState.Set(initial)
 
 	// Setup WASM-specific functionality if available
 	setupWASM(router)
@@ -188,6 +225,9 @@ func joinSegments(segments []string) string {
 
 // Route creates a new RouteDefinition with the specified path, component, and children.
 // This is a builder function for defining routes in a declarative way.
+// Components written against the positional props convention (child content
+// in props[0], params after it) keep working, but that convention is
+// deprecated: wrap a func(RouteContext) g.Node with Comp instead.
 func Route(path string, component func(props ...any) interface{}, children ...*RouteDefinition) *RouteDefinition {
 	rd := &RouteDefinition{
 		Path:         path,
@@ -215,7 +255,10 @@ func (r *Router) Params() map[string]string {
 }
 
 // Navigate performs programmatic navigation to the specified path.
-// It calls the un-exported navigate method on the router instance.
+// If the destination route or one of its parents has a Loader, the loaders
+// run first and the navigation is committed (history entry, Location and
+// render) only when they succeed and no newer navigation has started in the
+// meantime; otherwise it is committed immediately.
 func (r *Router) Navigate(path string, opts ...NavigateOptions) {
 	options := NavigateOptions{}
 	if len(opts) > 0 {
@@ -232,24 +275,57 @@ func (r *Router) navigate(path string, options NavigateOptions) {
 		r.OnBeforeNavigate(path, options)
 	}
 
-	// Use WASM-specific navigation if available
-	if r.navigateWASM != nil {
-		r.navigateWASM(path, options)
-	} else {
-		// Fallback for non-WASM builds
-		// Create a new Location with the given path
-		newLocation := Location{
-			Pathname: path,
-			Search:   "", // You might want to handle search and hash later
-			Hash:     "",
-			State:    options.State,
+	// Resolve the destination now, relative to the current location
+	newLocation := r.nextLocation(path, options)
+
+	r.runNavigation(newLocation, func() {
+		r.trackEntry(newLocation, options.Replace)
+		// Use WASM-specific navigation if available
+		if r.navigateWASM != nil {
+			r.navigateWASM(newLocation, options)
+		} else {
+			// Fallback for non-WASM builds
+			if options.Replace && len(r.history) > 0 {
+				r.history[len(r.history)-1] = newLocation
+			} else {
+				r.history = append(r.history, newLocation)
+			}
+			// Update the location state
+			r.locationState.Set(newLocation)
 		}
-		// Update the location state
-		r.locationState.Set(newLocation)
-	}
 
-	// Notify after navigation
-	if r.OnAfterNavigate != nil {
-		r.OnAfterNavigate(path, options)
+		// Notify after navigation
+		if r.OnAfterNavigate != nil {
+			r.OnAfterNavigate(path, options)
+		}
+	})
+}
+
+// findRouteHierarchy finds the complete route hierarchy from root to the matched route.
+// It returns a slice of RouteDefinition pointers representing the path from root to leaf.
+func findRouteHierarchy(routes []*RouteDefinition, originalPath string, targetRoute *RouteDefinition) []*RouteDefinition {
+	for _, route := range routes {
+		if route == targetRoute {
+			// Found the target route at this level
+			return []*RouteDefinition{route}
+		}
+
+		// Check if this route matches the beginning of the path
+		if route.matcher == nil {
+			route.matcher = compileMatcher(route)
+		}
+		isMatch, params := route.matcher(originalPath)
+		if isMatch {
+			// Calculate remaining path and search in children
+			remainingPath := calculateRemainingPath(originalPath, route.Path, params)
+			if len(route.Children) > 0 {
+				childHierarchy := findRouteHierarchy(route.Children, remainingPath, targetRoute)
+				if len(childHierarchy) > 0 {
+					// Found target in children, prepend this route
+					return append([]*RouteDefinition{route}, childHierarchy...)
+				}
+			}
+		}
 	}
+	return nil
 }
@@ -4,3 +4,6 @@ package router
 
 func setupWASM(router *Router) {
 }
+
+func scrollToAnchor(id string) {
+}
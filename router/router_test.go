@@ -87,7 +87,10 @@ func TestRouterLocationReturnsCurrentState(t *testing.T) {
 
 	// Verify that calling Location() initially returns a root path Location struct
 	initialLocation := router.Location()
-	expectedInitialLocation := Location{Pathname: "/", Search: "", Hash: "", State: nil}
+	if initialLocation.Key == "" {
+		t.Error("Expected initial location to have a history key")
+	}
+	expectedInitialLocation := Location{Pathname: "/", Search: "", Hash: "", State: nil, Key: initialLocation.Key}
 	if initialLocation != expectedInitialLocation {
 		t.Errorf("Expected initial location to be %+v, got %+v", expectedInitialLocation, initialLocation)
 	}
@@ -8,13 +8,15 @@ import (
 
 	"github.com/ozanturksever/logutil"
 	dom "honnef.co/go/js/dom/v2"
-	. "maragu.dev/gomponents"
 )
 
 // setupWASM initializes WASM-specific functionality for the router.
 func setupWASM(router *Router) {
 	// Set up WASM-specific navigation function
 	router.navigateWASM = router.navigateWASMImpl
+	router.backWASM = func() {
+		js.Global().Get("history").Call("back")
+	}
 
 	// Expose the router's location to JavaScript for testing
 	exposeRouterToJS(router)
@@ -46,6 +48,7 @@ func exposeRouterToJS(router *Router) {
 			"search":   router.locationState.Get().Search,
 			"hash":     router.locationState.Get().Hash,
 			"state":    router.locationState.Get().State,
+			"key":      router.locationState.Get().Key,
 		},
 		"Navigate": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
 			if len(args) < 1 {
@@ -62,7 +65,36 @@ func exposeRouterToJS(router *Router) {
 // renderLocation renders the appropriate component for the given location.
 // This implements the destructive-and-replace rendering strategy as specified in the design.
 // For nested routes, it composes parent and child components using the layout pattern.
+// Modal routes with a background location are rendered into an overlay host so the
+// background route stays mounted in the outlet underneath.
 func renderLocation(router *Router, location Location) {
+	if location.Background != nil {
+		if route, params := router.Match(location.Pathname); route != nil && route.Modal {
+			// Render the background first when it is not already on screen (deep link)
+			if router.renderedURL != locationURL(*location.Background) {
+				renderOutlet(router, *location.Background)
+			}
+			renderModal(router, location, route, params)
+			router.currentRoute = route
+			router.currentParams = params
+			return
+		}
+	}
+
+	// Closing a modal via Back returns to its background, which is still mounted
+	if view, open := modalViews[router]; open {
+		closeModal(router)
+		if view.background == locationURL(location) && router.renderedURL == view.background {
+			router.Match(location.Pathname)
+			return
+		}
+	}
+
+	renderOutlet(router, location)
+}
+
+// renderOutlet renders the route hierarchy for location into the router outlet.
+func renderOutlet(router *Router, location Location) {
 	currentPath := location.Pathname
 	logutil.Logf("Rendering location: %s", currentPath)
 
@@ -90,7 +122,7 @@ func renderLocation(router *Router, location Location) {
 	router.currentParams = params
 
 	// Build the component hierarchy for nested routes
-	componentNode := buildComponentHierarchy(router, currentPath, matchedRoute, params)
+	componentNode := buildComponentHierarchy(router, location, matchedRoute, params)
 	if componentNode == nil {
 		logutil.Log("Failed to build component hierarchy")
 		return
@@ -113,109 +145,75 @@ func renderLocation(router *Router, location Location) {
 		return
 	}
 
-	// Perform destructive-and-replace DOM update
-	outlet.SetInnerHTML(htmlString)
+	// Perform destructive-and-replace DOM update, morphing shared elements
+	swapOutlet(router, outlet, htmlString)
+	router.renderedURL = locationURL(location)
 	logutil.Log("DOM updated successfully")
 }
 
-// buildComponentHierarchy constructs the component hierarchy for nested routes.
-// It traverses the route tree from root to the matched route, composing parent
-// components with their child content according to the layout pattern.
-func buildComponentHierarchy(router *Router, originalPath string, matchedRoute *RouteDefinition, params map[string]string) Node {
-	// Find the route hierarchy from root to the matched route
-	routeHierarchy := findRouteHierarchy(router.routes, originalPath, matchedRoute)
-	if len(routeHierarchy) == 0 {
-		logutil.Log("No route hierarchy found")
-		return nil
-	}
-
-	logutil.Logf("Building component hierarchy with %d levels", len(routeHierarchy))
-
-	// Start from the deepest (matched) route and work backwards
-	var currentNode Node
-
-	// Render the deepest route first
-	deepestRoute := routeHierarchy[len(routeHierarchy)-1]
-	logutil.Logf("Rendering deepest component for route: %s", deepestRoute.Path)
-	componentResult := deepestRoute.Component(params)
-	if componentResult == nil {
-		logutil.Log("Deepest component function returned nil")
-		return nil
+// performInitialRender renders the initial component based on the current browser URL.
+func performInitialRender(router *Router) {
+	window := dom.GetWindow()
+	location := browserLocation(router)
+	// A deep link to a modal route occupies two history entries: the background
+	// page and the modal on top, so Back closes the modal instead of leaving.
+	entries := router.resolveInitialLocation(location)
+	if history := window.History(); history != nil {
+		history.ReplaceState(historyStateValue(entries[0]), "", locationURL(entries[0]))
+		for _, entry := range entries[1:] {
+			history.PushState(historyStateValue(entry), "", locationURL(entry))
+		}
 	}
-
-	var ok bool
-	currentNode, ok = componentResult.(Node)
-	if !ok {
-		logutil.Logf("Deepest component did not return a gomponents.Node, got: %T", componentResult)
-		return nil
+	// Entries of a deep link start the session; Back from a deep-linked
+	// modal stays in the app
+	for i, entry := range entries {
+		router.entryIndexes[entry.Key] = i
 	}
+	location = entries[len(entries)-1]
+	router.runNavigation(location, func() {
+		router.restoreEntry(location)
+		// Update the router's location state to match the current URL
+		router.locationState.Set(location)
+		renderLocation(router, location)
+	})
+}
 
-	// Work backwards through parent routes, passing child content as props
-	for i := len(routeHierarchy) - 2; i >= 0; i-- {
-		parentRoute := routeHierarchy[i]
-		logutil.Logf("Composing parent component for route: %s", parentRoute.Path)
-
-		// Call parent component with child node as first argument
-		parentResult := parentRoute.Component(currentNode, params)
-		if parentResult == nil {
-			logutil.Logf("Parent component for route %s returned nil", parentRoute.Path)
-			return nil
-		}
-
-		parentNode, ok := parentResult.(Node)
-		if !ok {
-			logutil.Logf("Parent component for route %s did not return a gomponents.Node, got: %T", parentRoute.Path, parentResult)
-			return nil
-		}
-
-		currentNode = parentNode
+// browserLocation reads the browser URL and the current history entry into a
+// Location, restoring the entry's key, state and modal background.
+func browserLocation(router *Router) Location {
+	current := dom.GetWindow().Location()
+	historyState := js.Global().Get("history").Get("state")
+	location := parseLocation(current.Pathname()+current.Search()+current.Hash(), nil)
+	location.Background = backgroundFromHistoryState(historyState)
+	if key := keyFromHistoryState(historyState); key != "" {
+		location.Key = key
+		location.State = router.entryStates[key]
+	} else {
+		location.Key = newLocationKey()
 	}
-
-	return currentNode
+	return location
 }
 
-// findRouteHierarchy finds the complete route hierarchy from root to the matched route.
-// It returns a slice of RouteDefinition pointers representing the path from root to leaf.
-func findRouteHierarchy(routes []*RouteDefinition, originalPath string, targetRoute *RouteDefinition) []*RouteDefinition {
-	for _, route := range routes {
-		if route == targetRoute {
-			// Found the target route at this level
-			return []*RouteDefinition{route}
-		}
-
-		// Check if this route matches the beginning of the path
-		if route.matcher == nil {
-			route.matcher = compileMatcher(route)
-		}
-		isMatch, params := route.matcher(originalPath)
-		if isMatch {
-			// Calculate remaining path and search in children
-			remainingPath := calculateRemainingPath(originalPath, route.Path, params)
-			if len(route.Children) > 0 {
-				childHierarchy := findRouteHierarchy(route.Children, remainingPath, targetRoute)
-				if len(childHierarchy) > 0 {
-					// Found target in children, prepend this route
-					return append([]*RouteDefinition{route}, childHierarchy...)
-				}
-			}
-		}
+// rememberEntryState records the Go state of a history entry so popstate can
+// restore it.
+func rememberEntryState(router *Router, location Location) {
+	if location.State == nil {
+		return
 	}
-	return nil
+	if router.entryStates == nil {
+		router.entryStates = make(map[string]any)
+	}
+	router.entryStates[location.Key] = location.State
 }
 
-// performInitialRender renders the initial component based on the current browser URL.
-func performInitialRender(router *Router) {
-	window := dom.GetWindow()
-	currentLocation := window.Location()
-	location := Location{
-		Pathname: currentLocation.Pathname(),
-		Search:   currentLocation.Search(),
-		Hash:     currentLocation.Hash(),
-		State:    nil, // Initial state is nil
+// scrollToAnchor scrolls the element with the given id into view, if present.
+func scrollToAnchor(id string) {
+	if id == "" {
+		return
+	}
+	if el := js.Global().Get("document").Call("getElementById", id); el.Truthy() {
+		el.Call("scrollIntoView")
 	}
-	// Update the router's location state to match the current URL
-	router.locationState.Set(location)
-	renderLocation(router, location)
 }
 
 // updateJSLocation updates the global JavaScript variable with the current location.
@@ -230,6 +228,7 @@ func updateJSLocation(location Location) {
 		"search":   location.Search,
 		"hash":     location.Hash,
 		"state":    location.State,
+		"key":      location.Key,
 	})
 	routerObj.Set("location", locationObj)
 }
@@ -238,18 +237,16 @@ func updateJSLocation(location Location) {
 func addPopstateEventListener(router *Router) {
 	window := dom.GetWindow()
 	window.AddEventListener("popstate", false, func(event dom.Event) {
-		// Get the current location from the browser
-		currentLocation := window.Location()
-		// Create a Location object from the browser's location
-		newLocation := Location{
-			Pathname: currentLocation.Pathname(),
-			Search:   currentLocation.Search(),
-			Hash:     currentLocation.Hash(),
-			State:    nil, // popstate event doesn't carry state, it's in the history state
-		}
-		// Update the router's location state
-		router.locationState.Set(newLocation)
-		// Also update the JavaScript global variable
-		updateJSLocation(newLocation)
+		// Create a Location object from the browser's location and history entry
+		newLocation := browserLocation(router)
+		// The browser has already moved to the entry; only the router state
+		// waits for loaders, and is left alone if a newer navigation wins
+		router.runNavigation(newLocation, func() {
+			router.restoreEntry(newLocation)
+			// Update the router's location state
+			router.locationState.Set(newLocation)
+			// Also update the JavaScript global variable
+			updateJSLocation(newLocation)
+		})
 	})
 }
@@ -0,0 +1,17 @@
+package router
+
+import g "maragu.dev/gomponents"
+
+// sharedElementAttr holds the name given to an element with SharedElement.
+const sharedElementAttr = "data-uiwgo-shared"
+
+// SharedElement is an attribute that names the element it is placed on as a
+// shared element, e.g. a product card image and the product page's hero
+// image. When a navigation replaces a page holding an element with the same
+// name, the incoming element morphs from the outgoing one's position and
+// size: with the View Transitions API where the browser has it, otherwise
+// with the Web Animations API. Without either the page is swapped without
+// animation. Names must be unique within a page.
+func SharedElement(name string) g.Node {
+	return g.Attr(sharedElementAttr, name)
+}
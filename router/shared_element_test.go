@@ -0,0 +1,138 @@
+//go:build js && wasm
+
+package router
+
+import (
+	"syscall/js"
+	"testing"
+
+	dom "honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// newSharedElementRouter mounts a router whose /grid and /detail pages share
+// the element "product-1" at different positions and sizes.
+func newSharedElementRouter(t *testing.T) (*Router, js.Value) {
+	t.Helper()
+	restoreURL(t)
+	doc := js.Global().Get("document")
+	outlet := doc.Call("createElement", "div")
+	doc.Get("body").Call("appendChild", outlet)
+	t.Cleanup(func() { outlet.Call("remove") })
+
+	box := func(style string) g.Node {
+		return h.Div(SharedElement("product-1"), h.Style("position:fixed;"+style))
+	}
+	r := New([]*RouteDefinition{
+		Route("/grid", func(props ...any) interface{} {
+			return h.Div(h.ID("grid"), box("left:10px;top:20px;width:100px;height:50px"))
+		}),
+		Route("/detail", func(props ...any) interface{} {
+			return h.Div(h.ID("detail"), box("left:200px;top:40px;width:400px;height:200px"))
+		}),
+	}, dom.WrapElement(outlet))
+	r.Navigate("/grid")
+	waitFor(t, func() bool { return outlet.Call("querySelector", "#grid").Truthy() })
+	return r, outlet
+}
+
+// hideViewTransitions shadows document.startViewTransition for the test.
+func hideViewTransitions(t *testing.T) {
+	doc := js.Global().Get("document")
+	doc.Set("startViewTransition", js.Undefined())
+	t.Cleanup(func() { js.Global().Get("Reflect").Call("deleteProperty", doc, "startViewTransition") })
+}
+
+func TestSharedElementMorphsWithWebAnimations(t *testing.T) {
+	hideViewTransitions(t)
+	proto := js.Global().Get("Element").Get("prototype")
+	original := proto.Get("animate")
+	var target, keyframes, options js.Value
+	calls := 0
+	animate := js.FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		target, keyframes, options = this, args[0], args[1]
+		return nil
+	})
+	proto.Set("animate", animate)
+	t.Cleanup(func() {
+		proto.Set("animate", original)
+		animate.Release()
+	})
+
+	r, outlet := newSharedElementRouter(t)
+	r.Navigate("/detail")
+	waitFor(t, func() bool { return outlet.Call("querySelector", "#detail").Truthy() })
+
+	if calls != 1 {
+		t.Fatalf("animate called %d times, want 1", calls)
+	}
+	if got := target.Call("getAttribute", sharedElementAttr).String(); got != "product-1" || !target.Get("isConnected").Bool() {
+		t.Errorf("animated %q (connected %v), want the incoming product-1", got, target.Get("isConnected").Bool())
+	}
+	if got, want := keyframes.Index(0).Get("transform").String(), "translate(-190px, -20px) scale(0.25, 0.25)"; got != want {
+		t.Errorf("from keyframe = %q, want %q", got, want)
+	}
+	if got := keyframes.Index(1).Get("transform").String(); got != "none" {
+		t.Errorf("to keyframe = %q, want none", got)
+	}
+	if got := options.Get("duration").Int(); got != sharedMorphDuration {
+		t.Errorf("duration = %d, want %d", got, sharedMorphDuration)
+	}
+}
+
+func TestSharedElementUsesViewTransitions(t *testing.T) {
+	doc := js.Global().Get("document")
+	var oldName, newName string
+	calls := 0
+	start := js.FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		if old := doc.Call("querySelector", "#grid ["+sharedElementAttr+"]"); old.Truthy() {
+			oldName = old.Get("style").Get("viewTransitionName").String()
+		}
+		args[0].Invoke()
+		if el := doc.Call("querySelector", "#detail ["+sharedElementAttr+"]"); el.Truthy() {
+			newName = el.Get("style").Get("viewTransitionName").String()
+		}
+		transition := js.Global().Get("Object").New()
+		transition.Set("finished", js.Global().Get("Promise").Call("resolve"))
+		return transition
+	})
+	doc.Set("startViewTransition", start)
+	t.Cleanup(func() {
+		js.Global().Get("Reflect").Call("deleteProperty", doc, "startViewTransition")
+		start.Release()
+	})
+
+	r, outlet := newSharedElementRouter(t)
+	if calls != 0 {
+		t.Fatalf("a page without a shared counterpart started %d transitions", calls)
+	}
+	r.Navigate("/detail")
+	waitFor(t, func() bool { return outlet.Call("querySelector", "#detail").Truthy() })
+
+	if calls != 1 {
+		t.Fatalf("startViewTransition called %d times, want 1", calls)
+	}
+	if want := "uiwgo-shared-product-1"; oldName != want || newName != want {
+		t.Errorf("view-transition-name old %q, new %q; want %q on both", oldName, newName, want)
+	}
+	hero := outlet.Call("querySelector", "["+sharedElementAttr+"]")
+	waitFor(t, func() bool { return hero.Get("style").Get("viewTransitionName").String() == "" })
+}
+
+func TestSharedElementWithoutAnimationSupport(t *testing.T) {
+	hideViewTransitions(t)
+	proto := js.Global().Get("Element").Get("prototype")
+	original := proto.Get("animate")
+	proto.Set("animate", js.Undefined())
+	t.Cleanup(func() { proto.Set("animate", original) })
+
+	r, outlet := newSharedElementRouter(t)
+	r.Navigate("/detail")
+	waitFor(t, func() bool { return outlet.Call("querySelector", "#detail").Truthy() })
+	if outlet.Call("querySelector", "#grid").Truthy() {
+		t.Error("the outgoing page is still shown")
+	}
+}
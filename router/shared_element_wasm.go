@@ -0,0 +1,146 @@
+//go:build js && wasm
+
+package router
+
+import (
+	"fmt"
+	"regexp"
+	"syscall/js"
+
+	dom "honnef.co/go/js/dom/v2"
+)
+
+// sharedMorphDuration is the length of a shared element morph in
+// milliseconds.
+const sharedMorphDuration = 300
+
+// outletSwaps numbers the outlet updates of each router so that a View
+// Transition update running after a newer navigation leaves it alone.
+var outletSwaps = map[*Router]int{}
+
+// swapOutlet replaces the content of outlet with html, morphing the shared
+// elements found both in the current content and in html.
+func swapOutlet(router *Router, outlet dom.Element, html string) {
+	outletSwaps[router]++
+	seq := outletSwaps[router]
+	root := outlet.Underlying()
+
+	from := sharedElementsIn(root)
+	if len(from) > 0 {
+		doc := js.Global().Get("document")
+		tpl := doc.Call("createElement", "template")
+		tpl.Set("innerHTML", html)
+		incoming := sharedElementsIn(tpl.Get("content"))
+		var common []string
+		for name := range from {
+			if _, ok := incoming[name]; ok {
+				common = append(common, name)
+			}
+		}
+		if len(common) > 0 {
+			if doc.Get("startViewTransition").Type() == js.TypeFunction {
+				viewTransitionSwap(router, seq, outlet, html, from, common)
+				return
+			}
+			rects := make(map[string]js.Value, len(common))
+			for _, name := range common {
+				rects[name] = from[name].Call("getBoundingClientRect")
+			}
+			outlet.SetInnerHTML(html)
+			to := sharedElementsIn(root)
+			for name, rect := range rects {
+				morphFrom(to[name], rect)
+			}
+			return
+		}
+	}
+	outlet.SetInnerHTML(html)
+}
+
+// sharedElementsIn returns the elements under root marked with
+// SharedElement by name; for a repeated name the first one wins.
+func sharedElementsIn(root js.Value) map[string]js.Value {
+	nodes := root.Call("querySelectorAll", "["+sharedElementAttr+"]")
+	out := make(map[string]js.Value, nodes.Length())
+	for i := 0; i < nodes.Length(); i++ {
+		el := nodes.Index(i)
+		name := el.Call("getAttribute", sharedElementAttr).String()
+		if _, ok := out[name]; !ok {
+			out[name] = el
+		}
+	}
+	return out
+}
+
+// morphFrom animates el from the position and size of the rect from to its
+// own with a FLIP transform.
+func morphFrom(el js.Value, from js.Value) {
+	if el.Get("animate").Type() != js.TypeFunction {
+		return
+	}
+	to := el.Call("getBoundingClientRect")
+	scale := func(a, b float64) float64 {
+		if b == 0 {
+			return 1
+		}
+		return a / b
+	}
+	dx := from.Get("left").Float() - to.Get("left").Float()
+	dy := from.Get("top").Float() - to.Get("top").Float()
+	sx := scale(from.Get("width").Float(), to.Get("width").Float())
+	sy := scale(from.Get("height").Float(), to.Get("height").Float())
+	el.Call("animate", []any{
+		map[string]any{"transform": fmt.Sprintf("translate(%gpx, %gpx) scale(%g, %g)", dx, dy, sx, sy), "transformOrigin": "top left"},
+		map[string]any{"transform": "none", "transformOrigin": "top left"},
+	}, map[string]any{"duration": sharedMorphDuration, "easing": "ease-in-out"})
+}
+
+// viewTransitionSwap swaps the outlet inside a View Transition that pairs
+// the shared elements in common through view-transition-name.
+func viewTransitionSwap(router *Router, seq int, outlet dom.Element, html string, from map[string]js.Value, common []string) {
+	for _, name := range common {
+		from[name].Get("style").Set("viewTransitionName", viewTransitionName(name))
+	}
+	var named []js.Value
+	var update js.Func
+	update = js.FuncOf(func(this js.Value, args []js.Value) any {
+		update.Release()
+		// A newer navigation has replaced the outlet since
+		if outletSwaps[router] != seq {
+			return nil
+		}
+		outlet.SetInnerHTML(html)
+		to := sharedElementsIn(outlet.Underlying())
+		for _, name := range common {
+			if el, ok := to[name]; ok {
+				el.Get("style").Set("viewTransitionName", viewTransitionName(name))
+				named = append(named, el)
+			}
+		}
+		return nil
+	})
+	transition := js.Global().Get("document").Call("startViewTransition", update)
+
+	// Names left on the page would clash with the next transition
+	finished := transition.Get("finished")
+	if !finished.Truthy() {
+		return
+	}
+	var done js.Func
+	done = js.FuncOf(func(this js.Value, args []js.Value) any {
+		done.Release()
+		for _, el := range named {
+			el.Get("style").Set("viewTransitionName", "")
+		}
+		return nil
+	})
+	finished.Call("then", done, done)
+}
+
+var invalidTransitionName = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// viewTransitionName turns a SharedElement name into a valid
+// view-transition-name.
+func viewTransitionName(name string) string {
+	return "uiwgo-shared-" + invalidTransitionName.ReplaceAllString(name, "-")
+}
@@ -7,9 +7,14 @@ import (
 // Location holds the parsed components of a URL, mirroring the browser's location object.
 type Location struct {
 	Pathname string
-	Search   string
-	Hash     string
-	State    any // Represents data passed via history.pushState
+	Search   string // Raw query string including the leading "?"; see Query
+	Hash     string // Fragment including the leading "#"
+	State    any    // Represents data passed via history.pushState
+	// Key uniquely identifies the history entry this location belongs to.
+	Key string
+	// Background is the location rendered underneath when this location is a
+	// modal route opened from within the app; nil otherwise.
+	Background *Location
 }
 
 // Subscriber defines the function signature for any callback that wishes
@@ -21,7 +26,12 @@ type Subscriber func(newLocation Location)
 type LocationState struct {
 	mu          sync.RWMutex
 	current     Location
-	subscribers []Subscriber
+	subscribers []*subscription
+}
+
+// subscription wraps a Subscriber so it can be removed again.
+type subscription struct {
+	fn Subscriber
 }
 
 // NewLocationState creates and returns a new LocationState instance
@@ -29,7 +39,7 @@ type LocationState struct {
 func NewLocationState() *LocationState {
 	return &LocationState{
 		current:     Location{},
-		subscribers: make([]Subscriber, 0),
+		subscribers: make([]*subscription, 0),
 	}
 }
 
@@ -42,9 +52,25 @@ func (ls *LocationState) Get() Location {
 
 // Subscribe adds a subscriber function to be notified of location changes.
 func (ls *LocationState) Subscribe(s Subscriber) {
+	ls.subscribe(s)
+}
+
+// subscribe adds a subscriber and returns a function that removes it.
+func (ls *LocationState) subscribe(s Subscriber) (unsubscribe func()) {
 	ls.mu.Lock()
 	defer ls.mu.Unlock()
-	ls.subscribers = append(ls.subscribers, s)
+	sub := &subscription{fn: s}
+	ls.subscribers = append(ls.subscribers, sub)
+	return func() {
+		ls.mu.Lock()
+		defer ls.mu.Unlock()
+		for i, other := range ls.subscribers {
+			if other == sub {
+				ls.subscribers = append(ls.subscribers[:i], ls.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
 }
 
 // Set updates the current location and notifies all subscribers.
@@ -54,11 +80,11 @@ func (ls *LocationState) Subscribe(s Subscriber) {
 func (ls *LocationState) Set(newLocation Location) {
 	ls.mu.Lock()
 	ls.current = newLocation
-	subscribers := make([]Subscriber, len(ls.subscribers))
+	subscribers := make([]*subscription, len(ls.subscribers))
 	copy(subscribers, ls.subscribers)
 	ls.mu.Unlock()
 
 	for _, subscriber := range subscribers {
-		subscriber(newLocation)
+		subscriber.fn(newLocation)
 	}
 }